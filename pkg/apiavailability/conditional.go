@@ -0,0 +1,208 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiavailability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var (
+	// ErrNoProber is returned when a ConditionalController is set up without Prober.
+	ErrNoProber = errors.New("a prober is required")
+	// ErrNoKinds is returned when a ConditionalController is set up without kinds.
+	ErrNoKinds = errors.New("at least one kind is required")
+)
+
+// ConditionalController runs a controller only while all its kinds are served, starting it when they appear and
+// stopping it when any of them disappears, with the informers of the kinds so that the cache does not keep failing
+// to list them.
+//
+// Controllers cannot be restarted, so New builds a new unmanaged controller every time the kinds appear. The
+// controllers it builds must skip name validation, as they share their name.
+//
+// Example:
+//
+//	routeController := &apiavailability.ConditionalController{
+//	    Name:   "route",
+//	    Prober: prober,
+//	    Kinds:  []schema.GroupVersionKind{routev1.GroupVersion.WithKind("Route")},
+//	    New: func(mgr ctrl.Manager) (controller.Controller, error) {
+//	        c, err := controller.NewUnmanaged("route", controller.Options{
+//	            Reconciler:         r,
+//	            SkipNameValidation: ptr.To(true),
+//	        })
+//	        if err != nil {
+//	            return nil, err
+//	        }
+//
+//	        return c, c.Watch(source.Kind(mgr.GetCache(), &routev1.Route{}, &handler.TypedEnqueueRequestForObject[*routev1.Route]{}))
+//	    },
+//	}
+//	if err := routeController.SetupWithManager(mgr); err != nil {
+//	    return err
+//	}
+type ConditionalController struct {
+	// Name names the conditional controller in logs.
+	Name string
+
+	// Prober probes the kinds. It has to be added to the manager.
+	Prober *Prober
+
+	// Kinds are the kinds that must all be served for the controller to run.
+	Kinds []schema.GroupVersionKind
+
+	// New builds the controller.
+	New func(mgr ctrl.Manager) (controller.Controller, error)
+
+	// NoLeaderElection runs the controller on every replica instead of the leader only, e.g. for read-only
+	// controllers.
+	NoLeaderElection bool
+
+	mgr     ctrl.Manager
+	changes <-chan struct{}
+}
+
+// SetupWithManager registers the kinds with the Prober and adds the ConditionalController to the manager.
+func (c *ConditionalController) SetupWithManager(mgr ctrl.Manager) error {
+	if c.Prober == nil {
+		return ErrNoProber
+	}
+
+	if len(c.Kinds) == 0 {
+		return ErrNoKinds
+	}
+
+	c.mgr = mgr
+	c.Prober.Register(c.Kinds...)
+	c.changes = c.Prober.Subscribe()
+
+	if err := mgr.Add(c); err != nil {
+		return fmt.Errorf("failed to add conditional controller %s to manager: %w", c.Name, err)
+	}
+
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+func (c *ConditionalController) NeedLeaderElection() bool {
+	return !c.NoLeaderElection
+}
+
+// Start runs the controller while its kinds are served, until the context is done. It fails when the controller
+// fails, like controllers added to the manager.
+func (c *ConditionalController) Start(ctx context.Context) error {
+	ctx = log.IntoContext(ctx, log.FromContext(ctx).WithName("conditional-controller").WithValues("controller", c.Name))
+	logger := log.FromContext(ctx)
+
+	var (
+		stop context.CancelFunc
+		done chan error
+	)
+
+	stopController := func() error {
+		stop()
+
+		err := <-done
+		stop, done = nil, nil
+
+		return errors.Join(err, c.removeInformers(context.WithoutCancel(ctx)))
+	}
+
+	for {
+		switch available := c.available(); {
+		case available && stop == nil:
+			ctrlr, err := c.New(c.mgr)
+			if err != nil {
+				return fmt.Errorf("failed to create conditional controller %s: %w", c.Name, err)
+			}
+
+			var controllerCtx context.Context
+
+			controllerCtx, stop = context.WithCancel(ctx)
+			done = make(chan error, 1)
+
+			go func() {
+				done <- ctrlr.Start(controllerCtx)
+			}()
+
+			logger.Info("Started controller as its APIs are available")
+		case !available && stop != nil:
+			if err := stopController(); err != nil {
+				return fmt.Errorf("failed to stop conditional controller %s: %w", c.Name, err)
+			}
+
+			logger.Info("Stopped controller as its APIs are not available")
+		}
+
+		select {
+		case <-ctx.Done():
+			if stop != nil {
+				return stopController()
+			}
+
+			return nil
+		case err := <-done:
+			stop()
+
+			return fmt.Errorf("conditional controller %s failed: %w", c.Name, err)
+		case <-c.changes:
+		}
+	}
+}
+
+// available reports whether all the kinds are served.
+func (c *ConditionalController) available() bool {
+	for _, gvk := range c.Kinds {
+		if !c.Prober.Available(gvk) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// removeInformers removes the informers of the kinds from the cache of the manager. Kinds that are not in the scheme
+// are skipped, as the controller could not have watched them.
+func (c *ConditionalController) removeInformers(ctx context.Context) error {
+	var errs []error
+
+	for _, gvk := range c.Kinds {
+		obj, err := c.mgr.GetScheme().New(gvk)
+		if err != nil {
+			continue
+		}
+
+		cacheObj, ok := obj.(client.Object)
+		if !ok {
+			continue
+		}
+
+		if err := c.mgr.GetCache().RemoveInformer(ctx, cacheObj); err != nil {
+			errs = append(errs, fmt.Errorf("failed to remove the informer of %s: %w", gvk.String(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
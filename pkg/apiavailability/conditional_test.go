@@ -0,0 +1,178 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiavailability
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	discoveryfake "k8s.io/client-go/discovery/fake"
+	toolscache "k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// fakeManager is a manager providing a scheme and a cache only.
+type fakeManager struct {
+	ctrl.Manager
+
+	scheme *runtime.Scheme
+	cache  cache.Cache
+}
+
+func (m *fakeManager) Add(manager.Runnable) error {
+	return nil
+}
+
+func (m *fakeManager) GetScheme() *runtime.Scheme {
+	return m.scheme
+}
+
+func (m *fakeManager) GetCache() cache.Cache {
+	return m.cache
+}
+
+// fakeController is a controller running until stopped, or failing with err.
+type fakeController struct {
+	controller.Controller
+
+	running *atomic.Int32
+	err     error
+}
+
+func (c *fakeController) Start(ctx context.Context) error {
+	if c.err != nil {
+		return c.err
+	}
+
+	c.running.Add(1)
+	defer c.running.Add(-1)
+
+	<-ctx.Done()
+
+	return nil
+}
+
+var _ = Describe("ConditionalController", func() {
+	var (
+		ctx       context.Context
+		discovery *discoveryfake.FakeDiscovery
+		prober    *Prober
+		informers *informertest.FakeInformers
+		mgr       *fakeManager
+		running   atomic.Int32
+		created   atomic.Int32
+	)
+
+	podGVK := corev1.SchemeGroupVersion.WithKind("Pod")
+
+	BeforeEach(func() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(context.Background())
+		DeferCleanup(cancel)
+
+		discovery = newDiscovery()
+		prober = &Prober{Discovery: discovery}
+
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		informers = &informertest.FakeInformers{
+			Scheme:         scheme,
+			InformersByGVK: map[schema.GroupVersionKind]toolscache.SharedIndexInformer{podGVK: nil},
+		}
+		mgr = &fakeManager{scheme: scheme, cache: informers}
+
+		running.Store(0)
+		created.Store(0)
+	})
+
+	newConditionalController := func(err error) *ConditionalController {
+		c := &ConditionalController{
+			Name:   "pod",
+			Prober: prober,
+			Kinds:  []schema.GroupVersionKind{podGVK, routeGVK},
+			New: func(ctrl.Manager) (controller.Controller, error) {
+				created.Add(1)
+				return &fakeController{running: &running, err: err}, nil
+			},
+		}
+		Expect(c.SetupWithManager(mgr)).To(Succeed())
+
+		return c
+	}
+
+	It("should require a prober and kinds", func() {
+		Expect((&ConditionalController{}).SetupWithManager(mgr)).To(MatchError(ErrNoProber))
+		Expect((&ConditionalController{Prober: prober}).SetupWithManager(mgr)).To(MatchError(ErrNoKinds))
+	})
+
+	It("should run the controller while all its kinds are served", func() {
+		c := newConditionalController(nil)
+		Expect(c.NeedLeaderElection()).To(BeTrue())
+
+		startCtx, stop := context.WithCancel(ctx)
+		done := make(chan error)
+		go func() {
+			done <- c.Start(startCtx)
+		}()
+
+		serve(discovery, podGVK)
+		Expect(prober.Probe(ctx)).To(Succeed())
+		Consistently(running.Load, "50ms").Should(BeZero())
+
+		serve(discovery, podGVK, routeGVK)
+		Expect(prober.Probe(ctx)).To(Succeed())
+		Eventually(running.Load).Should(BeEquivalentTo(1))
+
+		serve(discovery, podGVK)
+		Expect(prober.Probe(ctx)).To(Succeed())
+		Eventually(running.Load).Should(BeZero())
+
+		serve(discovery, podGVK, routeGVK)
+		Expect(prober.Probe(ctx)).To(Succeed())
+		Eventually(running.Load).Should(BeEquivalentTo(1))
+		Expect(created.Load()).To(BeEquivalentTo(2))
+
+		stop()
+		Eventually(done).Should(Receive(BeNil()))
+		Expect(running.Load()).To(BeZero())
+		Expect(informers.InformersByGVK).NotTo(HaveKey(podGVK))
+	})
+
+	It("should fail when the controller fails", func() {
+		c := newConditionalController(errors.New("boom"))
+
+		serve(discovery, podGVK, routeGVK)
+		Expect(prober.Probe(ctx)).To(Succeed())
+
+		done := make(chan error)
+		go func() {
+			done <- c.Start(ctx)
+		}()
+
+		Eventually(done).Should(Receive(MatchError(ContainSubstring("boom"))))
+	})
+})
@@ -0,0 +1,245 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package apiavailability probes the API server for optional APIs, e.g. Routes, ServiceMonitors or
+// VerticalPodAutoscalers, and runs the controllers depending on them only while they are served, so that operators
+// pick up APIs installed after they started, e.g. by OLM.
+package apiavailability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// DefaultProbeInterval is the default interval at which the APIs are probed.
+const DefaultProbeInterval = time.Minute
+
+// Prober probes discovery for the registered kinds when started and at every interval, and notifies its subscribers
+// when their availability changes.
+//
+// Call Probe before starting the manager so that Available is usable immediately.
+//
+// Example:
+//
+//	prober := &apiavailability.Prober{}
+//	prober.Register(monitoringv1.SchemeGroupVersion.WithKind("ServiceMonitor"))
+//	if err := prober.SetupWithManager(mgr); err != nil {
+//	    return err
+//	}
+//
+//	if err := prober.Probe(ctx); err != nil {
+//	    return err
+//	}
+//
+//	if prober.Available(monitoringv1.SchemeGroupVersion.WithKind("ServiceMonitor")) {
+//	    ...
+//	}
+type Prober struct {
+	// Discovery is the discovery client probing the APIs. Defaults to a client for the config of the manager.
+	Discovery discovery.DiscoveryInterface
+
+	// Interval is the interval between probes. Defaults to DefaultProbeInterval.
+	Interval time.Duration
+
+	mu          sync.RWMutex
+	available   map[schema.GroupVersionKind]bool
+	subscribers []chan<- struct{}
+}
+
+// Register registers the kinds to probe, initially reported as unavailable until probed.
+func (p *Prober) Register(gvks ...schema.GroupVersionKind) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.available == nil {
+		p.available = map[schema.GroupVersionKind]bool{}
+	}
+
+	for _, gvk := range gvks {
+		if _, ok := p.available[gvk]; !ok {
+			p.available[gvk] = false
+		}
+	}
+}
+
+// Available reports whether the kind was served when last probed.
+func (p *Prober) Available(gvk schema.GroupVersionKind) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.available[gvk]
+}
+
+// Subscribe returns a channel receiving a value when the availability of any registered kind changes.
+// Notifications are coalesced, so subscribers have to check the availability of the kinds they need.
+func (p *Prober) Subscribe() <-chan struct{} {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	changes := make(chan struct{}, 1)
+	p.subscribers = append(p.subscribers, changes)
+
+	return changes
+}
+
+// SetupWithManager adds the Prober to the manager, defaulting Discovery to a client for the config of the manager.
+func (p *Prober) SetupWithManager(mgr ctrl.Manager) error {
+	if p.Discovery == nil {
+		discoveryClient, err := discovery.NewDiscoveryClientForConfigAndClient(mgr.GetConfig(), mgr.GetHTTPClient())
+		if err != nil {
+			return fmt.Errorf("failed to create discovery client: %w", err)
+		}
+
+		p.Discovery = discoveryClient
+	}
+
+	if err := mgr.Add(p); err != nil {
+		return fmt.Errorf("failed to add API availability prober to manager: %w", err)
+	}
+
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+// Every replica needs to know which APIs are available, so the Prober runs regardless of leadership.
+func (p *Prober) NeedLeaderElection() bool {
+	return false
+}
+
+// Start probes the APIs, then again at every interval, until the context is done.
+// Failures are logged and keep the last known availability.
+func (p *Prober) Start(ctx context.Context) error {
+	interval := p.Interval
+	if interval <= 0 {
+		interval = DefaultProbeInterval
+	}
+
+	ctx = log.IntoContext(ctx, log.FromContext(ctx).WithName("api-availability-prober"))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.Probe(ctx); err != nil {
+			log.FromContext(ctx).Error(err, "Failed to probe API availability")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Probe probes discovery for the registered kinds once, and notifies the subscribers when any availability changed.
+// The kinds of group versions that failed to be probed keep their last known availability.
+func (p *Prober) Probe(ctx context.Context) error {
+	p.mu.RLock()
+	gvks := slices.Collect(maps.Keys(p.available))
+	p.mu.RUnlock()
+
+	probed := map[schema.GroupVersionKind]bool{}
+	served := map[schema.GroupVersion]map[string]bool{}
+
+	var errs []error
+
+	for _, gvk := range gvks {
+		gv := gvk.GroupVersion()
+
+		kinds, ok := served[gv]
+		if !ok {
+			var err error
+
+			kinds, err = p.servedKinds(gv)
+			if err != nil {
+				errs = append(errs, err)
+			}
+
+			served[gv] = kinds
+		}
+
+		if kinds != nil {
+			probed[gvk] = kinds[gvk.Kind]
+		}
+	}
+
+	p.mu.Lock()
+
+	changed := false
+
+	for gvk, available := range probed {
+		if p.available[gvk] != available {
+			log.FromContext(ctx).Info("API availability changed", "gvk", gvk.String(), "available", available)
+
+			p.available[gvk] = available
+			changed = true
+		}
+	}
+
+	if changed {
+		for _, subscriber := range p.subscribers {
+			select {
+			case subscriber <- struct{}{}:
+			default:
+			}
+		}
+	}
+
+	p.mu.Unlock()
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to probe API availability: %w", errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// servedKinds returns the kinds served in the group version, empty when the group version is not served, or nil on
+// failure.
+func (p *Prober) servedKinds(gv schema.GroupVersion) (map[string]bool, error) {
+	resources, err := p.Discovery.ServerResourcesForGroupVersion(gv.String())
+	if apierrors.IsNotFound(err) {
+		return map[string]bool{}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover %s: %w", gv.String(), err)
+	}
+
+	kinds := map[string]bool{}
+
+	for _, resource := range resources.APIResources {
+		// Subresources, e.g. deployments/scale, are served with the kind of their own representation.
+		if !strings.Contains(resource.Name, "/") {
+			kinds[resource.Kind] = true
+		}
+	}
+
+	return kinds, nil
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiavailability
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	discoveryfake "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+var (
+	routeGVK          = schema.GroupVersionKind{Group: "route.openshift.io", Version: "v1", Kind: "Route"}
+	serviceMonitorGVK = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "ServiceMonitor"}
+)
+
+// newDiscovery returns a fake discovery client serving the kinds.
+func newDiscovery(gvks ...schema.GroupVersionKind) *discoveryfake.FakeDiscovery {
+	discovery := &discoveryfake.FakeDiscovery{Fake: &clienttesting.Fake{}}
+	serve(discovery, gvks...)
+
+	return discovery
+}
+
+// serve replaces the kinds served by the fake discovery client.
+func serve(discovery *discoveryfake.FakeDiscovery, gvks ...schema.GroupVersionKind) {
+	discovery.Resources = nil
+
+	for _, gvk := range gvks {
+		discovery.Resources = append(discovery.Resources, &metav1.APIResourceList{
+			GroupVersion: gvk.GroupVersion().String(),
+			APIResources: []metav1.APIResource{{Name: "resources", Kind: gvk.Kind}},
+		})
+	}
+}
+
+var _ = Describe("Prober", func() {
+	ctx := context.Background()
+
+	It("should report the served kinds and notify their changes", func() {
+		discovery := newDiscovery(routeGVK)
+		prober := &Prober{Discovery: discovery}
+		prober.Register(routeGVK, serviceMonitorGVK)
+		changes := prober.Subscribe()
+
+		Expect(prober.Available(routeGVK)).To(BeFalse())
+
+		Expect(prober.Probe(ctx)).To(Succeed())
+		Expect(prober.Available(routeGVK)).To(BeTrue())
+		Expect(prober.Available(serviceMonitorGVK)).To(BeFalse())
+		Expect(changes).To(Receive())
+
+		Expect(prober.Probe(ctx)).To(Succeed())
+		Expect(changes).NotTo(Receive())
+
+		serve(discovery, routeGVK, serviceMonitorGVK)
+		Expect(prober.Probe(ctx)).To(Succeed())
+		Expect(prober.Available(serviceMonitorGVK)).To(BeTrue())
+		Expect(changes).To(Receive())
+	})
+
+	It("should ignore the subresources", func() {
+		discovery := newDiscovery()
+		discovery.Resources = []*metav1.APIResourceList{{
+			GroupVersion: routeGVK.GroupVersion().String(),
+			APIResources: []metav1.APIResource{{Name: "routes/status", Kind: routeGVK.Kind}},
+		}}
+
+		prober := &Prober{Discovery: discovery}
+		prober.Register(routeGVK)
+		Expect(prober.Probe(ctx)).To(Succeed())
+		Expect(prober.Available(routeGVK)).To(BeFalse())
+	})
+
+	It("should keep the last known availability on failures", func() {
+		discovery := newDiscovery(routeGVK)
+		prober := &Prober{Discovery: discovery}
+		prober.Register(routeGVK)
+		Expect(prober.Probe(ctx)).To(Succeed())
+
+		discovery.PrependReactor("get", "resource", func(clienttesting.Action) (bool, runtime.Object, error) {
+			return true, nil, errors.New("boom")
+		})
+
+		Expect(prober.Probe(ctx)).To(MatchError(ContainSubstring("boom")))
+		Expect(prober.Available(routeGVK)).To(BeTrue())
+	})
+
+	It("should probe until stopped", func() {
+		var probes atomic.Int32
+
+		discovery := newDiscovery(routeGVK)
+		discovery.PrependReactor("get", "resource", func(clienttesting.Action) (bool, runtime.Object, error) {
+			probes.Add(1)
+			return false, nil, nil
+		})
+
+		prober := &Prober{Discovery: discovery, Interval: 10 * time.Millisecond}
+		prober.Register(routeGVK)
+
+		startCtx, stop := context.WithCancel(ctx)
+		done := make(chan error)
+		go func() {
+			done <- prober.Start(startCtx)
+		}()
+
+		Eventually(func() bool { return prober.Available(routeGVK) }).Should(BeTrue())
+		Eventually(probes.Load).Should(BeNumerically(">=", 3))
+
+		stop()
+		Eventually(done).Should(Receive(BeNil()))
+	})
+})
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package apply provides server-side apply helpers enforcing consistent field manager names
+// and conflict policies across controllers.
+package apply
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// maxFieldManagerLength is the maximum length of a field manager accepted by the API server.
+const maxFieldManagerLength = 128
+
+// ErrNoFieldManager is returned when an Applier is used without a field manager.
+var ErrNoFieldManager = errors.New("a field manager is required for server-side apply")
+
+// ConflictPolicy is what to do when applied fields are owned by another field manager.
+type ConflictPolicy int
+
+const (
+	// ForceOnConflict takes ownership of the conflicting fields. This is what controllers owning
+	// the objects they apply should use, so that they correct changes made by others.
+	ForceOnConflict ConflictPolicy = iota
+	// FailOnConflict returns a conflict error, leaving the fields to their current owner.
+	// This is what controllers sharing objects with other actors, such as users, should use.
+	FailOnConflict
+)
+
+// FieldManager returns the field manager name of a controller of an operator, <operator>/<controller>,
+// so that managedFields tell which controller of which operator owns each field.
+// The name is truncated to the maximum length accepted by the API server.
+func FieldManager(operator, controller string) string {
+	name := operator + "/" + controller
+	if len(name) > maxFieldManagerLength {
+		name = name[:maxFieldManagerLength]
+	}
+
+	return name
+}
+
+// Applier applies objects and statuses with server-side apply as a single field manager.
+//
+// Example:
+//
+//	applier := &apply.Applier{Client: mgr.GetClient(), FieldManager: apply.FieldManager("my-operator", "operand")}
+//	err := applier.Apply(ctx, applycorev1.ConfigMap("operand-config", namespace).WithData(data))
+type Applier struct {
+	client.Client
+
+	// FieldManager is the field manager of the applied fields. Use FieldManager to build it.
+	FieldManager string
+
+	// Conflicts is the policy for fields owned by other field managers. Defaults to ForceOnConflict.
+	Conflicts ConflictPolicy
+}
+
+// Apply applies the object described by the apply configuration, such as one from k8s.io/client-go/applyconfigurations.
+// On success, the apply configuration is updated with the object returned by the API server.
+func (a *Applier) Apply(ctx context.Context, obj runtime.ApplyConfiguration) error {
+	if a.FieldManager == "" {
+		return ErrNoFieldManager
+	}
+
+	opts := []client.ApplyOption{client.FieldOwner(a.FieldManager)}
+	if a.Conflicts == ForceOnConflict {
+		opts = append(opts, client.ForceOwnership)
+	}
+
+	if err := a.Client.Apply(ctx, obj, opts...); err != nil {
+		return fmt.Errorf("failed to apply %s: %w", describe(obj), err)
+	}
+
+	return nil
+}
+
+// ApplyStatus applies the status described by the apply configuration through the status subresource.
+// On success, the apply configuration is updated with the object returned by the API server.
+func (a *Applier) ApplyStatus(ctx context.Context, obj runtime.ApplyConfiguration) error {
+	if a.FieldManager == "" {
+		return ErrNoFieldManager
+	}
+
+	opts := []client.SubResourceApplyOption{client.FieldOwner(a.FieldManager)}
+	if a.Conflicts == ForceOnConflict {
+		opts = append(opts, client.ForceOwnership)
+	}
+
+	if err := a.Status().Apply(ctx, obj, opts...); err != nil {
+		return fmt.Errorf("failed to apply status of %s: %w", describe(obj), err)
+	}
+
+	return nil
+}
+
+// ExtractFunc extracts the fields owned by a field manager from an object into an apply configuration,
+// such as applycorev1.ExtractConfigMap.
+type ExtractFunc[T client.Object, AC runtime.ApplyConfiguration] func(obj T, fieldManager string) (AC, error)
+
+// NewFunc returns an empty apply configuration for the object with the given name and namespace,
+// such as applycorev1.ConfigMap.
+type NewFunc[AC runtime.ApplyConfiguration] func(name, namespace string) AC
+
+// ExtractModifyApply reads the object with the given key into obj, extracts the fields owned by the applier's field manager,
+// lets modify change them and applies the result. When the object does not exist, modify starts from an empty
+// apply configuration. This is the flow to use for read-modify-write changes, such as adding an entry to a map,
+// without taking ownership of the fields of other managers.
+//
+// Example:
+//
+//	err := apply.ExtractModifyApply(ctx, applier, key, &corev1.ConfigMap{}, applycorev1.ExtractConfigMap, applycorev1.ConfigMap,
+//	    func(cm *applycorev1.ConfigMapApplyConfiguration) error {
+//	        cm.WithData(map[string]string{"key": "value"})
+//	        return nil
+//	    })
+func ExtractModifyApply[T client.Object, AC runtime.ApplyConfiguration](
+	ctx context.Context,
+	a *Applier,
+	key client.ObjectKey,
+	obj T,
+	extract ExtractFunc[T, AC],
+	newApplyConfiguration NewFunc[AC],
+	modify func(AC) error,
+) error {
+	if a.FieldManager == "" {
+		return ErrNoFieldManager
+	}
+
+	var applyConfiguration AC
+
+	if err := a.Get(ctx, key, obj); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get %T %s: %w", obj, key.String(), err)
+		}
+
+		applyConfiguration = newApplyConfiguration(key.Name, key.Namespace)
+	} else {
+		if applyConfiguration, err = extract(obj, a.FieldManager); err != nil {
+			return fmt.Errorf("failed to extract the fields of %T %s owned by %q: %w", obj, key.String(), a.FieldManager, err)
+		}
+	}
+
+	if err := modify(applyConfiguration); err != nil {
+		return err
+	}
+
+	return a.Apply(ctx, applyConfiguration)
+}
+
+// describe returns a description of an apply configuration for error messages.
+func describe(obj runtime.ApplyConfiguration) string {
+	named, ok := obj.(interface{ GetName() *string })
+	if !ok || named.GetName() == nil {
+		return fmt.Sprintf("%T", obj)
+	}
+
+	if namespaced, ok := obj.(interface{ GetNamespace() *string }); ok && namespaced.GetNamespace() != nil && *namespaced.GetNamespace() != "" {
+		return fmt.Sprintf("%T %s/%s", obj, *namespaced.GetNamespace(), *named.GetName())
+	}
+
+	return fmt.Sprintf("%T %s", obj, *named.GetName())
+}
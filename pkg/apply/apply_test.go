@@ -0,0 +1,106 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apply
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	applycorev1 "k8s.io/client-go/applyconfigurations/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Applier", func() {
+	var (
+		fakeClient client.Client
+		applier    *Applier
+	)
+
+	key := client.ObjectKey{Namespace: "operator", Name: "operand-config"}
+
+	BeforeEach(func() {
+		fakeClient = fake.NewClientBuilder().WithReturnManagedFields().Build()
+		applier = &Applier{Client: fakeClient, FieldManager: FieldManager("my-operator", "operand")}
+	})
+
+	It("should build field manager names", func() {
+		Expect(FieldManager("my-operator", "operand")).To(Equal("my-operator/operand"))
+		Expect(FieldManager(strings.Repeat("o", 100), strings.Repeat("c", 100))).To(HaveLen(128))
+	})
+
+	It("should require a field manager", func() {
+		applier.FieldManager = ""
+		Expect(applier.Apply(ctx, applycorev1.ConfigMap(key.Name, key.Namespace))).To(MatchError(ErrNoFieldManager))
+	})
+
+	It("should apply objects as the field manager", func() {
+		Expect(applier.Apply(ctx, applycorev1.ConfigMap(key.Name, key.Namespace).WithData(map[string]string{"a": "1"}))).To(Succeed())
+
+		configMap := &corev1.ConfigMap{}
+		Expect(fakeClient.Get(ctx, key, configMap)).To(Succeed())
+		Expect(configMap.Data).To(Equal(map[string]string{"a": "1"}))
+		Expect(configMap.ManagedFields).To(ContainElement(HaveField("Manager", "my-operator/operand")))
+	})
+
+	It("should fail on conflicts when asked to", func() {
+		other := &Applier{Client: fakeClient, FieldManager: "someone-else"}
+		Expect(other.Apply(ctx, applycorev1.ConfigMap(key.Name, key.Namespace).WithData(map[string]string{"a": "1"}))).To(Succeed())
+
+		applier.Conflicts = FailOnConflict
+		Expect(applier.Apply(ctx, applycorev1.ConfigMap(key.Name, key.Namespace).WithData(map[string]string{"a": "2"}))).NotTo(Succeed())
+
+		applier.Conflicts = ForceOnConflict
+		Expect(applier.Apply(ctx, applycorev1.ConfigMap(key.Name, key.Namespace).WithData(map[string]string{"a": "2"}))).To(Succeed())
+	})
+
+	It("should only reapply the fields it owns with ExtractModifyApply", func() {
+		other := &Applier{Client: fakeClient, FieldManager: "someone-else"}
+		Expect(other.Apply(ctx, applycorev1.ConfigMap(key.Name, key.Namespace).WithData(map[string]string{"theirs": "1"}))).To(Succeed())
+
+		addEntry := func(name, value string) func(*applycorev1.ConfigMapApplyConfiguration) error {
+			return func(cm *applycorev1.ConfigMapApplyConfiguration) error {
+				cm.WithData(map[string]string{name: value})
+				return nil
+			}
+		}
+
+		Expect(ExtractModifyApply(ctx, applier, key, &corev1.ConfigMap{}, applycorev1.ExtractConfigMap, applycorev1.ConfigMap,
+			addEntry("mine", "1"))).To(Succeed())
+		Expect(ExtractModifyApply(ctx, applier, key, &corev1.ConfigMap{}, applycorev1.ExtractConfigMap, applycorev1.ConfigMap,
+			addEntry("also-mine", "2"))).To(Succeed())
+
+		configMap := &corev1.ConfigMap{}
+		Expect(fakeClient.Get(ctx, key, configMap)).To(Succeed())
+		Expect(configMap.Data).To(Equal(map[string]string{"theirs": "1", "mine": "1", "also-mine": "2"}))
+	})
+
+	It("should create the object when it does not exist with ExtractModifyApply", func() {
+		Expect(ExtractModifyApply(ctx, applier, key, &corev1.ConfigMap{}, applycorev1.ExtractConfigMap, applycorev1.ConfigMap,
+			func(cm *applycorev1.ConfigMapApplyConfiguration) error {
+				cm.WithImmutable(true)
+				return nil
+			})).To(Succeed())
+
+		configMap := &corev1.ConfigMap{}
+		Expect(fakeClient.Get(ctx, key, configMap)).To(Succeed())
+		Expect(configMap.Immutable).To(Equal(ptr.To(true)))
+	})
+})
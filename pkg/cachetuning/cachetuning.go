@@ -0,0 +1,130 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cachetuning reduces the memory footprint of the cache of a manager, restricting the cached objects with
+// selectors and stripping the fields operators do not read, and reports the number of cached objects.
+package cachetuning
+
+import (
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Options tune the memory footprint of a cache.
+type Options struct {
+	// ByObject restricts and transforms the cached objects by kind, e.g. with a label selector on Secrets. Their
+	// transform funcs run after the fields are stripped.
+	ByObject map[client.Object]cache.ByObject
+
+	// KeepManagedFields keeps the managed fields of the cached objects, which are stripped by default.
+	KeepManagedFields bool
+
+	// KeepLastAppliedConfiguration keeps the last-applied-configuration annotation of kubectl on the cached objects,
+	// which is stripped by default.
+	KeepLastAppliedConfiguration bool
+}
+
+// CacheOptions returns the base cache options with the tuning of opts: the selectors and transform funcs of their
+// kinds, and the stripping of the managed fields and of the last-applied-configuration annotation, which often
+// account for most of the size of cached objects, chained before the transform funcs of base and opts.
+//
+// Example:
+//
+//	managerOptions.Cache = cachetuning.CacheOptions(managerOptions.Cache, cachetuning.Options{
+//	    ByObject: map[client.Object]cache.ByObject{
+//	        &corev1.Secret{}: {Label: labels.SelectorFromSet(labels.Set{"app": "example"})},
+//	    },
+//	})
+func CacheOptions(base cache.Options, opts Options) cache.Options {
+	var strip []toolscache.TransformFunc
+	if !opts.KeepManagedFields {
+		strip = append(strip, cache.TransformStripManagedFields())
+	}
+
+	if !opts.KeepLastAppliedConfiguration {
+		strip = append(strip, TransformStripLastAppliedConfiguration())
+	}
+
+	options := base
+	options.DefaultTransform = Chain(append(strip, base.DefaultTransform)...)
+
+	options.ByObject = maps.Clone(base.ByObject)
+	if options.ByObject == nil && len(opts.ByObject) > 0 {
+		options.ByObject = make(map[client.Object]cache.ByObject, len(opts.ByObject))
+	}
+
+	maps.Copy(options.ByObject, opts.ByObject)
+
+	// The transform funcs of objects replace the default one, so the fields are stripped by each of them as well.
+	for obj, byObject := range options.ByObject {
+		if byObject.Transform != nil {
+			byObject.Transform = Chain(append(strip, byObject.Transform)...)
+			options.ByObject[obj] = byObject
+		}
+	}
+
+	return options
+}
+
+// TransformStripLastAppliedConfiguration strips the last-applied-configuration annotation of kubectl, a copy of the
+// whole object, from an object before it is cached.
+func TransformStripLastAppliedConfiguration() toolscache.TransformFunc {
+	return func(in any) (any, error) {
+		// Tombstones of deleted objects are not transformed.
+		if obj, err := meta.Accessor(in); err == nil {
+			if _, ok := obj.GetAnnotations()[corev1.LastAppliedConfigAnnotation]; ok {
+				annotations := obj.GetAnnotations()
+				delete(annotations, corev1.LastAppliedConfigAnnotation)
+				obj.SetAnnotations(annotations)
+			}
+		}
+
+		return in, nil
+	}
+}
+
+// Chain returns a transform func applying the non-nil transform funcs in order, or nil when there is none.
+func Chain(transforms ...toolscache.TransformFunc) toolscache.TransformFunc {
+	var chain []toolscache.TransformFunc
+
+	for _, transform := range transforms {
+		if transform != nil {
+			chain = append(chain, transform)
+		}
+	}
+
+	if len(chain) == 0 {
+		return nil
+	}
+
+	return func(in any) (any, error) {
+		out := in
+
+		for _, transform := range chain {
+			var err error
+			if out, err = transform(out); err != nil {
+				return nil, err
+			}
+		}
+
+		return out, nil
+	}
+}
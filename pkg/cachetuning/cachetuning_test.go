@@ -0,0 +1,132 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cachetuning
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// newConfigMap returns a ConfigMap with managed fields and the last-applied-configuration annotation.
+func newConfigMap() *corev1.ConfigMap {
+	return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+		Name:          "config",
+		ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "kubectl"}},
+		Annotations: map[string]string{
+			corev1.LastAppliedConfigAnnotation: "{}",
+			"example.com/kept":                 "true",
+		},
+	}}
+}
+
+// markTransformed is a transform func annotating objects.
+func markTransformed(in any) (any, error) {
+	obj, ok := in.(client.Object)
+	if !ok {
+		return nil, errors.New("not an object")
+	}
+
+	obj.GetAnnotations()["example.com/transformed"] = "true"
+
+	return obj, nil
+}
+
+var _ = Describe("CacheOptions", func() {
+	It("should strip the managed fields and the last-applied-configuration annotation", func() {
+		options := CacheOptions(cache.Options{}, Options{})
+
+		out, err := options.DefaultTransform(newConfigMap())
+		Expect(err).NotTo(HaveOccurred())
+
+		configMap, ok := out.(*corev1.ConfigMap)
+		Expect(ok).To(BeTrue())
+		Expect(configMap.ManagedFields).To(BeEmpty())
+		Expect(configMap.Annotations).To(Equal(map[string]string{"example.com/kept": "true"}))
+	})
+
+	It("should keep the fields when asked", func() {
+		options := CacheOptions(cache.Options{}, Options{KeepManagedFields: true, KeepLastAppliedConfiguration: true})
+
+		Expect(options.DefaultTransform).To(BeNil())
+	})
+
+	It("should chain the transform funcs after stripping the fields", func() {
+		selector := labels.SelectorFromSet(labels.Set{"app": "example"})
+		base := cache.Options{
+			DefaultTransform: markTransformed,
+			ByObject:         map[client.Object]cache.ByObject{&corev1.Secret{}: {Label: selector}},
+		}
+
+		options := CacheOptions(base, Options{ByObject: map[client.Object]cache.ByObject{
+			&corev1.ConfigMap{}: {Transform: markTransformed},
+		}})
+
+		Expect(options.ByObject).To(HaveLen(2))
+		Expect(base.ByObject).To(HaveLen(1))
+
+		for obj, byObject := range options.ByObject {
+			switch obj.(type) {
+			case *corev1.Secret:
+				Expect(byObject.Label).To(Equal(selector))
+				Expect(byObject.Transform).To(BeNil())
+			case *corev1.ConfigMap:
+				out, err := byObject.Transform(newConfigMap())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(out.(*corev1.ConfigMap).ManagedFields).To(BeEmpty())
+				Expect(out.(*corev1.ConfigMap).Annotations).To(HaveKey("example.com/transformed"))
+			}
+		}
+
+		out, err := options.DefaultTransform(newConfigMap())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.(*corev1.ConfigMap).Annotations).To(Equal(map[string]string{
+			"example.com/kept":        "true",
+			"example.com/transformed": "true",
+		}))
+	})
+})
+
+var _ = Describe("TransformStripLastAppliedConfiguration", func() {
+	It("should leave tombstones untouched", func() {
+		tombstone := toolscache.DeletedFinalStateUnknown{Key: "config", Obj: newConfigMap()}
+
+		out, err := TransformStripLastAppliedConfiguration()(tombstone)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(Equal(tombstone))
+	})
+})
+
+var _ = Describe("Chain", func() {
+	It("should return nil without transform funcs", func() {
+		Expect(Chain(nil, nil)).To(BeNil())
+	})
+
+	It("should stop at the first error", func() {
+		transform := Chain(func(any) (any, error) { return nil, errors.New("boom") }, markTransformed)
+
+		_, err := transform(newConfigMap())
+		Expect(err).To(MatchError("boom"))
+	})
+})
@@ -0,0 +1,149 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cachetuning
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openshift/controller-runtime-common/pkg/metricsregistry"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/runtime"
+	toolscache "k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+var cachedObjects = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "cache_objects",
+	Help: "Number of objects in the cache of the manager, by kind.",
+}, []string{"kind"})
+
+func init() {
+	metricsregistry.Add(cachedObjects)
+}
+
+// ObjectCounter reports the number of cached objects of the given kinds in the cache_objects metric, to size the
+// cache of an operator and check the effect of its selectors.
+//
+// Example:
+//
+//	counter := &cachetuning.ObjectCounter{Objects: []client.Object{&corev1.Secret{}, &corev1.ConfigMap{}}}
+//	if err := counter.SetupWithManager(mgr); err != nil {
+//	    return err
+//	}
+type ObjectCounter struct {
+	// Cache is the cache holding the objects. Defaults to the cache of the manager.
+	Cache cache.Cache
+	// Scheme resolves the kinds of the objects. Defaults to the scheme of the manager.
+	Scheme *runtime.Scheme
+
+	// Objects are the kinds counted, which should already be cached by the operator, as counting them gets their
+	// informers. PartialObjectMetadata objects count the metadata-only informer of their kind.
+	Objects []client.Object
+}
+
+// SetupWithManager adds the ObjectCounter to the manager.
+func (c *ObjectCounter) SetupWithManager(mgr ctrl.Manager) error {
+	if c.Cache == nil {
+		c.Cache = mgr.GetCache()
+	}
+
+	if c.Scheme == nil {
+		c.Scheme = mgr.GetScheme()
+	}
+
+	if err := mgr.Add(c); err != nil {
+		return fmt.Errorf("failed to add cached object counter to manager: %w", err)
+	}
+
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+// The cache is filled on every replica, so the ObjectCounter runs on all of them.
+func (c *ObjectCounter) NeedLeaderElection() bool {
+	return false
+}
+
+// Start counts the objects added to and deleted from the informers of the kinds until the context is done.
+func (c *ObjectCounter) Start(ctx context.Context) error {
+	registrations, err := c.register(ctx)
+	defer unregister(registrations)
+
+	if err != nil {
+		return err
+	}
+
+	<-ctx.Done()
+
+	return nil
+}
+
+// counterRegistration is the event handler counting the cached objects of a kind.
+type counterRegistration struct {
+	informer     cache.Informer
+	registration toolscache.ResourceEventHandlerRegistration
+	kind         string
+}
+
+// register adds the event handlers counting the cached objects of the kinds, returning those added until the first
+// failure.
+func (c *ObjectCounter) register(ctx context.Context) ([]counterRegistration, error) {
+	registrations := make([]counterRegistration, 0, len(c.Objects))
+
+	for _, obj := range c.Objects {
+		gvk, err := apiutil.GVKForObject(obj, c.Scheme)
+		if err != nil {
+			return registrations, fmt.Errorf("failed to get GroupVersionKind of %T: %w", obj, err)
+		}
+
+		kind := gvk.GroupKind().String()
+
+		informer, err := c.Cache.GetInformer(ctx, obj)
+		if err != nil {
+			return registrations, fmt.Errorf("failed to get informer of %s: %w", kind, err)
+		}
+
+		gauge := cachedObjects.WithLabelValues(kind)
+		gauge.Set(0)
+
+		registration, err := informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+			AddFunc:    func(any) { gauge.Inc() },
+			DeleteFunc: func(any) { gauge.Dec() },
+		})
+		if err != nil {
+			return registrations, fmt.Errorf("failed to add event handler to informer of %s: %w", kind, err)
+		}
+
+		registrations = append(registrations, counterRegistration{informer: informer, registration: registration, kind: kind})
+	}
+
+	return registrations, nil
+}
+
+// unregister removes the event handlers and the counts of their kinds.
+func unregister(registrations []counterRegistration) {
+	for _, r := range registrations {
+		// The informers may be stopped already, leaving nothing to remove.
+		_ = r.informer.RemoveEventHandler(r.registration)
+
+		cachedObjects.DeleteLabelValues(r.kind)
+	}
+}
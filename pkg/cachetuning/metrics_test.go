@@ -0,0 +1,71 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cachetuning
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("ObjectCounter", func() {
+	It("should count the cached objects by kind", func() {
+		ctx := context.Background()
+		informers := &informertest.FakeInformers{}
+		counter := &ObjectCounter{
+			Cache:   informers,
+			Scheme:  scheme.Scheme,
+			Objects: []client.Object{&corev1.Secret{}, &appsv1.Deployment{}},
+		}
+
+		registrations, err := counter.register(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(registrations).To(HaveLen(2))
+
+		secrets, err := informers.FakeInformerFor(ctx, &corev1.Secret{})
+		Expect(err).NotTo(HaveOccurred())
+
+		deployments, err := informers.FakeInformerFor(ctx, &appsv1.Deployment{})
+		Expect(err).NotTo(HaveOccurred())
+
+		secrets.Add(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "first"}})
+		secrets.Add(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "second"}})
+		secrets.Delete(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "first"}})
+		deployments.Add(&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "operand"}})
+
+		Expect(testutil.ToFloat64(cachedObjects.WithLabelValues("Secret"))).To(Equal(1.0))
+		Expect(testutil.ToFloat64(cachedObjects.WithLabelValues("Deployment.apps"))).To(Equal(1.0))
+
+		unregister(registrations)
+		Expect(testutil.CollectAndCount(cachedObjects)).To(BeZero())
+	})
+
+	It("should fail for kinds missing from the scheme", func() {
+		counter := &ObjectCounter{Cache: &informertest.FakeInformers{}, Scheme: scheme.Scheme, Objects: []client.Object{&struct{ corev1.Secret }{}}}
+
+		_, err := counter.register(context.Background())
+		Expect(err).To(MatchError(ContainSubstring("failed to get GroupVersionKind")))
+	})
+})
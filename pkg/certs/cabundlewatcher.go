@@ -0,0 +1,234 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// TrustedCABundleKey is the key under which the cluster network operator injects
+	// the trusted CA bundle into ConfigMaps.
+	TrustedCABundleKey = "ca-bundle.crt"
+
+	// InjectTrustedCABundleLabel is the label that requests the cluster network operator
+	// to inject the trusted CA bundle, including the proxy's additional trusted CAs, into a ConfigMap.
+	InjectTrustedCABundleLabel = "config.openshift.io/inject-trusted-cabundle"
+)
+
+// ErrNoCertificatesInBundle is returned when a CA bundle does not contain any PEM encoded certificate.
+var ErrNoCertificatesInBundle = errors.New("no PEM encoded certificates found in CA bundle")
+
+// NewCertPoolFromPEM returns a certificate pool containing the PEM encoded certificates in the bundle.
+// When includeSystemRoots is true, the certificates are added to a copy of the system pool.
+func NewCertPoolFromPEM(bundle []byte, includeSystemRoots bool) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+
+	if includeSystemRoots {
+		systemPool, err := x509.SystemCertPool()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load system certificate pool: %w", err)
+		}
+
+		pool = systemPool
+	}
+
+	if !pool.AppendCertsFromPEM(bundle) {
+		return nil, ErrNoCertificatesInBundle
+	}
+
+	return pool, nil
+}
+
+// caBundle is an immutable snapshot of a CA bundle and the pool built from it.
+type caBundle struct {
+	pem  []byte
+	pool *x509.CertPool
+}
+
+// CABundleWatcher watches a ConfigMap containing a PEM encoded CA bundle, such as the trusted CA bundle
+// injected by the cluster network operator into ConfigMaps labeled with InjectTrustedCABundleLabel,
+// and keeps a certificate pool built from it up to date.
+//
+// The watcher runs on every replica, regardless of leader election, as every replica makes outbound connections.
+type CABundleWatcher struct {
+	client.Client
+
+	// ConfigMap is the namespace and name of the ConfigMap to watch.
+	ConfigMap types.NamespacedName
+
+	// Key is the key of the CA bundle in the ConfigMap data.
+	// Defaults to TrustedCABundleKey.
+	Key string
+
+	// IncludeSystemRoots adds the bundle to the system roots rather than using it on its own.
+	// The injected trusted CA bundle already contains the system roots, so this is usually not needed for it.
+	IncludeSystemRoots bool
+
+	// OnChange is a function that will be called when the CA bundle changes.
+	// It receives the reconcile context and the new certificate pool.
+	OnChange func(ctx context.Context, pool *x509.CertPool)
+
+	bundle atomic.Pointer[caBundle]
+}
+
+// Load reads the CA bundle from the ConfigMap using the given reader.
+// It is intended to be called with the manager's APIReader before the manager is started,
+// so that clients built before the first reconcile already trust the bundle.
+func (r *CABundleWatcher) Load(ctx context.Context, reader client.Reader) error {
+	configMap := &corev1.ConfigMap{}
+	if err := reader.Get(ctx, r.ConfigMap, configMap); err != nil {
+		return fmt.Errorf("failed to get ConfigMap %s: %w", r.ConfigMap.String(), err)
+	}
+
+	bundle, err := r.parse(configMap)
+	if err != nil {
+		return err
+	}
+
+	r.bundle.Store(bundle)
+
+	return nil
+}
+
+// CertPool returns the current certificate pool, or nil if no bundle has been loaded yet.
+// The returned pool must not be modified.
+func (r *CABundleWatcher) CertPool() *x509.CertPool {
+	if bundle := r.bundle.Load(); bundle != nil {
+		return bundle.pool
+	}
+
+	return nil
+}
+
+// TLSClientConfig returns a copy of base, or of an empty config if base is nil,
+// with RootCAs set to the current certificate pool.
+// The returned config is a snapshot: use OnChange to build a new one when the bundle changes.
+func (r *CABundleWatcher) TLSClientConfig(base *tls.Config) *tls.Config {
+	tlsConf := &tls.Config{MinVersion: tls.VersionTLS12}
+	if base != nil {
+		tlsConf = base.Clone()
+	}
+
+	tlsConf.RootCAs = r.CertPool()
+
+	return tlsConf
+}
+
+// ConfigureTransport sets the transport's TLSClientConfig.RootCAs to the current certificate pool,
+// keeping any other TLS settings of the transport.
+// Like TLSClientConfig, this is a snapshot: connections already established keep using the old pool.
+func (r *CABundleWatcher) ConfigureTransport(transport *http.Transport) {
+	transport.TLSClientConfig = r.TLSClientConfig(transport.TLSClientConfig)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CABundleWatcher) SetupWithManager(mgr ctrl.Manager) error {
+	isWatchedConfigMap := func(obj client.Object) bool {
+		return obj.GetNamespace() == r.ConfigMap.Namespace && obj.GetName() == r.ConfigMap.Name
+	}
+
+	if err := ctrl.NewControllerManagedBy(mgr).
+		Named("cabundlewatcher").
+		WithOptions(controller.Options{NeedLeaderElection: ptr.To(false)}).
+		For(&corev1.ConfigMap{}, builder.WithPredicates(predicate.NewPredicateFuncs(isWatchedConfigMap))).
+		// Override the default log constructor as it makes the logs very chatty.
+		WithLogConstructor(func(_ *reconcile.Request) logr.Logger {
+			return mgr.GetLogger().WithValues(
+				"controller", "cabundlewatcher",
+				"configMap", r.ConfigMap.String(),
+			)
+		}).
+		Complete(r); err != nil {
+		return fmt.Errorf("could not set up controller for CA bundle watcher: %w", err)
+	}
+
+	return nil
+}
+
+// Reconcile rebuilds the certificate pool from the ConfigMap and invokes the callback when the bundle has changed.
+func (r *CABundleWatcher) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	logger.V(1).Info("Reconciling CA bundle ConfigMap")
+	defer logger.V(1).Info("Finished reconciling CA bundle ConfigMap")
+
+	configMap := &corev1.ConfigMap{}
+	if err := r.Get(ctx, req.NamespacedName, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Keep trusting the current bundle, the ConfigMap is expected to be recreated.
+			logger.Info("CA bundle ConfigMap not found, keeping the current bundle")
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get ConfigMap %s: %w", req.NamespacedName.String(), err)
+	}
+
+	newBundle, err := r.parse(configMap)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if oldBundle := r.bundle.Load(); oldBundle != nil && bytes.Equal(oldBundle.pem, newBundle.pem) {
+		return ctrl.Result{}, nil
+	}
+
+	r.bundle.Store(newBundle)
+	logger.Info("CA bundle changed")
+
+	if r.OnChange != nil {
+		r.OnChange(ctx, newBundle.pool)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *CABundleWatcher) parse(configMap *corev1.ConfigMap) (*caBundle, error) {
+	key := r.Key
+	if key == "" {
+		key = TrustedCABundleKey
+	}
+
+	pem := []byte(configMap.Data[key])
+
+	pool, err := NewCertPoolFromPEM(pem, r.IncludeSystemRoots)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CA bundle from ConfigMap %s/%s key %q: %w", configMap.Namespace, configMap.Name, key, err)
+	}
+
+	return &caBundle{pem: pem, pool: pool}, nil
+}
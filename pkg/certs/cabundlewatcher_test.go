@@ -0,0 +1,118 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"context"
+	"crypto/x509"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("CABundleWatcher", func() {
+	var (
+		fakeClient client.Client
+		configMap  *corev1.ConfigMap
+		watcher    *CABundleWatcher
+		changes    []*x509.CertPool
+	)
+
+	key := types.NamespacedName{Namespace: "operator", Name: "trusted-ca-bundle"}
+	req := ctrl.Request{NamespacedName: key}
+
+	setBundle := func(commonName string) {
+		certPEM, _ := generateTestKeyPair(commonName)
+		configMap.Data = map[string]string{TrustedCABundleKey: string(certPEM)}
+	}
+
+	BeforeEach(func() {
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: key.Namespace,
+				Name:      key.Name,
+				Labels:    map[string]string{InjectTrustedCABundleLabel: "true"},
+			},
+		}
+		setBundle("initial-ca")
+		fakeClient = fake.NewClientBuilder().WithObjects(configMap).Build()
+
+		changes = nil
+		watcher = &CABundleWatcher{
+			Client:    fakeClient,
+			ConfigMap: key,
+			OnChange: func(_ context.Context, pool *x509.CertPool) {
+				changes = append(changes, pool)
+			},
+		}
+	})
+
+	It("should load the bundle into a pool", func() {
+		Expect(watcher.CertPool()).To(BeNil())
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+		Expect(watcher.CertPool()).NotTo(BeNil())
+		Expect(changes).To(BeEmpty())
+	})
+
+	It("should only invoke the callback when the bundle changes", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+		initialPool := watcher.CertPool()
+
+		_, err := watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+
+		setBundle("rotated-ca")
+		Expect(fakeClient.Update(ctx, configMap)).To(Succeed())
+
+		_, err = watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(HaveLen(1))
+		Expect(changes[0]).To(BeIdenticalTo(watcher.CertPool()))
+		Expect(watcher.CertPool().Equal(initialPool)).To(BeFalse())
+	})
+
+	It("should keep the current bundle when the ConfigMap holds no certificates", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+		initialPool := watcher.CertPool()
+
+		configMap.Data[TrustedCABundleKey] = "not a certificate"
+		Expect(fakeClient.Update(ctx, configMap)).To(Succeed())
+
+		_, err := watcher.Reconcile(ctx, req)
+		Expect(err).To(MatchError(ErrNoCertificatesInBundle))
+		Expect(watcher.CertPool()).To(BeIdenticalTo(initialPool))
+	})
+
+	It("should configure a transport with the current pool, keeping other TLS settings", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+
+		transport := &http.Transport{}
+		watcher.ConfigureTransport(transport)
+
+		Expect(transport.TLSClientConfig).NotTo(BeNil())
+		Expect(transport.TLSClientConfig.RootCAs).To(BeIdenticalTo(watcher.CertPool()))
+		Expect(transport.TLSClientConfig.MinVersion).NotTo(BeZero())
+	})
+})
@@ -0,0 +1,303 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// CABundleSourceKind is the kind of object a CABundleInjector reads the CA bundle from.
+type CABundleSourceKind string
+
+const (
+	// CABundleSourceSecret reads the CA bundle from a Secret, such as the one written by SelfSignedServingCert.
+	CABundleSourceSecret CABundleSourceKind = "Secret"
+	// CABundleSourceConfigMap reads the CA bundle from a ConfigMap.
+	CABundleSourceConfigMap CABundleSourceKind = "ConfigMap"
+)
+
+var (
+	// ErrNoTargetSelector is returned when a CABundleInjector is set up without a target selector.
+	ErrNoTargetSelector = errors.New("a target selector is required to avoid injecting into unrelated objects")
+	// ErrEmptyCABundle is returned when the CA bundle source does not contain a bundle.
+	ErrEmptyCABundle = errors.New("CA bundle is empty")
+)
+
+// CABundleInjector keeps the caBundle fields of ValidatingWebhookConfigurations, MutatingWebhookConfigurations
+// and CustomResourceDefinition conversion webhooks in sync with a CA bundle held in a Secret or ConfigMap.
+//
+// Only objects matching TargetSelector are modified. The whole bundle is injected, so that when the source
+// carries both the current and the previous CA during a rotation, as SelfSignedServingCert does,
+// API servers keep trusting certificates issued by either CA.
+//
+// The manager scheme must include admissionregistration/v1 and, when InjectCRDs is set, apiextensions/v1.
+type CABundleInjector struct {
+	client.Client
+
+	// SourceKind is the kind of object holding the CA bundle.
+	SourceKind CABundleSourceKind
+
+	// Source is the namespace and name of the object holding the CA bundle.
+	Source types.NamespacedName
+
+	// SourceKey is the key of the CA bundle in the source data. Defaults to CACertKey.
+	SourceKey string
+
+	// TargetSelector selects the objects to inject the CA bundle into.
+	TargetSelector labels.Selector
+
+	// InjectCRDs also injects the CA bundle into the conversion webhooks of selected CustomResourceDefinitions.
+	InjectCRDs bool
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CABundleInjector) SetupWithManager(mgr ctrl.Manager) error {
+	if r.TargetSelector == nil {
+		return ErrNoTargetSelector
+	}
+
+	source, err := r.sourceObject()
+	if err != nil {
+		return err
+	}
+
+	// Every event results in the same request, which syncs all targets.
+	enqueueSync := handler.EnqueueRequestsFromMapFunc(func(context.Context, client.Object) []reconcile.Request {
+		return []reconcile.Request{{NamespacedName: r.Source}}
+	})
+
+	isSource := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetNamespace() == r.Source.Namespace && obj.GetName() == r.Source.Name
+	})
+
+	isTarget := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return r.TargetSelector.Matches(labels.Set(obj.GetLabels()))
+	})
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named("cabundleinjector").
+		Watches(source, enqueueSync, builder.WithPredicates(isSource)).
+		Watches(&admissionregistrationv1.ValidatingWebhookConfiguration{}, enqueueSync, builder.WithPredicates(isTarget)).
+		Watches(&admissionregistrationv1.MutatingWebhookConfiguration{}, enqueueSync, builder.WithPredicates(isTarget)).
+		// Override the default log constructor as it makes the logs very chatty.
+		WithLogConstructor(func(_ *reconcile.Request) logr.Logger {
+			return mgr.GetLogger().WithValues(
+				"controller", "cabundleinjector",
+				"source", r.Source.String(),
+			)
+		})
+
+	if r.InjectCRDs {
+		b = b.Watches(&apiextensionsv1.CustomResourceDefinition{}, enqueueSync, builder.WithPredicates(isTarget))
+	}
+
+	if err := b.Complete(r); err != nil {
+		return fmt.Errorf("could not set up controller for CA bundle injector: %w", err)
+	}
+
+	return nil
+}
+
+// Reconcile injects the current CA bundle into all selected objects.
+func (r *CABundleInjector) Reconcile(ctx context.Context, _ ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	logger.V(1).Info("Reconciling CA bundle injection")
+	defer logger.V(1).Info("Finished reconciling CA bundle injection")
+
+	caBundle, err := r.caBundle(ctx)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// Nothing to inject yet, the source will trigger a reconcile once it is created.
+			logger.Info("CA bundle source not found, skipping injection")
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, err
+	}
+
+	errs := []error{
+		r.injectValidatingWebhooks(ctx, caBundle),
+		r.injectMutatingWebhooks(ctx, caBundle),
+	}
+
+	if r.InjectCRDs {
+		errs = append(errs, r.injectCRDs(ctx, caBundle))
+	}
+
+	return ctrl.Result{}, kerrors.NewAggregate(errs)
+}
+
+func (r *CABundleInjector) injectValidatingWebhooks(ctx context.Context, caBundle []byte) error {
+	list := &admissionregistrationv1.ValidatingWebhookConfigurationList{}
+	if err := r.List(ctx, list, client.MatchingLabelsSelector{Selector: r.TargetSelector}); err != nil {
+		return fmt.Errorf("failed to list ValidatingWebhookConfigurations: %w", err)
+	}
+
+	var errs []error
+
+	for i := range list.Items {
+		obj := &list.Items[i]
+		base := obj.DeepCopy()
+
+		changed := false
+		for j := range obj.Webhooks {
+			changed = setCABundle(&obj.Webhooks[j].ClientConfig.CABundle, caBundle) || changed
+		}
+
+		errs = append(errs, r.patchIfChanged(ctx, obj, base, changed))
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+func (r *CABundleInjector) injectMutatingWebhooks(ctx context.Context, caBundle []byte) error {
+	list := &admissionregistrationv1.MutatingWebhookConfigurationList{}
+	if err := r.List(ctx, list, client.MatchingLabelsSelector{Selector: r.TargetSelector}); err != nil {
+		return fmt.Errorf("failed to list MutatingWebhookConfigurations: %w", err)
+	}
+
+	var errs []error
+
+	for i := range list.Items {
+		obj := &list.Items[i]
+		base := obj.DeepCopy()
+
+		changed := false
+		for j := range obj.Webhooks {
+			changed = setCABundle(&obj.Webhooks[j].ClientConfig.CABundle, caBundle) || changed
+		}
+
+		errs = append(errs, r.patchIfChanged(ctx, obj, base, changed))
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+func (r *CABundleInjector) injectCRDs(ctx context.Context, caBundle []byte) error {
+	list := &apiextensionsv1.CustomResourceDefinitionList{}
+	if err := r.List(ctx, list, client.MatchingLabelsSelector{Selector: r.TargetSelector}); err != nil {
+		return fmt.Errorf("failed to list CustomResourceDefinitions: %w", err)
+	}
+
+	var errs []error
+
+	for i := range list.Items {
+		obj := &list.Items[i]
+		base := obj.DeepCopy()
+
+		conversion := obj.Spec.Conversion
+		if conversion == nil || conversion.Strategy != apiextensionsv1.WebhookConverter ||
+			conversion.Webhook == nil || conversion.Webhook.ClientConfig == nil {
+			continue
+		}
+
+		changed := setCABundle(&conversion.Webhook.ClientConfig.CABundle, caBundle)
+		errs = append(errs, r.patchIfChanged(ctx, obj, base, changed))
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+func (r *CABundleInjector) patchIfChanged(ctx context.Context, obj, base client.Object, changed bool) error {
+	if !changed {
+		return nil
+	}
+
+	if err := r.Patch(ctx, obj, client.MergeFrom(base)); err != nil {
+		return fmt.Errorf("failed to inject CA bundle into %T %s: %w", obj, obj.GetName(), err)
+	}
+
+	log.FromContext(ctx).Info("Injected CA bundle", "kind", fmt.Sprintf("%T", obj), "name", obj.GetName())
+
+	return nil
+}
+
+// setCABundle sets the field to the bundle and reports whether it changed.
+func setCABundle(field *[]byte, caBundle []byte) bool {
+	if bytes.Equal(*field, caBundle) {
+		return false
+	}
+
+	*field = caBundle
+
+	return true
+}
+
+func (r *CABundleInjector) sourceObject() (client.Object, error) {
+	switch r.SourceKind {
+	case CABundleSourceSecret:
+		return &corev1.Secret{}, nil
+	case CABundleSourceConfigMap:
+		return &corev1.ConfigMap{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported CA bundle source kind %q", r.SourceKind)
+	}
+}
+
+func (r *CABundleInjector) caBundle(ctx context.Context) ([]byte, error) {
+	key := r.SourceKey
+	if key == "" {
+		key = CACertKey
+	}
+
+	var caBundle []byte
+
+	switch r.SourceKind {
+	case CABundleSourceSecret:
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, r.Source, secret); err != nil {
+			return nil, fmt.Errorf("failed to get Secret %s: %w", r.Source.String(), err)
+		}
+
+		caBundle = secret.Data[key]
+	case CABundleSourceConfigMap:
+		configMap := &corev1.ConfigMap{}
+		if err := r.Get(ctx, r.Source, configMap); err != nil {
+			return nil, fmt.Errorf("failed to get ConfigMap %s: %w", r.Source.String(), err)
+		}
+
+		caBundle = []byte(configMap.Data[key])
+	default:
+		return nil, fmt.Errorf("unsupported CA bundle source kind %q", r.SourceKind)
+	}
+
+	if len(caBundle) == 0 {
+		return nil, fmt.Errorf("%w: %s %s key %q", ErrEmptyCABundle, r.SourceKind, r.Source.String(), key)
+	}
+
+	return caBundle, nil
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("CABundleInjector", func() {
+	var (
+		fakeClient client.Client
+		injector   *CABundleInjector
+		secret     *corev1.Secret
+	)
+
+	source := types.NamespacedName{Namespace: "operator", Name: "webhook-cert"}
+	managedLabels := map[string]string{"app.kubernetes.io/managed-by": "my-operator"}
+
+	validatingWebhook := func(name string, objLabels map[string]string) *admissionregistrationv1.ValidatingWebhookConfiguration {
+		return &admissionregistrationv1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: objLabels},
+			Webhooks: []admissionregistrationv1.ValidatingWebhook{
+				{Name: "a." + name + ".example.com"},
+				{Name: "b." + name + ".example.com"},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		testScheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(testScheme)).To(Succeed())
+		Expect(apiextensionsv1.AddToScheme(testScheme)).To(Succeed())
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: source.Namespace, Name: source.Name},
+			Data:       map[string][]byte{CACertKey: []byte("bundle-v1")},
+		}
+
+		fakeClient = fake.NewClientBuilder().WithScheme(testScheme).WithObjects(
+			secret,
+			validatingWebhook("managed", managedLabels),
+			validatingWebhook("unmanaged", nil),
+			&admissionregistrationv1.MutatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "managed", Labels: managedLabels},
+				Webhooks:   []admissionregistrationv1.MutatingWebhook{{Name: "m.example.com"}},
+			},
+			&apiextensionsv1.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: "widgets.example.com", Labels: managedLabels},
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Conversion: &apiextensionsv1.CustomResourceConversion{
+						Strategy: apiextensionsv1.WebhookConverter,
+						Webhook: &apiextensionsv1.WebhookConversion{
+							ClientConfig:             &apiextensionsv1.WebhookClientConfig{},
+							ConversionReviewVersions: []string{"v1"},
+						},
+					},
+				},
+			},
+		).Build()
+
+		injector = &CABundleInjector{
+			Client:         fakeClient,
+			SourceKind:     CABundleSourceSecret,
+			Source:         source,
+			TargetSelector: labels.SelectorFromSet(managedLabels),
+			InjectCRDs:     true,
+		}
+	})
+
+	reconcileAndExpect := func(bundle string) {
+		GinkgoHelper()
+		_, err := injector.Reconcile(ctx, ctrl.Request{NamespacedName: source})
+		Expect(err).NotTo(HaveOccurred())
+
+		vwc := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "managed"}, vwc)).To(Succeed())
+		for _, webhook := range vwc.Webhooks {
+			Expect(string(webhook.ClientConfig.CABundle)).To(Equal(bundle))
+		}
+
+		mwc := &admissionregistrationv1.MutatingWebhookConfiguration{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "managed"}, mwc)).To(Succeed())
+		Expect(string(mwc.Webhooks[0].ClientConfig.CABundle)).To(Equal(bundle))
+
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "widgets.example.com"}, crd)).To(Succeed())
+		Expect(string(crd.Spec.Conversion.Webhook.ClientConfig.CABundle)).To(Equal(bundle))
+	}
+
+	It("should inject the CA bundle into selected objects only", func() {
+		reconcileAndExpect("bundle-v1")
+
+		unmanaged := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "unmanaged"}, unmanaged)).To(Succeed())
+		Expect(unmanaged.Webhooks[0].ClientConfig.CABundle).To(BeEmpty())
+	})
+
+	It("should update the CA bundle when the source rotates", func() {
+		reconcileAndExpect("bundle-v1")
+
+		secret.Data[CACertKey] = []byte("bundle-v2\nbundle-v1")
+		Expect(fakeClient.Update(ctx, secret)).To(Succeed())
+
+		reconcileAndExpect("bundle-v2\nbundle-v1")
+	})
+
+	It("should read the CA bundle from a ConfigMap", func() {
+		Expect(fakeClient.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "operator", Name: "ca"},
+			Data:       map[string]string{"service-ca.crt": "configmap-bundle"},
+		})).To(Succeed())
+
+		injector.SourceKind = CABundleSourceConfigMap
+		injector.Source = types.NamespacedName{Namespace: "operator", Name: "ca"}
+		injector.SourceKey = "service-ca.crt"
+
+		reconcileAndExpect("configmap-bundle")
+	})
+
+	It("should not fail when the source does not exist yet", func() {
+		Expect(fakeClient.Delete(ctx, secret)).To(Succeed())
+
+		_, err := injector.Reconcile(ctx, ctrl.Request{NamespacedName: source})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should return an error when the source holds no bundle", func() {
+		secret.Data = nil
+		Expect(fakeClient.Update(ctx, secret)).To(Succeed())
+
+		_, err := injector.Reconcile(ctx, ctrl.Request{NamespacedName: source})
+		Expect(err).To(MatchError(ErrEmptyCABundle))
+	})
+})
@@ -0,0 +1,46 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"crypto/tls"
+)
+
+// CertificateSource provides the current serving certificate.
+// It is implemented by the certificate watchers in this package.
+type CertificateSource interface {
+	// GetCertificate returns the current certificate. It has the signature of tls.Config.GetCertificate.
+	GetCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error)
+}
+
+// DynamicTLSConfig returns a function that configures a tls.Config to serve the current certificate
+// of the given source, so that rotated certificates are picked up without restarting the server.
+// The returned function is intended to be used with controller-runtime's TLSOpts,
+// and can be combined with the function returned by tls.NewTLSConfigFromProfile.
+//
+// Example:
+//
+//	webhookServer := webhook.NewServer(webhook.Options{
+//	    TLSOpts: []func(*tls.Config){tlsProfileOpt, certs.DynamicTLSConfig(watcher)},
+//	})
+func DynamicTLSConfig(source CertificateSource) func(*tls.Config) {
+	return func(tlsConf *tls.Config) {
+		tlsConf.GetCertificate = source.GetCertificate
+		// Static certificates take precedence over GetCertificate, so drop any that were configured.
+		tlsConf.Certificates = nil
+	}
+}
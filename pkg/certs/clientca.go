@@ -0,0 +1,299 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync/atomic"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// ExtensionAPIServerAuthenticationNamespace is the namespace of the ConfigMap published by the kube-apiserver
+	// with the configuration needed to authenticate its clients.
+	ExtensionAPIServerAuthenticationNamespace = "kube-system"
+	// ExtensionAPIServerAuthenticationName is the name of the ConfigMap published by the kube-apiserver
+	// with the configuration needed to authenticate its clients.
+	ExtensionAPIServerAuthenticationName = "extension-apiserver-authentication"
+
+	clientCAFileKey                     = "client-ca-file"
+	requestHeaderClientCAFileKey        = "requestheader-client-ca-file"
+	requestHeaderUsernameHeadersKey     = "requestheader-username-headers"
+	requestHeaderGroupHeadersKey        = "requestheader-group-headers"
+	requestHeaderExtraHeaderPrefixesKey = "requestheader-extra-headers-prefix"
+	requestHeaderAllowedNamesKey        = "requestheader-allowed-names"
+)
+
+// ClientCAConfig is the client authentication configuration published in the
+// kube-system/extension-apiserver-authentication ConfigMap.
+type ClientCAConfig struct {
+	// ClientCA is the PEM encoded bundle of CAs used to verify client certificates.
+	ClientCA []byte
+	// ClientCAs is the pool built from ClientCA, or nil if ClientCA is empty.
+	ClientCAs *x509.CertPool
+
+	// RequestHeaderClientCA is the PEM encoded bundle of CAs used to verify the certificates of front proxies.
+	RequestHeaderClientCA []byte
+	// RequestHeaderClientCAs is the pool built from RequestHeaderClientCA, or nil if RequestHeaderClientCA is empty.
+	RequestHeaderClientCAs *x509.CertPool
+
+	// RequestHeaderUsernameHeaders are the headers front proxies use to pass the user name.
+	RequestHeaderUsernameHeaders []string
+	// RequestHeaderGroupHeaders are the headers front proxies use to pass the groups.
+	RequestHeaderGroupHeaders []string
+	// RequestHeaderExtraHeaderPrefixes are the header prefixes front proxies use to pass extra attributes.
+	RequestHeaderExtraHeaderPrefixes []string
+	// RequestHeaderAllowedNames are the common names front proxy certificates must carry. Empty allows any.
+	RequestHeaderAllowedNames []string
+}
+
+// equal reports whether two configurations carry the same data. The pools are derived from the bundles.
+func (c *ClientCAConfig) equal(other *ClientCAConfig) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+
+	a, b := *c, *other
+	a.ClientCAs, a.RequestHeaderClientCAs = nil, nil
+	b.ClientCAs, b.RequestHeaderClientCAs = nil, nil
+
+	return reflect.DeepEqual(a, b)
+}
+
+// ClientCAWatcher watches the kube-system/extension-apiserver-authentication ConfigMap and keeps the client CAs
+// and request header configuration it contains up to date, for aggregated API servers and metrics endpoints
+// that authenticate clients with certificates.
+//
+// The watcher runs on every replica, regardless of leader election, as each replica serves requests.
+// The operator needs RBAC to get, list and watch ConfigMaps in kube-system, which is usually granted
+// through the extension-apiserver-authentication-reader Role.
+type ClientCAWatcher struct {
+	client.Client
+
+	// ConfigMap is the namespace and name of the ConfigMap to watch.
+	// Defaults to kube-system/extension-apiserver-authentication.
+	ConfigMap types.NamespacedName
+
+	// OnChange is a function that will be called when the configuration changes.
+	// It receives the reconcile context and the new configuration.
+	OnChange func(ctx context.Context, config *ClientCAConfig)
+
+	config atomic.Pointer[ClientCAConfig]
+}
+
+// Load reads the configuration using the given reader.
+// It is intended to be called with the manager's APIReader before the manager is started.
+func (r *ClientCAWatcher) Load(ctx context.Context, reader client.Reader) error {
+	configMap := &corev1.ConfigMap{}
+	if err := reader.Get(ctx, r.configMapKey(), configMap); err != nil {
+		return fmt.Errorf("failed to get ConfigMap %s: %w", r.configMapKey().String(), err)
+	}
+
+	config, err := parseClientCAConfig(configMap)
+	if err != nil {
+		return err
+	}
+
+	r.config.Store(config)
+
+	return nil
+}
+
+// Config returns the current configuration, or nil if none has been loaded yet.
+// The returned value must not be modified.
+func (r *ClientCAWatcher) Config() *ClientCAConfig {
+	return r.config.Load()
+}
+
+// ClientCAs returns the current pool of client CAs, or nil if none has been loaded yet.
+func (r *ClientCAWatcher) ClientCAs() *x509.CertPool {
+	if config := r.config.Load(); config != nil {
+		return config.ClientCAs
+	}
+
+	return nil
+}
+
+// TLSOpt returns a function that configures a tls.Config to verify client certificates, when presented,
+// against the current client CAs. It is intended to be used with controller-runtime's TLSOpts.
+//
+// The client CAs are resolved on every handshake through GetConfigForClient. Any GetConfigForClient set
+// by a previous option, such as tlsidentity.Provider.TLSOpt, is preserved, so this option must come after it.
+func (r *ClientCAWatcher) TLSOpt() func(*tls.Config) {
+	return func(base *tls.Config) {
+		if base.ClientAuth == tls.NoClientCert {
+			base.ClientAuth = tls.VerifyClientCertIfGiven
+		}
+
+		base.ClientCAs = r.ClientCAs()
+
+		previous := base.GetConfigForClient
+		base.GetConfigForClient = func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+			var conf *tls.Config
+
+			if previous != nil {
+				var err error
+				if conf, err = previous(hello); err != nil {
+					return nil, err
+				}
+			}
+
+			if conf == nil {
+				conf = base.Clone()
+				conf.GetConfigForClient = nil
+			}
+
+			conf.ClientCAs = r.ClientCAs()
+
+			return conf, nil
+		}
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ClientCAWatcher) SetupWithManager(mgr ctrl.Manager) error {
+	key := r.configMapKey()
+	isWatchedConfigMap := func(obj client.Object) bool {
+		return obj.GetNamespace() == key.Namespace && obj.GetName() == key.Name
+	}
+
+	if err := ctrl.NewControllerManagedBy(mgr).
+		Named("clientcawatcher").
+		WithOptions(controller.Options{NeedLeaderElection: ptr.To(false)}).
+		For(&corev1.ConfigMap{}, builder.WithPredicates(predicate.NewPredicateFuncs(isWatchedConfigMap))).
+		// Override the default log constructor as it makes the logs very chatty.
+		WithLogConstructor(func(_ *reconcile.Request) logr.Logger {
+			return mgr.GetLogger().WithValues(
+				"controller", "clientcawatcher",
+				"configMap", key.String(),
+			)
+		}).
+		Complete(r); err != nil {
+		return fmt.Errorf("could not set up controller for client CA watcher: %w", err)
+	}
+
+	return nil
+}
+
+// Reconcile reloads the client authentication configuration and invokes the callback when it has changed.
+func (r *ClientCAWatcher) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	logger.V(1).Info("Reconciling client CA ConfigMap")
+	defer logger.V(1).Info("Finished reconciling client CA ConfigMap")
+
+	configMap := &corev1.ConfigMap{}
+	if err := r.Get(ctx, req.NamespacedName, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Keep the current configuration, the kube-apiserver republishes the ConfigMap.
+			logger.Info("Client CA ConfigMap not found, keeping the current configuration")
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get ConfigMap %s: %w", req.NamespacedName.String(), err)
+	}
+
+	newConfig, err := parseClientCAConfig(configMap)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if newConfig.equal(r.config.Load()) {
+		return ctrl.Result{}, nil
+	}
+
+	r.config.Store(newConfig)
+	logger.Info("Client CA configuration changed")
+
+	if r.OnChange != nil {
+		r.OnChange(ctx, newConfig)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *ClientCAWatcher) configMapKey() types.NamespacedName {
+	if r.ConfigMap.Name != "" {
+		return r.ConfigMap
+	}
+
+	return types.NamespacedName{Namespace: ExtensionAPIServerAuthenticationNamespace, Name: ExtensionAPIServerAuthenticationName}
+}
+
+func parseClientCAConfig(configMap *corev1.ConfigMap) (*ClientCAConfig, error) {
+	config := &ClientCAConfig{}
+
+	var err error
+
+	if config.ClientCA, config.ClientCAs, err = parseOptionalBundle(configMap, clientCAFileKey); err != nil {
+		return nil, err
+	}
+
+	if config.RequestHeaderClientCA, config.RequestHeaderClientCAs, err = parseOptionalBundle(configMap, requestHeaderClientCAFileKey); err != nil {
+		return nil, err
+	}
+
+	for key, target := range map[string]*[]string{
+		requestHeaderUsernameHeadersKey:     &config.RequestHeaderUsernameHeaders,
+		requestHeaderGroupHeadersKey:        &config.RequestHeaderGroupHeaders,
+		requestHeaderExtraHeaderPrefixesKey: &config.RequestHeaderExtraHeaderPrefixes,
+		requestHeaderAllowedNamesKey:        &config.RequestHeaderAllowedNames,
+	} {
+		value, ok := configMap.Data[key]
+		if !ok || value == "" {
+			continue
+		}
+
+		// The kube-apiserver publishes these lists JSON encoded.
+		if err := json.Unmarshal([]byte(value), target); err != nil {
+			return nil, fmt.Errorf("failed to parse %q from ConfigMap %s/%s: %w", key, configMap.Namespace, configMap.Name, err)
+		}
+	}
+
+	return config, nil
+}
+
+func parseOptionalBundle(configMap *corev1.ConfigMap, key string) ([]byte, *x509.CertPool, error) {
+	value, ok := configMap.Data[key]
+	if !ok || value == "" {
+		return nil, nil, nil
+	}
+
+	pool, err := NewCertPoolFromPEM([]byte(value), false)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse %q from ConfigMap %s/%s: %w", key, configMap.Namespace, configMap.Name, err)
+	}
+
+	return []byte(value), pool, nil
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"context"
+	"crypto/tls"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("ClientCAWatcher", func() {
+	var (
+		fakeClient client.Client
+		configMap  *corev1.ConfigMap
+		watcher    *ClientCAWatcher
+		changes    []*ClientCAConfig
+	)
+
+	key := types.NamespacedName{Namespace: ExtensionAPIServerAuthenticationNamespace, Name: ExtensionAPIServerAuthenticationName}
+	req := ctrl.Request{NamespacedName: key}
+
+	setClientCA := func(commonName string) {
+		certPEM, _ := generateTestKeyPair(commonName)
+		configMap.Data[clientCAFileKey] = string(certPEM)
+	}
+
+	BeforeEach(func() {
+		proxyCA, _ := generateTestKeyPair("front-proxy-ca")
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+			Data: map[string]string{
+				requestHeaderClientCAFileKey:        string(proxyCA),
+				requestHeaderUsernameHeadersKey:     `["X-Remote-User"]`,
+				requestHeaderGroupHeadersKey:        `["X-Remote-Group"]`,
+				requestHeaderExtraHeaderPrefixesKey: `["X-Remote-Extra-"]`,
+				requestHeaderAllowedNamesKey:        `["front-proxy-client"]`,
+			},
+		}
+		setClientCA("client-ca")
+		fakeClient = fake.NewClientBuilder().WithObjects(configMap).Build()
+
+		changes = nil
+		watcher = &ClientCAWatcher{
+			Client: fakeClient,
+			OnChange: func(_ context.Context, config *ClientCAConfig) {
+				changes = append(changes, config)
+			},
+		}
+	})
+
+	It("should load the client CAs and request header configuration", func() {
+		Expect(watcher.Config()).To(BeNil())
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+
+		config := watcher.Config()
+		Expect(config.ClientCAs).NotTo(BeNil())
+		Expect(config.RequestHeaderClientCAs).NotTo(BeNil())
+		Expect(config.RequestHeaderUsernameHeaders).To(Equal([]string{"X-Remote-User"}))
+		Expect(config.RequestHeaderGroupHeaders).To(Equal([]string{"X-Remote-Group"}))
+		Expect(config.RequestHeaderExtraHeaderPrefixes).To(Equal([]string{"X-Remote-Extra-"}))
+		Expect(config.RequestHeaderAllowedNames).To(Equal([]string{"front-proxy-client"}))
+		Expect(watcher.ClientCAs()).To(BeIdenticalTo(config.ClientCAs))
+	})
+
+	It("should only invoke the callback when the configuration changes", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+
+		_, err := watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+
+		setClientCA("rotated-client-ca")
+		Expect(fakeClient.Update(ctx, configMap)).To(Succeed())
+
+		_, err = watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(HaveLen(1))
+		Expect(changes[0]).To(BeIdenticalTo(watcher.Config()))
+	})
+
+	It("should keep the current configuration when a header list is malformed", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+		initial := watcher.Config()
+
+		configMap.Data[requestHeaderUsernameHeadersKey] = "X-Remote-User"
+		Expect(fakeClient.Update(ctx, configMap)).To(Succeed())
+
+		_, err := watcher.Reconcile(ctx, req)
+		Expect(err).To(HaveOccurred())
+		Expect(watcher.Config()).To(BeIdenticalTo(initial))
+	})
+
+	It("should resolve the client CAs on every handshake", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+
+		tlsConf := &tls.Config{MinVersion: tls.VersionTLS12}
+		watcher.TLSOpt()(tlsConf)
+		Expect(tlsConf.ClientAuth).To(Equal(tls.VerifyClientCertIfGiven))
+
+		setClientCA("rotated-client-ca")
+		Expect(fakeClient.Update(ctx, configMap)).To(Succeed())
+		_, err := watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+
+		conf, err := tlsConf.GetConfigForClient(&tls.ClientHelloInfo{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(conf.ClientCAs).To(BeIdenticalTo(watcher.ClientCAs()))
+		Expect(conf.GetConfigForClient).To(BeNil())
+	})
+})
@@ -0,0 +1,272 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/go-logr/logr"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// CSRAutoApprovedReason is the reason set on the Approved condition by CSRApprover.
+	CSRAutoApprovedReason = "AutoApproved"
+
+	// defaultCSRPollInterval is the interval at which WaitForCSRCertificate checks the CSR.
+	defaultCSRPollInterval = time.Second
+)
+
+var (
+	// ErrCSRDenied is returned when waiting for a CertificateSigningRequest that has been denied.
+	ErrCSRDenied = errors.New("certificate signing request was denied")
+	// ErrCSRFailed is returned when waiting for a CertificateSigningRequest that the signer failed to issue.
+	ErrCSRFailed = errors.New("certificate signing request failed")
+)
+
+// CSRRequest describes a CertificateSigningRequest to be created by CreateCSR.
+type CSRRequest struct {
+	// Name is the name of the CertificateSigningRequest. When empty, GenerateName is used instead.
+	Name string
+	// GenerateName is the name prefix used when Name is empty.
+	GenerateName string
+	// Labels are added to the CertificateSigningRequest, e.g. to be matched by a CSRApprover.
+	Labels map[string]string
+
+	// SignerName is the signer requested to issue the certificate, e.g. kubernetes.io/kube-apiserver-client.
+	SignerName string
+	// Usages are the key usages requested for the certificate.
+	Usages []certificatesv1.KeyUsage
+	// Lifetime is the requested validity period of the certificate. Signers may ignore it. Zero leaves it unset.
+	Lifetime time.Duration
+
+	// Subject is the subject of the certificate.
+	Subject pkix.Name
+	// DNSNames are the DNS subject alternative names.
+	DNSNames []string
+	// IPAddresses are the IP subject alternative names.
+	IPAddresses []net.IP
+	// KeyType is the type of private key to generate. Defaults to DefaultKeyType.
+	KeyType KeyType
+}
+
+// CreateCSR generates a private key and creates a CertificateSigningRequest for it.
+// It returns the created object and the PEM encoded private key, which never leaves the process.
+func CreateCSR(ctx context.Context, c client.Client, req CSRRequest) (*certificatesv1.CertificateSigningRequest, []byte, error) {
+	key, err := generateKey(req.KeyType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:     req.Subject,
+		DNSNames:    req.DNSNames,
+		IPAddresses: req.IPAddresses,
+	}, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create certificate request: %w", err)
+	}
+
+	keyPEM, err := encodePrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	csr := &certificatesv1.CertificateSigningRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:         req.Name,
+			GenerateName: req.GenerateName,
+			Labels:       req.Labels,
+		},
+		Spec: certificatesv1.CertificateSigningRequestSpec{
+			Request:    pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}),
+			SignerName: req.SignerName,
+			Usages:     req.Usages,
+		},
+	}
+
+	if req.Lifetime > 0 {
+		csr.Spec.ExpirationSeconds = ptr.To(int32(req.Lifetime / time.Second))
+	}
+
+	if err := c.Create(ctx, csr); err != nil {
+		return nil, nil, fmt.Errorf("failed to create CertificateSigningRequest: %w", err)
+	}
+
+	return csr, keyPEM, nil
+}
+
+// WaitForCSRCertificate waits until the named CertificateSigningRequest has been issued and returns
+// the PEM encoded certificate. It returns ErrCSRDenied or ErrCSRFailed when the request will never be issued,
+// and an error when the timeout expires first. Failures to get the request, including when it is not found yet,
+// are retried until the timeout, and the last one is returned with the timeout error.
+func WaitForCSRCertificate(ctx context.Context, c client.Reader, name string, timeout time.Duration) ([]byte, error) {
+	var (
+		certificate []byte
+		getErr      error
+	)
+
+	err := wait.PollUntilContextTimeout(ctx, defaultCSRPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		csr := &certificatesv1.CertificateSigningRequest{}
+		if getErr = c.Get(ctx, client.ObjectKey{Name: name}, csr); getErr != nil {
+			return false, nil
+		}
+
+		for _, condition := range csr.Status.Conditions {
+			if condition.Status != corev1.ConditionTrue {
+				continue
+			}
+
+			switch condition.Type {
+			case certificatesv1.CertificateDenied:
+				return false, fmt.Errorf("%w: %s: %s", ErrCSRDenied, name, condition.Message)
+			case certificatesv1.CertificateFailed:
+				return false, fmt.Errorf("%w: %s: %s", ErrCSRFailed, name, condition.Message)
+			}
+		}
+
+		certificate = csr.Status.Certificate
+
+		return len(certificate) > 0, nil
+	})
+	if err != nil {
+		if getErr != nil && wait.Interrupted(err) {
+			return nil, fmt.Errorf("failed waiting for CertificateSigningRequest %s to be issued: %w: %w", name, err, getErr)
+		}
+
+		return nil, fmt.Errorf("failed waiting for CertificateSigningRequest %s to be issued: %w", name, err)
+	}
+
+	return certificate, nil
+}
+
+// CSRApprover approves CertificateSigningRequests for a single signer that match a label selector.
+// It is intended for tests and standalone deployments where no other approver runs; in OpenShift,
+// prefer the approvers shipped with the platform.
+//
+// The operator needs RBAC to approve CSRs for the signer, i.e. the approve verb on signers
+// and update on certificatesigningrequests/approval.
+type CSRApprover struct {
+	client.Client
+
+	// SignerName is the only signer whose requests are approved.
+	SignerName string
+
+	// Selector restricts approval to the requests with matching labels.
+	Selector labels.Selector
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CSRApprover) SetupWithManager(mgr ctrl.Manager) error {
+	if r.SignerName == "" {
+		return errors.New("a signer name is required")
+	}
+
+	if r.Selector == nil {
+		return errors.New("a selector is required to avoid approving unrelated requests")
+	}
+
+	if err := ctrl.NewControllerManagedBy(mgr).
+		Named("csrapprover").
+		For(&certificatesv1.CertificateSigningRequest{}, builder.WithPredicates(predicate.NewPredicateFuncs(r.matches))).
+		// Override the default log constructor as it makes the logs very chatty.
+		WithLogConstructor(func(req *reconcile.Request) logr.Logger {
+			logger := mgr.GetLogger().WithValues(
+				"controller", "csrapprover",
+				"signerName", r.SignerName,
+			)
+			if req != nil {
+				logger = logger.WithValues("csr", req.Name)
+			}
+
+			return logger
+		}).
+		Complete(r); err != nil {
+		return fmt.Errorf("could not set up controller for CSR approver: %w", err)
+	}
+
+	return nil
+}
+
+// Reconcile approves the request when it matches and has not been approved or denied yet.
+func (r *CSRApprover) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	csr := &certificatesv1.CertificateSigningRequest{}
+	if err := r.Get(ctx, req.NamespacedName, csr); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get CertificateSigningRequest %s: %w", req.Name, err)
+	}
+
+	if !r.matches(csr) {
+		return ctrl.Result{}, nil
+	}
+
+	for _, condition := range csr.Status.Conditions {
+		if condition.Type == certificatesv1.CertificateApproved || condition.Type == certificatesv1.CertificateDenied {
+			return ctrl.Result{}, nil
+		}
+	}
+
+	csr.Status.Conditions = append(csr.Status.Conditions, certificatesv1.CertificateSigningRequestCondition{
+		Type:           certificatesv1.CertificateApproved,
+		Status:         corev1.ConditionTrue,
+		Reason:         CSRAutoApprovedReason,
+		Message:        "Approved by the controller-runtime-common CSR approver",
+		LastUpdateTime: metav1.Now(),
+	})
+
+	if err := r.SubResource("approval").Update(ctx, csr); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to approve CertificateSigningRequest %s: %w", req.Name, err)
+	}
+
+	logger.Info("Approved CertificateSigningRequest")
+
+	return ctrl.Result{}, nil
+}
+
+func (r *CSRApprover) matches(obj client.Object) bool {
+	csr, ok := obj.(*certificatesv1.CertificateSigningRequest)
+	if !ok {
+		return false
+	}
+
+	return csr.Spec.SignerName == r.SignerName && r.Selector.Matches(labels.Set(csr.GetLabels()))
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("CertificateSigningRequests", func() {
+	const signerName = "example.com/serving"
+
+	var fakeClient client.Client
+
+	BeforeEach(func() {
+		fakeClient = fake.NewClientBuilder().
+			WithStatusSubresource(&certificatesv1.CertificateSigningRequest{}).
+			Build()
+	})
+
+	createCSR := func(name string, csrLabels map[string]string) *certificatesv1.CertificateSigningRequest {
+		csr, keyPEM, err := CreateCSR(ctx, fakeClient, CSRRequest{
+			Name:       name,
+			Labels:     csrLabels,
+			SignerName: signerName,
+			Usages:     []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageServerAuth},
+			Lifetime:   time.Hour,
+			Subject:    pkix.Name{CommonName: "operator.example.svc"},
+			DNSNames:   []string{"operator.example.svc"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keyPEM).NotTo(BeEmpty())
+
+		return csr
+	}
+
+	It("should create a request for the generated key", func() {
+		csr := createCSR("serving", nil)
+
+		Expect(csr.Spec.SignerName).To(Equal(signerName))
+		Expect(*csr.Spec.ExpirationSeconds).To(BeEquivalentTo(3600))
+
+		block, _ := pem.Decode(csr.Spec.Request)
+		Expect(block).NotTo(BeNil())
+		request, err := x509.ParseCertificateRequest(block.Bytes)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(request.CheckSignature()).To(Succeed())
+		Expect(request.DNSNames).To(ConsistOf("operator.example.svc"))
+	})
+
+	It("should return the certificate once it has been issued", func() {
+		csr := createCSR("serving", nil)
+		certPEM, _ := generateTestKeyPair("operator.example.svc")
+
+		csr.Status.Certificate = certPEM
+		Expect(fakeClient.Status().Update(ctx, csr)).To(Succeed())
+
+		Expect(WaitForCSRCertificate(ctx, fakeClient, csr.Name, time.Second)).To(Equal(certPEM))
+	})
+
+	It("should stop waiting when the request is denied", func() {
+		csr := createCSR("serving", nil)
+
+		csr.Status.Conditions = []certificatesv1.CertificateSigningRequestCondition{{
+			Type:    certificatesv1.CertificateDenied,
+			Status:  corev1.ConditionTrue,
+			Message: "not allowed",
+		}}
+		Expect(fakeClient.Status().Update(ctx, csr)).To(Succeed())
+
+		_, err := WaitForCSRCertificate(ctx, fakeClient, csr.Name, time.Minute)
+		Expect(err).To(MatchError(ErrCSRDenied))
+	})
+
+	It("should time out while the request is pending", func() {
+		csr := createCSR("serving", nil)
+
+		_, err := WaitForCSRCertificate(ctx, fakeClient, csr.Name, 100*time.Millisecond)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should keep waiting while the request cannot be read", func() {
+		_, err := WaitForCSRCertificate(ctx, fakeClient, "missing", 100*time.Millisecond)
+		Expect(err).To(MatchError(ContainSubstring("not found")))
+		Expect(err).NotTo(MatchError(ErrCSRDenied))
+	})
+
+	Context("CSRApprover", func() {
+		var approver *CSRApprover
+
+		BeforeEach(func() {
+			approver = &CSRApprover{
+				Client:     fakeClient,
+				SignerName: signerName,
+				Selector:   labels.SelectorFromSet(labels.Set{"app": "operator"}),
+			}
+		})
+
+		isApproved := func(name string) bool {
+			csr := &certificatesv1.CertificateSigningRequest{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: name}, csr)).To(Succeed())
+
+			for _, condition := range csr.Status.Conditions {
+				if condition.Type == certificatesv1.CertificateApproved && condition.Status == corev1.ConditionTrue {
+					return true
+				}
+			}
+
+			return false
+		}
+
+		It("should approve matching requests", func() {
+			createCSR("matching", map[string]string{"app": "operator"})
+
+			_, err := approver.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKey{Name: "matching"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(isApproved("matching")).To(BeTrue())
+		})
+
+		It("should ignore requests without the selected labels", func() {
+			createCSR("unlabelled", nil)
+
+			_, err := approver.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKey{Name: "unlabelled"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(isApproved("unlabelled")).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,188 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openshift/controller-runtime-common/pkg/metricsregistry"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// CertificateExpiredReason is the condition reason used when a certificate has expired.
+	CertificateExpiredReason = "CertificateExpired"
+	// CertificateExpiringReason is the condition reason used when a certificate is within its renewal window.
+	CertificateExpiringReason = "CertificateExpiring"
+	// CertificateValidReason is the condition reason used when a certificate is valid and not due for renewal.
+	CertificateValidReason = "CertificateValid"
+)
+
+var certificateExpiryDesc = prometheus.NewDesc(
+	"certificate_expiry_seconds",
+	"Number of seconds until the certificate expires, negative once it has expired.",
+	[]string{"name", "subject"},
+	nil,
+)
+
+// DefaultExpiryTracker is part of the metrics of the library, registered with the controller-runtime metrics registry
+// by default, so certificates tracked with it are exposed by the manager's metrics server.
+var DefaultExpiryTracker = NewExpiryTracker()
+
+func init() {
+	metricsregistry.Add(DefaultExpiryTracker)
+}
+
+// ExpiryTracker exposes the certificate_expiry_seconds gauge for a set of named certificates.
+// The gauge is computed when metrics are collected, so it does not go stale between updates.
+type ExpiryTracker struct {
+	mu    sync.RWMutex
+	certs map[string]*x509.Certificate
+}
+
+// NewExpiryTracker returns an empty ExpiryTracker.
+// It has to be registered with a Prometheus registry to be exposed.
+func NewExpiryTracker() *ExpiryTracker {
+	return &ExpiryTracker{certs: map[string]*x509.Certificate{}}
+}
+
+// Track starts tracking the certificate under the given name, replacing any certificate previously tracked under it.
+func (t *ExpiryTracker) Track(name string, cert *x509.Certificate) {
+	if cert == nil {
+		t.Untrack(name)
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.certs[name] = cert
+}
+
+// TrackTLS tracks the leaf of a tls.Certificate, as returned by the certificate watchers of this package.
+// It can be called from their OnRotate callbacks.
+func (t *ExpiryTracker) TrackTLS(name string, cert *tls.Certificate) {
+	if cert == nil {
+		t.Untrack(name)
+		return
+	}
+
+	leaf := cert.Leaf
+	if leaf == nil && len(cert.Certificate) > 0 {
+		// Leaf is populated by the standard library since Go 1.23, parse it for certificates built by hand.
+		parsed, err := x509.ParseCertificate(cert.Certificate[0])
+		if err != nil {
+			t.Untrack(name)
+			return
+		}
+
+		leaf = parsed
+	}
+
+	t.Track(name, leaf)
+}
+
+// Untrack stops tracking the certificate with the given name.
+func (t *ExpiryTracker) Untrack(name string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.certs, name)
+}
+
+// Describe implements prometheus.Collector.
+func (t *ExpiryTracker) Describe(ch chan<- *prometheus.Desc) {
+	ch <- certificateExpiryDesc
+}
+
+// Collect implements prometheus.Collector.
+func (t *ExpiryTracker) Collect(ch chan<- prometheus.Metric) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	now := time.Now()
+	for name, cert := range t.certs {
+		ch <- prometheus.MustNewConstMetric(
+			certificateExpiryDesc,
+			prometheus.GaugeValue,
+			cert.NotAfter.Sub(now).Seconds(),
+			name, cert.Subject.String(),
+		)
+	}
+}
+
+// ExpiryCondition returns a Degraded-style condition of the given type for the named certificates.
+// The condition is True when any certificate has expired or is within the renewal window of its expiry,
+// and False otherwise. The message lists the offending certificates, ordered by name.
+//
+// Example:
+//
+//	meta.SetStatusCondition(&status.Conditions, certs.ExpiryCondition("CertificateDegraded",
+//	    map[string]*x509.Certificate{"serving": watcher.Certificate().Leaf}, 7*24*time.Hour))
+func ExpiryCondition(conditionType string, certificates map[string]*x509.Certificate, renewalWindow time.Duration) metav1.Condition {
+	now := time.Now()
+
+	names := make([]string, 0, len(certificates))
+	for name := range certificates {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var expired, expiring []string
+
+	for _, name := range names {
+		cert := certificates[name]
+		if cert == nil {
+			continue
+		}
+
+		switch {
+		case !now.Before(cert.NotAfter):
+			expired = append(expired, fmt.Sprintf("%s expired at %s", name, cert.NotAfter.UTC().Format(time.RFC3339)))
+		case !now.Before(cert.NotAfter.Add(-renewalWindow)):
+			expiring = append(expiring, fmt.Sprintf("%s expires at %s", name, cert.NotAfter.UTC().Format(time.RFC3339)))
+		}
+	}
+
+	condition := metav1.Condition{
+		Type:    conditionType,
+		Status:  metav1.ConditionFalse,
+		Reason:  CertificateValidReason,
+		Message: "All certificates are valid",
+	}
+
+	switch {
+	case len(expired) > 0:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = CertificateExpiredReason
+		condition.Message = strings.Join(append(expired, expiring...), "; ")
+	case len(expiring) > 0:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = CertificateExpiringReason
+		condition.Message = strings.Join(expiring, "; ")
+	}
+
+	return condition
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("ExpiryTracker", func() {
+	collect := func(tracker *ExpiryTracker) map[string]float64 {
+		ch := make(chan prometheus.Metric, 10)
+		tracker.Collect(ch)
+		close(ch)
+
+		values := map[string]float64{}
+		for metric := range ch {
+			m := &dto.Metric{}
+			Expect(metric.Write(m)).To(Succeed())
+			for _, label := range m.GetLabel() {
+				if label.GetName() == "name" {
+					values[label.GetValue()] = m.GetGauge().GetValue()
+				}
+			}
+		}
+		return values
+	}
+
+	It("should report the seconds until expiry of tracked certificates", func() {
+		tracker := NewExpiryTracker()
+		tracker.Track("serving", &x509.Certificate{NotAfter: time.Now().Add(time.Hour)})
+		tracker.Track("expired", &x509.Certificate{NotAfter: time.Now().Add(-time.Hour)})
+
+		values := collect(tracker)
+		Expect(values).To(HaveLen(2))
+		Expect(values["serving"]).To(BeNumerically("~", time.Hour.Seconds(), 60))
+		Expect(values["expired"]).To(BeNumerically("<", 0))
+
+		tracker.Untrack("expired")
+		Expect(collect(tracker)).To(HaveKey("serving"))
+		Expect(collect(tracker)).NotTo(HaveKey("expired"))
+	})
+
+	It("should track the leaf of TLS certificates", func() {
+		certPEM, keyPEM := generateTestKeyPair("leaf")
+		watcherCert, err := parseTestKeyPair(certPEM, keyPEM)
+		Expect(err).NotTo(HaveOccurred())
+
+		tracker := NewExpiryTracker()
+		tracker.TrackTLS("serving", watcherCert)
+		Expect(collect(tracker)).To(HaveKey("serving"))
+
+		tracker.TrackTLS("serving", nil)
+		Expect(collect(tracker)).To(BeEmpty())
+	})
+})
+
+var _ = Describe("ExpiryCondition", func() {
+	cert := func(cn string, validFor time.Duration) *x509.Certificate {
+		return &x509.Certificate{Subject: pkix.Name{CommonName: cn}, NotAfter: time.Now().Add(validFor)}
+	}
+
+	It("should be False when all certificates are valid", func() {
+		condition := ExpiryCondition("CertificateDegraded", map[string]*x509.Certificate{
+			"serving": cert("serving", 30*24*time.Hour),
+		}, 7*24*time.Hour)
+		Expect(condition.Type).To(Equal("CertificateDegraded"))
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(CertificateValidReason))
+	})
+
+	It("should be True when a certificate is within the renewal window", func() {
+		condition := ExpiryCondition("CertificateDegraded", map[string]*x509.Certificate{
+			"serving": cert("serving", 30*24*time.Hour),
+			"client":  cert("client", 24*time.Hour),
+		}, 7*24*time.Hour)
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(CertificateExpiringReason))
+		Expect(condition.Message).To(HavePrefix("client expires at"))
+	})
+
+	It("should report expired certificates first", func() {
+		condition := ExpiryCondition("CertificateDegraded", map[string]*x509.Certificate{
+			"a-expiring": cert("a", time.Hour),
+			"b-expired":  cert("b", -time.Hour),
+		}, 7*24*time.Hour)
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(CertificateExpiredReason))
+		Expect(condition.Message).To(And(HavePrefix("b-expired expired at"), ContainSubstring("; a-expiring expires at")))
+	})
+})
@@ -0,0 +1,295 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package certs provides utilities for working with the TLS certificates served and trusted by operators.
+package certs
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// DefaultPollInterval is the default interval at which certificate files are re-read,
+	// regardless of file system notifications.
+	DefaultPollInterval = 10 * time.Second
+)
+
+// ErrNoCertificate is returned when no certificate has been loaded yet.
+var ErrNoCertificate = errors.New("no certificate loaded")
+
+// FileCertWatcher watches a certificate and key pair on disk and reloads it when it changes.
+//
+// Changes are detected through file system notifications on the directories containing the files,
+// which also covers the symlink swaps performed by the kubelet when updating mounted Secrets,
+// with periodic polling as a fallback for file systems that do not support notifications.
+//
+// FileCertWatcher implements manager.Runnable and does not need leader election,
+// so it can be added to a manager with mgr.Add.
+type FileCertWatcher struct {
+	// CertPath is the path to the PEM encoded certificate (chain).
+	CertPath string
+
+	// KeyPath is the path to the PEM encoded private key.
+	KeyPath string
+
+	// PollInterval is the interval at which the files are re-read regardless of notifications.
+	// Defaults to DefaultPollInterval.
+	PollInterval time.Duration
+
+	// DisableNotifications disables file system notifications, leaving polling as the only way
+	// changes are detected. This is useful on file systems where notifications are unreliable,
+	// such as some network file systems.
+	DisableNotifications bool
+
+	// OnRotate is a function that will be called when the certificate changes.
+	// It receives the context passed to Start, the old and the new certificate.
+	//
+	// The callback is invoked from the goroutine running Start, which handles both
+	// file system notifications and polling: while the callback runs, no further changes are
+	// picked up, so long-running work should be handed off to another goroutine.
+	OnRotate func(ctx context.Context, oldCert, newCert *tls.Certificate)
+
+	cert atomic.Pointer[tls.Certificate]
+
+	// lastErr is the error of the most recent reload, or nil if it succeeded.
+	lastErr atomic.Pointer[error]
+	// consecutiveFailures is only accessed from the goroutine running Start.
+	consecutiveFailures int
+}
+
+// NewFileCertWatcher returns a FileCertWatcher for the given certificate and key paths,
+// with the initial certificate already loaded.
+func NewFileCertWatcher(certPath, keyPath string) (*FileCertWatcher, error) {
+	w := &FileCertWatcher{
+		CertPath: certPath,
+		KeyPath:  keyPath,
+	}
+
+	cert, err := w.load()
+	if err != nil {
+		return nil, err
+	}
+
+	w.cert.Store(cert)
+
+	return w, nil
+}
+
+// Certificate returns the currently loaded certificate, or nil if none has been loaded.
+func (w *FileCertWatcher) Certificate() *tls.Certificate {
+	return w.cert.Load()
+}
+
+// GetCertificate returns the currently loaded certificate.
+// It is intended to be used as tls.Config.GetCertificate.
+func (w *FileCertWatcher) GetCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cert := w.cert.Load()
+	if cert == nil {
+		return nil, ErrNoCertificate
+	}
+
+	return cert, nil
+}
+
+// GetClientCertificate returns the currently loaded certificate.
+// It is intended to be used as tls.Config.GetClientCertificate.
+func (w *FileCertWatcher) GetClientCertificate(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return w.GetCertificate(nil)
+}
+
+// LastError returns the error of the most recent reload attempt, or nil if it succeeded.
+// A non-nil value means the files on disk cannot be loaded and an older certificate is still being served.
+func (w *FileCertWatcher) LastError() error {
+	if err := w.lastErr.Load(); err != nil {
+		return *err
+	}
+
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+// The certificate has to be served by every replica, so the watcher runs regardless of leadership.
+func (w *FileCertWatcher) NeedLeaderElection() bool {
+	return false
+}
+
+// Start watches the certificate files until the context is cancelled.
+// If file system notifications cannot be set up, the watcher falls back to polling only.
+func (w *FileCertWatcher) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithValues("cert", w.CertPath, "key", w.KeyPath)
+
+	var events <-chan fsnotify.Event
+	var errs <-chan error
+
+	if !w.DisableNotifications {
+		watcher, err := w.newNotifyWatcher()
+		if err != nil {
+			logger.Error(err, "Failed to set up file system notifications, falling back to polling")
+		} else {
+			defer watcher.Close()
+			events, errs = watcher.Events, watcher.Errors
+		}
+	}
+
+	// Pick up any change that happened between construction and start.
+	w.reload(ctx)
+
+	interval := w.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			w.reload(ctx)
+		case event, ok := <-events:
+			if !ok {
+				// Receiving from a nil channel blocks forever, leaving only polling.
+				events = nil
+				continue
+			}
+
+			if event.Has(fsnotify.Chmod) {
+				continue
+			}
+
+			w.reload(ctx)
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+
+			logger.Error(err, "Error watching certificate files, relying on polling")
+		}
+	}
+}
+
+// newNotifyWatcher returns a file system watcher on the directories containing the certificate files.
+func (w *FileCertWatcher) newNotifyWatcher() (*fsnotify.Watcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create file watcher: %w", err)
+	}
+
+	// Watch the parent directories rather than the files themselves,
+	// as the kubelet replaces mounted files by swapping a symlink.
+	for _, dir := range uniqueDirs(w.CertPath, w.KeyPath) {
+		if err := watcher.Add(dir); err != nil {
+			_ = watcher.Close()
+			return nil, fmt.Errorf("failed to watch directory %s: %w", dir, err)
+		}
+	}
+
+	return watcher, nil
+}
+
+// reload reads the certificate from disk and swaps it in if it differs from the current one.
+// On failure the current certificate is kept. As files are often observed mid-update,
+// a single failure is only logged at V(1), while repeated failures are logged as errors.
+func (w *FileCertWatcher) reload(ctx context.Context) {
+	logger := log.FromContext(ctx).WithValues("cert", w.CertPath, "key", w.KeyPath)
+
+	newCert, err := w.load()
+	if err != nil {
+		w.lastErr.Store(&err)
+		w.consecutiveFailures++
+
+		if w.consecutiveFailures > 1 {
+			logger.Error(err, "Failed to reload certificate, keeping the current one", "consecutiveFailures", w.consecutiveFailures)
+		} else {
+			logger.V(1).Info("Failed to reload certificate, keeping the current one", "error", err.Error())
+		}
+
+		return
+	}
+
+	w.lastErr.Store(nil)
+	w.consecutiveFailures = 0
+
+	oldCert := w.cert.Load()
+	if certificatesEqual(oldCert, newCert) {
+		return
+	}
+
+	w.cert.Store(newCert)
+	logger.Info("Certificate rotated")
+
+	if w.OnRotate != nil {
+		w.OnRotate(ctx, oldCert, newCert)
+	}
+}
+
+func (w *FileCertWatcher) load() (*tls.Certificate, error) {
+	cert, err := tls.LoadX509KeyPair(w.CertPath, w.KeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load certificate from %s and %s: %w", w.CertPath, w.KeyPath, err)
+	}
+
+	return &cert, nil
+}
+
+// certificatesEqual reports whether two certificates carry the same certificate chain.
+func certificatesEqual(a, b *tls.Certificate) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	if len(a.Certificate) != len(b.Certificate) {
+		return false
+	}
+
+	for i := range a.Certificate {
+		if !bytes.Equal(a.Certificate[i], b.Certificate[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func uniqueDirs(paths ...string) []string {
+	seen := map[string]struct{}{}
+	dirs := make([]string, 0, len(paths))
+
+	for _, path := range paths {
+		dir := filepath.Dir(path)
+		if _, ok := seen[dir]; ok {
+			continue
+		}
+
+		seen[dir] = struct{}{}
+		dirs = append(dirs, dir)
+	}
+
+	return dirs
+}
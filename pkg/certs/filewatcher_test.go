@@ -0,0 +1,240 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FileCertWatcher", func() {
+	var (
+		dir       string
+		certPath  string
+		keyPath   string
+		rotations chan [2]string
+	)
+
+	// writeFileAtomically writes the file next to its destination and renames it into place,
+	// so that a concurrent reload never observes a partially written file.
+	writeFileAtomically := func(path string, data []byte) {
+		GinkgoHelper()
+		tmp := path + ".tmp"
+		Expect(os.WriteFile(tmp, data, 0o600)).To(Succeed())
+		Expect(os.Rename(tmp, path)).To(Succeed())
+	}
+
+	commonName := func(cert *tls.Certificate) string {
+		if cert == nil || cert.Leaf == nil {
+			return ""
+		}
+		return cert.Leaf.Subject.CommonName
+	}
+
+	recordRotations := func(watcher *FileCertWatcher) {
+		watcher.OnRotate = func(_ context.Context, oldCert, newCert *tls.Certificate) {
+			rotations <- [2]string{commonName(oldCert), commonName(newCert)}
+		}
+	}
+
+	startWatcher := func(watcher *FileCertWatcher) {
+		watchCtx, cancel := context.WithCancel(ctx)
+		done := make(chan struct{})
+		DeferCleanup(func() {
+			cancel()
+			<-done
+		})
+
+		go func() {
+			defer GinkgoRecover()
+			defer close(done)
+			Expect(watcher.Start(watchCtx)).To(Succeed())
+		}()
+	}
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		certPath = filepath.Join(dir, "tls.crt")
+		keyPath = filepath.Join(dir, "tls.key")
+		rotations = make(chan [2]string, 10)
+	})
+
+	Context("with plain files", func() {
+		var certPEM, keyPEM []byte
+
+		writeKeyPair := func(commonName string) {
+			GinkgoHelper()
+			certPEM, keyPEM = generateTestKeyPair(commonName)
+			writeFileAtomically(keyPath, keyPEM)
+			writeFileAtomically(certPath, certPEM)
+		}
+
+		It("should fail when the files do not exist", func() {
+			_, err := NewFileCertWatcher(certPath, keyPath)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should load the initial certificate", func() {
+			writeKeyPair("initial")
+
+			watcher, err := NewFileCertWatcher(certPath, keyPath)
+			Expect(err).NotTo(HaveOccurred())
+
+			cert, err := watcher.GetCertificate(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(commonName(cert)).To(Equal("initial"))
+			Expect(watcher.LastError()).ToNot(HaveOccurred())
+		})
+
+		It("should reload the certificate on file system notifications", func() {
+			writeKeyPair("initial")
+
+			watcher, err := NewFileCertWatcher(certPath, keyPath)
+			Expect(err).NotTo(HaveOccurred())
+			// Make sure the change can only be picked up through a notification.
+			watcher.PollInterval = time.Hour
+			recordRotations(watcher)
+			startWatcher(watcher)
+
+			writeKeyPair("rotated")
+
+			Eventually(rotations).Should(Receive(Equal([2]string{"initial", "rotated"})))
+			Expect(commonName(watcher.Certificate())).To(Equal("rotated"))
+		})
+
+		It("should reload the certificate by polling when notifications are disabled", func() {
+			writeKeyPair("initial")
+
+			watcher, err := NewFileCertWatcher(certPath, keyPath)
+			Expect(err).NotTo(HaveOccurred())
+			watcher.DisableNotifications = true
+			watcher.PollInterval = 50 * time.Millisecond
+			recordRotations(watcher)
+			startWatcher(watcher)
+
+			writeKeyPair("rotated")
+
+			Eventually(rotations).Should(Receive(Equal([2]string{"initial", "rotated"})))
+		})
+
+		It("should not invoke the callback when the files are rewritten with identical contents", func() {
+			writeKeyPair("initial")
+
+			watcher, err := NewFileCertWatcher(certPath, keyPath)
+			Expect(err).NotTo(HaveOccurred())
+			watcher.PollInterval = 50 * time.Millisecond
+			recordRotations(watcher)
+			startWatcher(watcher)
+
+			writeFileAtomically(keyPath, keyPEM)
+			writeFileAtomically(certPath, certPEM)
+
+			Consistently(rotations, 500*time.Millisecond).ShouldNot(Receive())
+		})
+
+		It("should keep the current certificate when the certificate does not match the key", func() {
+			writeKeyPair("initial")
+
+			watcher, err := NewFileCertWatcher(certPath, keyPath)
+			Expect(err).NotTo(HaveOccurred())
+			recordRotations(watcher)
+
+			// Simulate a reload between the certificate and the key being updated.
+			otherCertPEM, _ := generateTestKeyPair("mismatched")
+			writeFileAtomically(certPath, otherCertPEM)
+			watcher.reload(ctx)
+
+			Expect(commonName(watcher.Certificate())).To(Equal("initial"))
+			Expect(watcher.LastError()).To(MatchError(ContainSubstring("does not match")))
+			Expect(rotations).NotTo(Receive())
+
+			// Once the key catches up, the new certificate is picked up and the error cleared.
+			writeKeyPair("rotated")
+			watcher.reload(ctx)
+
+			Expect(commonName(watcher.Certificate())).To(Equal("rotated"))
+			Expect(watcher.LastError()).ToNot(HaveOccurred())
+			Expect(rotations).To(Receive(Equal([2]string{"initial", "rotated"})))
+		})
+
+		It("should keep the current certificate when the files are invalid", func() {
+			writeKeyPair("initial")
+
+			watcher, err := NewFileCertWatcher(certPath, keyPath)
+			Expect(err).NotTo(HaveOccurred())
+
+			writeFileAtomically(certPath, []byte("garbage"))
+			watcher.reload(ctx)
+			watcher.reload(ctx)
+
+			Expect(commonName(watcher.Certificate())).To(Equal("initial"))
+			Expect(watcher.LastError()).To(HaveOccurred())
+		})
+	})
+
+	Context("with a kubelet-style Secret volume", func() {
+		var generation int
+
+		// writeSecretVolume mimics the kubelet's atomic writer: the files live in a timestamped
+		// directory, referenced through the "..data" symlink, which is swapped atomically on update.
+		writeSecretVolume := func(commonName string) {
+			GinkgoHelper()
+			generation++
+
+			certPEM, keyPEM := generateTestKeyPair(commonName)
+			dataDir := fmt.Sprintf("..%d", generation)
+			Expect(os.Mkdir(filepath.Join(dir, dataDir), 0o700)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, dataDir, "tls.crt"), certPEM, 0o600)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, dataDir, "tls.key"), keyPEM, 0o600)).To(Succeed())
+
+			Expect(os.Symlink(dataDir, filepath.Join(dir, "..data_tmp"))).To(Succeed())
+			Expect(os.Rename(filepath.Join(dir, "..data_tmp"), filepath.Join(dir, "..data"))).To(Succeed())
+
+			if generation == 1 {
+				Expect(os.Symlink(filepath.Join("..data", "tls.crt"), certPath)).To(Succeed())
+				Expect(os.Symlink(filepath.Join("..data", "tls.key"), keyPath)).To(Succeed())
+			}
+		}
+
+		BeforeEach(func() {
+			generation = 0
+		})
+
+		It("should reload the certificate when the data symlink is swapped", func() {
+			writeSecretVolume("initial")
+
+			watcher, err := NewFileCertWatcher(certPath, keyPath)
+			Expect(err).NotTo(HaveOccurred())
+			// Make sure the change can only be picked up through a notification.
+			watcher.PollInterval = time.Hour
+			recordRotations(watcher)
+			startWatcher(watcher)
+
+			writeSecretVolume("rotated")
+
+			Eventually(rotations).Should(Receive(Equal([2]string{"initial", "rotated"})))
+			Expect(commonName(watcher.Certificate())).To(Equal("rotated"))
+		})
+	})
+})
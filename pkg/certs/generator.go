@@ -0,0 +1,333 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"time"
+)
+
+// KeyType is the type of private key generated for certificates.
+type KeyType string
+
+const (
+	// KeyTypeECDSAP256 generates ECDSA keys on the P-256 curve.
+	KeyTypeECDSAP256 KeyType = "ECDSA-P256"
+	// KeyTypeECDSAP384 generates ECDSA keys on the P-384 curve.
+	KeyTypeECDSAP384 KeyType = "ECDSA-P384"
+	// KeyTypeRSA2048 generates 2048 bit RSA keys.
+	KeyTypeRSA2048 KeyType = "RSA-2048"
+	// KeyTypeRSA4096 generates 4096 bit RSA keys.
+	KeyTypeRSA4096 KeyType = "RSA-4096"
+
+	// DefaultKeyType is the key type used when none is specified.
+	DefaultKeyType = KeyTypeECDSAP256
+)
+
+const (
+	// DefaultCALifetime is the default validity period of generated CAs.
+	DefaultCALifetime = 2 * 365 * 24 * time.Hour
+	// DefaultCertLifetime is the default validity period of generated serving certificates.
+	DefaultCertLifetime = 365 * 24 * time.Hour
+
+	// clockSkew is subtracted from NotBefore so that certificates are valid on hosts with slightly late clocks.
+	clockSkew = 5 * time.Minute
+)
+
+var (
+	// ErrUnsupportedKeyType is returned when a certificate is requested with an unknown key type.
+	ErrUnsupportedKeyType = errors.New("unsupported key type")
+	// ErrNoSubjectAltNames is returned when a serving certificate is requested without any DNS name or IP address.
+	ErrNoSubjectAltNames = errors.New("serving certificate requires at least one DNS name or IP address")
+)
+
+// CertificateAuthority is a CA able to issue serving certificates.
+type CertificateAuthority struct {
+	// Cert is the parsed CA certificate.
+	Cert *x509.Certificate
+	// Key is the CA private key.
+	Key crypto.Signer
+	// CertPEM is the PEM encoded CA certificate.
+	CertPEM []byte
+	// KeyPEM is the PEM encoded CA private key.
+	KeyPEM []byte
+}
+
+// NewSelfSignedCA generates a new self-signed CA with the given common name, lifetime and key type.
+func NewSelfSignedCA(commonName string, lifetime time.Duration, keyType KeyType) (*CertificateAuthority, error) {
+	key, err := generateKey(keyType)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             now.Add(-clockSkew),
+		NotAfter:              now.Add(lifetime),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	cert, certPEM, err := createCertificate(template, template, key.Public(), key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CA certificate: %w", err)
+	}
+
+	keyPEM, err := encodePrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CertificateAuthority{Cert: cert, Key: key, CertPEM: certPEM, KeyPEM: keyPEM}, nil
+}
+
+// ParseCertificateAuthority parses a PEM encoded CA certificate and private key.
+// When certPEM is a bundle, the first certificate is used as the CA certificate.
+func ParseCertificateAuthority(certPEM, keyPEM []byte) (*CertificateAuthority, error) {
+	cert, err := parseCertificate(certPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	if !cert.IsCA {
+		return nil, fmt.Errorf("certificate %q is not a CA", cert.Subject.CommonName)
+	}
+
+	key, err := parsePrivateKey(keyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CertificateAuthority{
+		Cert:    cert,
+		Key:     key,
+		CertPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}),
+		KeyPEM:  keyPEM,
+	}, nil
+}
+
+// ServingCertRequest describes a serving certificate to be issued by a CertificateAuthority.
+type ServingCertRequest struct {
+	// CommonName is the subject common name. Defaults to the first DNS name.
+	CommonName string
+	// DNSNames are the DNS subject alternative names.
+	DNSNames []string
+	// IPAddresses are the IP subject alternative names.
+	IPAddresses []net.IP
+	// Lifetime is the validity period of the certificate. Defaults to DefaultCertLifetime.
+	// It is capped to the remaining validity of the CA.
+	Lifetime time.Duration
+	// KeyType is the type of private key to generate. Defaults to DefaultKeyType.
+	KeyType KeyType
+}
+
+// IssueServingCert issues a serving certificate signed by the CA and returns the PEM encoded certificate
+// and private key. The certificate is usable for both server and client authentication.
+func (ca *CertificateAuthority) IssueServingCert(req ServingCertRequest) (certPEM, keyPEM []byte, err error) {
+	if len(req.DNSNames) == 0 && len(req.IPAddresses) == 0 {
+		return nil, nil, ErrNoSubjectAltNames
+	}
+
+	commonName := req.CommonName
+	if commonName == "" && len(req.DNSNames) > 0 {
+		commonName = req.DNSNames[0]
+	}
+
+	lifetime := req.Lifetime
+	if lifetime <= 0 {
+		lifetime = DefaultCertLifetime
+	}
+
+	keyType := req.KeyType
+	if keyType == "" {
+		keyType = DefaultKeyType
+	}
+
+	key, err := generateKey(keyType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	notAfter := now.Add(lifetime)
+	if notAfter.After(ca.Cert.NotAfter) {
+		notAfter = ca.Cert.NotAfter
+	}
+
+	template := &x509.Certificate{
+		Subject:               pkix.Name{CommonName: commonName},
+		DNSNames:              req.DNSNames,
+		IPAddresses:           req.IPAddresses,
+		NotBefore:             now.Add(-clockSkew),
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+	}
+
+	if _, certPEM, err = createCertificate(template, ca.Cert, key.Public(), ca.Key); err != nil {
+		return nil, nil, fmt.Errorf("failed to create serving certificate: %w", err)
+	}
+
+	if keyPEM, err = encodePrivateKey(key); err != nil {
+		return nil, nil, err
+	}
+
+	return certPEM, keyPEM, nil
+}
+
+func generateKey(keyType KeyType) (crypto.Signer, error) {
+	var (
+		key crypto.Signer
+		err error
+	)
+
+	switch keyType {
+	case KeyTypeECDSAP256, "":
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case KeyTypeECDSAP384:
+		key, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	case KeyTypeRSA2048:
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+	case KeyTypeRSA4096:
+		key, err = rsa.GenerateKey(rand.Reader, 4096)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedKeyType, keyType)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate %s key: %w", keyType, err)
+	}
+
+	return key, nil
+}
+
+// keyTypeOf returns the KeyType matching a public key, or an empty KeyType if it is not one this package generates.
+func keyTypeOf(pub crypto.PublicKey) KeyType {
+	switch key := pub.(type) {
+	case *ecdsa.PublicKey:
+		switch key.Curve {
+		case elliptic.P256():
+			return KeyTypeECDSAP256
+		case elliptic.P384():
+			return KeyTypeECDSAP384
+		}
+	case *rsa.PublicKey:
+		switch key.N.BitLen() {
+		case 2048:
+			return KeyTypeRSA2048
+		case 4096:
+			return KeyTypeRSA4096
+		}
+	}
+
+	return ""
+}
+
+func createCertificate(template, parent *x509.Certificate, pub crypto.PublicKey, signer crypto.Signer) (*x509.Certificate, []byte, error) {
+	// Serial numbers must be unique per CA and at most 20 bytes long (RFC 5280).
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate serial number: %w", err)
+	}
+
+	template.SerialNumber = serial
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, pub, signer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse generated certificate: %w", err)
+	}
+
+	return cert, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), nil
+}
+
+func encodePrivateKey(key crypto.Signer) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal private key: %w", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// parseCertificate parses the first PEM encoded certificate in data.
+func parseCertificate(data []byte) (*x509.Certificate, error) {
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate: %w", err)
+		}
+
+		return cert, nil
+	}
+
+	return nil, errors.New("no PEM encoded certificate found")
+}
+
+func parsePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM encoded private key found")
+	}
+
+	var (
+		key any
+		err error
+	)
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+
+	return signer, nil
+}
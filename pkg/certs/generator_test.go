@@ -0,0 +1,97 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CertificateAuthority", func() {
+	It("should issue serving certificates that verify against the CA", func() {
+		ca, err := NewSelfSignedCA("test-ca", time.Hour, KeyTypeECDSAP256)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ca.Cert.IsCA).To(BeTrue())
+
+		certPEM, keyPEM, err := ca.IssueServingCert(ServingCertRequest{
+			DNSNames:    []string{"webhook.operator.svc"},
+			IPAddresses: []net.IP{net.ParseIP("127.0.0.1")},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		keyPair, err := tls.X509KeyPair(certPEM, keyPEM)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keyPair.Leaf.Subject.CommonName).To(Equal("webhook.operator.svc"))
+		Expect(keyPair.Leaf.NotAfter).NotTo(BeTemporally(">", ca.Cert.NotAfter), "lifetime should be capped to the CA")
+
+		roots := x509.NewCertPool()
+		roots.AddCert(ca.Cert)
+		_, err = keyPair.Leaf.Verify(x509.VerifyOptions{DNSName: "webhook.operator.svc", Roots: roots})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should generate the requested key type", func() {
+		ca, err := NewSelfSignedCA("test-ca", time.Hour, KeyTypeRSA2048)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ca.Key).To(BeAssignableToTypeOf(&rsa.PrivateKey{}))
+
+		_, keyPEM, err := ca.IssueServingCert(ServingCertRequest{DNSNames: []string{"a"}, KeyType: KeyTypeECDSAP256})
+		Expect(err).NotTo(HaveOccurred())
+		key, err := parsePrivateKey(keyPEM)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(key).To(BeAssignableToTypeOf(&ecdsa.PrivateKey{}))
+	})
+
+	It("should reject unknown key types", func() {
+		_, err := NewSelfSignedCA("test-ca", time.Hour, KeyType("DSA"))
+		Expect(err).To(MatchError(ErrUnsupportedKeyType))
+	})
+
+	It("should reject serving certificates without subject alternative names", func() {
+		ca, err := NewSelfSignedCA("test-ca", time.Hour, DefaultKeyType)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, _, err = ca.IssueServingCert(ServingCertRequest{CommonName: "no-sans"})
+		Expect(err).To(MatchError(ErrNoSubjectAltNames))
+	})
+
+	It("should round-trip through ParseCertificateAuthority", func() {
+		ca, err := NewSelfSignedCA("test-ca", time.Hour, DefaultKeyType)
+		Expect(err).NotTo(HaveOccurred())
+
+		parsed, err := ParseCertificateAuthority(ca.CertPEM, ca.KeyPEM)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parsed.Cert.Equal(ca.Cert)).To(BeTrue())
+	})
+
+	It("should refuse to parse a leaf certificate as a CA", func() {
+		ca, err := NewSelfSignedCA("test-ca", time.Hour, DefaultKeyType)
+		Expect(err).NotTo(HaveOccurred())
+		certPEM, keyPEM, err := ca.IssueServingCert(ServingCertRequest{DNSNames: []string{"leaf"}})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = ParseCertificateAuthority(certPEM, keyPEM)
+		Expect(err).To(MatchError(ContainSubstring("is not a CA")))
+	})
+})
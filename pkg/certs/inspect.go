@@ -0,0 +1,207 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CertificateInfo describes a single certificate of a chain.
+type CertificateInfo struct {
+	Subject      string
+	Issuer       string
+	SerialNumber string
+	DNSNames     []string
+	IPAddresses  []string
+	NotBefore    time.Time
+	NotAfter     time.Time
+	IsCA         bool
+	SelfSigned   bool
+	KeyUsages    []string
+	ExtKeyUsages []string
+}
+
+// ChainReport is the result of inspecting a PEM bundle.
+type ChainReport struct {
+	// Certificates are the certificates of the bundle, in the order they appear in it.
+	// The first one is treated as the leaf.
+	Certificates []CertificateInfo
+	// Verified is true when the leaf verifies against the roots, using the other certificates as intermediates.
+	Verified bool
+	// VerificationError is the reason the chain does not verify, if it does not.
+	VerificationError string
+	// Problems lists the issues found with the bundle, such as expired certificates or a chain that does not verify.
+	Problems []string
+}
+
+// Inspect parses a PEM bundle, such as a custom certificate supplied by an admin, and reports the details
+// of its certificates and whether the chain verifies against roots. A nil roots verifies against the system pool.
+// Non-certificate PEM blocks, such as private keys, are ignored.
+//
+// It only returns an error when the bundle cannot be parsed; the problems with a parsed chain are
+// reported in the ChainReport, so that they can be surfaced in validation webhooks and Degraded conditions.
+func Inspect(pemBundle []byte, roots *x509.CertPool) (*ChainReport, error) {
+	var certificates []*x509.Certificate
+
+	for block, rest := pem.Decode(pemBundle); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate %d: %w", len(certificates)+1, err)
+		}
+
+		certificates = append(certificates, cert)
+	}
+
+	if len(certificates) == 0 {
+		return nil, ErrNoCertificatesInBundle
+	}
+
+	report := &ChainReport{}
+	now := time.Now()
+
+	for _, cert := range certificates {
+		info := inspectCertificate(cert)
+		report.Certificates = append(report.Certificates, info)
+
+		switch {
+		case now.After(cert.NotAfter):
+			report.Problems = append(report.Problems, fmt.Sprintf("certificate %q expired at %s", info.Subject, cert.NotAfter.UTC().Format(time.RFC3339)))
+		case now.Before(cert.NotBefore):
+			report.Problems = append(report.Problems, fmt.Sprintf("certificate %q is not valid before %s", info.Subject, cert.NotBefore.UTC().Format(time.RFC3339)))
+		}
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certificates[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	if _, err := certificates[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}); err != nil {
+		report.VerificationError = err.Error()
+		report.Problems = append(report.Problems, fmt.Sprintf("chain does not verify: %s", err.Error()))
+	} else {
+		report.Verified = true
+	}
+
+	return report, nil
+}
+
+// String returns a human-readable report, one line per certificate followed by the problems found.
+func (r *ChainReport) String() string {
+	var b strings.Builder
+
+	for i, cert := range r.Certificates {
+		fmt.Fprintf(&b, "[%d] subject=%q issuer=%q notBefore=%s notAfter=%s ca=%t",
+			i, cert.Subject, cert.Issuer,
+			cert.NotBefore.UTC().Format(time.RFC3339), cert.NotAfter.UTC().Format(time.RFC3339), cert.IsCA)
+
+		if sans := append(append([]string{}, cert.DNSNames...), cert.IPAddresses...); len(sans) > 0 {
+			fmt.Fprintf(&b, " sans=%s", strings.Join(sans, ","))
+		}
+
+		if len(cert.ExtKeyUsages) > 0 {
+			fmt.Fprintf(&b, " extKeyUsages=%s", strings.Join(cert.ExtKeyUsages, ","))
+		}
+
+		b.WriteString("\n")
+	}
+
+	if len(r.Problems) == 0 {
+		b.WriteString("no problems found\n")
+	}
+
+	for _, problem := range r.Problems {
+		fmt.Fprintf(&b, "problem: %s\n", problem)
+	}
+
+	return b.String()
+}
+
+func inspectCertificate(cert *x509.Certificate) CertificateInfo {
+	info := CertificateInfo{
+		Subject:      cert.Subject.String(),
+		Issuer:       cert.Issuer.String(),
+		SerialNumber: cert.SerialNumber.String(),
+		DNSNames:     cert.DNSNames,
+		NotBefore:    cert.NotBefore,
+		NotAfter:     cert.NotAfter,
+		IsCA:         cert.IsCA,
+		SelfSigned:   cert.CheckSignatureFrom(cert) == nil,
+	}
+
+	for _, ip := range cert.IPAddresses {
+		info.IPAddresses = append(info.IPAddresses, ip.String())
+	}
+
+	for _, usage := range []struct {
+		bit  x509.KeyUsage
+		name string
+	}{
+		{x509.KeyUsageDigitalSignature, "DigitalSignature"},
+		{x509.KeyUsageContentCommitment, "ContentCommitment"},
+		{x509.KeyUsageKeyEncipherment, "KeyEncipherment"},
+		{x509.KeyUsageDataEncipherment, "DataEncipherment"},
+		{x509.KeyUsageKeyAgreement, "KeyAgreement"},
+		{x509.KeyUsageCertSign, "CertSign"},
+		{x509.KeyUsageCRLSign, "CRLSign"},
+		{x509.KeyUsageEncipherOnly, "EncipherOnly"},
+		{x509.KeyUsageDecipherOnly, "DecipherOnly"},
+	} {
+		if cert.KeyUsage&usage.bit != 0 {
+			info.KeyUsages = append(info.KeyUsages, usage.name)
+		}
+	}
+
+	for _, usage := range cert.ExtKeyUsage {
+		info.ExtKeyUsages = append(info.ExtKeyUsages, extKeyUsageName(usage))
+	}
+
+	return info
+}
+
+func extKeyUsageName(usage x509.ExtKeyUsage) string {
+	switch usage {
+	case x509.ExtKeyUsageAny:
+		return "Any"
+	case x509.ExtKeyUsageServerAuth:
+		return "ServerAuth"
+	case x509.ExtKeyUsageClientAuth:
+		return "ClientAuth"
+	case x509.ExtKeyUsageCodeSigning:
+		return "CodeSigning"
+	case x509.ExtKeyUsageEmailProtection:
+		return "EmailProtection"
+	case x509.ExtKeyUsageTimeStamping:
+		return "TimeStamping"
+	case x509.ExtKeyUsageOCSPSigning:
+		return "OCSPSigning"
+	default:
+		return fmt.Sprintf("Unknown(%d)", usage)
+	}
+}
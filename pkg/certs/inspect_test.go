@@ -0,0 +1,75 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"crypto/x509"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Inspect", func() {
+	var ca *CertificateAuthority
+
+	BeforeEach(func() {
+		var err error
+		ca, err = NewSelfSignedCA("inspect-ca", DefaultCALifetime, DefaultKeyType)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should report the details of a chain that verifies", func() {
+		certPEM, keyPEM, err := ca.IssueServingCert(ServingCertRequest{DNSNames: []string{"api.example.com"}})
+		Expect(err).NotTo(HaveOccurred())
+
+		roots := x509.NewCertPool()
+		roots.AddCert(ca.Cert)
+
+		// Private keys in the bundle are ignored.
+		report, err := Inspect(append(append(certPEM, ca.CertPEM...), keyPEM...), roots)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Verified).To(BeTrue())
+		Expect(report.Problems).To(BeEmpty())
+		Expect(report.Certificates).To(HaveLen(2))
+
+		leaf := report.Certificates[0]
+		Expect(leaf.Subject).To(Equal("CN=api.example.com"))
+		Expect(leaf.DNSNames).To(ConsistOf("api.example.com"))
+		Expect(leaf.ExtKeyUsages).To(ConsistOf("ServerAuth", "ClientAuth"))
+		Expect(leaf.SelfSigned).To(BeFalse())
+		Expect(report.Certificates[1].IsCA).To(BeTrue())
+		Expect(report.Certificates[1].SelfSigned).To(BeTrue())
+
+		Expect(report.String()).To(ContainSubstring("no problems found"))
+	})
+
+	It("should report a chain that does not verify against the roots", func() {
+		certPEM, _, err := ca.IssueServingCert(ServingCertRequest{DNSNames: []string{"api.example.com"}})
+		Expect(err).NotTo(HaveOccurred())
+
+		report, err := Inspect(certPEM, x509.NewCertPool())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Verified).To(BeFalse())
+		Expect(report.VerificationError).NotTo(BeEmpty())
+		Expect(report.String()).To(ContainSubstring("problem: chain does not verify"))
+	})
+
+	It("should fail on bundles without certificates", func() {
+		_, err := Inspect([]byte("not a certificate"), nil)
+		Expect(err).To(MatchError(ErrNoCertificatesInBundle))
+	})
+})
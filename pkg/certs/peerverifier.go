@@ -0,0 +1,208 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/openshift/controller-runtime-common/pkg/metricsregistry"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// DefaultPeerCacheTTL is the default time a verified peer certificate is remembered.
+	DefaultPeerCacheTTL = 5 * time.Minute
+	// DefaultPeerCacheSize is the default maximum number of verified peer certificates remembered.
+	DefaultPeerCacheSize = 1024
+
+	peerRejectionMissing    = "missing"
+	peerRejectionUnverified = "unverified"
+	peerRejectionNotAllowed = "not_allowed"
+)
+
+// ErrPeerNotAllowed is returned when a peer certificate does not match any of the allowed names.
+var ErrPeerNotAllowed = errors.New("peer certificate is not allowed")
+
+var peerCertificateRejections = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "peer_certificate_rejections_total",
+	Help: "Number of peer certificates rejected by a PeerVerifier, by verifier name and reason.",
+}, []string{"name", "reason"})
+
+func init() {
+	metricsregistry.Add(peerCertificateRejections)
+}
+
+// PeerVerifier restricts the client certificates accepted by a server, such as a webhook server,
+// to those carrying an allowed common name or subject alternative name.
+//
+// It runs after the TLS stack has verified the chain against ClientCAs, and remembers the certificates
+// it has accepted for CacheTTL, so that clients reconnecting frequently are not matched again on every handshake.
+// Rejections are counted in the peer_certificate_rejections_total metric.
+type PeerVerifier struct {
+	// Name identifies the verifier in metrics.
+	Name string
+
+	// AllowedCommonNames are the subject common names accepted.
+	AllowedCommonNames []string
+
+	// AllowedSANs are the DNS, email and URI subject alternative names accepted.
+	AllowedSANs []string
+
+	// CacheTTL is the time an accepted certificate is remembered. Defaults to DefaultPeerCacheTTL.
+	CacheTTL time.Duration
+
+	// CacheSize is the maximum number of accepted certificates remembered. Defaults to DefaultPeerCacheSize.
+	CacheSize int
+
+	mu    sync.Mutex
+	cache map[[sha256.Size]byte]time.Time
+}
+
+// TLSOpt returns a function that configures a tls.Config to require and verify client certificates,
+// and to only accept those allowed by the verifier. ClientCAs must be set, e.g. with ClientCAWatcher.TLSOpt.
+// It can be combined with tlsidentity.Provider.TLSOpt and ClientCAWatcher.TLSOpt in any order: client
+// certificates are required even when a previous option only verifies them if given, and the verifier is kept
+// when the configuration is cloned for each connection.
+func (v *PeerVerifier) TLSOpt() func(*tls.Config) {
+	return func(base *tls.Config) {
+		base.ClientAuth = tls.RequireAndVerifyClientCert
+
+		previous := base.VerifyPeerCertificate
+		base.VerifyPeerCertificate = func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+			if previous != nil {
+				if err := previous(rawCerts, verifiedChains); err != nil {
+					return err
+				}
+			}
+
+			return v.VerifyPeerCertificate(rawCerts, verifiedChains)
+		}
+	}
+}
+
+// VerifyPeerCertificate implements the tls.Config hook of the same name.
+// Handshakes without a client certificate are rejected, whatever the ClientAuth policy.
+func (v *PeerVerifier) VerifyPeerCertificate(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+	if len(rawCerts) == 0 {
+		peerCertificateRejections.WithLabelValues(v.Name, peerRejectionMissing).Inc()
+		return fmt.Errorf("%w: no certificate was presented", ErrPeerNotAllowed)
+	}
+
+	if len(verifiedChains) == 0 || len(verifiedChains[0]) == 0 {
+		peerCertificateRejections.WithLabelValues(v.Name, peerRejectionUnverified).Inc()
+		return fmt.Errorf("%w: certificate chain was not verified", ErrPeerNotAllowed)
+	}
+
+	leaf := verifiedChains[0][0]
+	fingerprint := sha256.Sum256(leaf.Raw)
+	now := time.Now()
+
+	if v.cached(fingerprint, now) {
+		return nil
+	}
+
+	if !v.allowed(leaf) {
+		peerCertificateRejections.WithLabelValues(v.Name, peerRejectionNotAllowed).Inc()
+		return fmt.Errorf("%w: %q", ErrPeerNotAllowed, leaf.Subject.String())
+	}
+
+	v.remember(fingerprint, leaf, now)
+
+	return nil
+}
+
+func (v *PeerVerifier) allowed(leaf *x509.Certificate) bool {
+	if slices.Contains(v.AllowedCommonNames, leaf.Subject.CommonName) {
+		return true
+	}
+
+	for _, san := range leaf.DNSNames {
+		if slices.Contains(v.AllowedSANs, san) {
+			return true
+		}
+	}
+
+	for _, san := range leaf.EmailAddresses {
+		if slices.Contains(v.AllowedSANs, san) {
+			return true
+		}
+	}
+
+	for _, san := range leaf.URIs {
+		if slices.Contains(v.AllowedSANs, san.String()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (v *PeerVerifier) cached(fingerprint [sha256.Size]byte, now time.Time) bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	expiry, ok := v.cache[fingerprint]
+
+	return ok && now.Before(expiry)
+}
+
+// remember caches an accepted certificate until the TTL or the certificate expires, whichever comes first.
+func (v *PeerVerifier) remember(fingerprint [sha256.Size]byte, leaf *x509.Certificate, now time.Time) {
+	ttl := v.CacheTTL
+	if ttl <= 0 {
+		ttl = DefaultPeerCacheTTL
+	}
+
+	size := v.CacheSize
+	if size <= 0 {
+		size = DefaultPeerCacheSize
+	}
+
+	expiry := now.Add(ttl)
+	if leaf.NotAfter.Before(expiry) {
+		expiry = leaf.NotAfter
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.cache == nil {
+		v.cache = map[[sha256.Size]byte]time.Time{}
+	}
+
+	if len(v.cache) >= size {
+		for key, entryExpiry := range v.cache {
+			if !now.Before(entryExpiry) {
+				delete(v.cache, key)
+			}
+		}
+
+		// Still full of live entries: start over rather than tracking recency.
+		if len(v.cache) >= size {
+			clear(v.cache)
+		}
+	}
+
+	v.cache[fingerprint] = expiry
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var _ = Describe("PeerVerifier", func() {
+	var (
+		ca       *CertificateAuthority
+		verifier *PeerVerifier
+	)
+
+	issue := func(commonName string, dnsNames ...string) [][]*x509.Certificate {
+		certPEM, _, err := ca.IssueServingCert(ServingCertRequest{CommonName: commonName, DNSNames: dnsNames})
+		Expect(err).NotTo(HaveOccurred())
+
+		leaf, err := parseCertificate(certPEM)
+		Expect(err).NotTo(HaveOccurred())
+
+		return [][]*x509.Certificate{{leaf, ca.Cert}}
+	}
+
+	BeforeEach(func() {
+		var err error
+		ca, err = NewSelfSignedCA("client-ca", DefaultCALifetime, DefaultKeyType)
+		Expect(err).NotTo(HaveOccurred())
+
+		verifier = &PeerVerifier{
+			Name:               "test-" + CurrentSpecReport().LeafNodeText,
+			AllowedCommonNames: []string{"system:kube-apiserver"},
+			AllowedSANs:        []string{"apiserver.example.com"},
+		}
+	})
+
+	It("should accept allowed common names and SANs", func() {
+		chains := issue("system:kube-apiserver", "other.example.com")
+		Expect(verifier.VerifyPeerCertificate([][]byte{chains[0][0].Raw}, chains)).To(Succeed())
+
+		chains = issue("someone", "apiserver.example.com")
+		Expect(verifier.VerifyPeerCertificate([][]byte{chains[0][0].Raw}, chains)).To(Succeed())
+		Expect(verifier.cache).To(HaveLen(2))
+	})
+
+	It("should reject other peers and count the rejections", func() {
+		chains := issue("someone", "other.example.com")
+		Expect(verifier.VerifyPeerCertificate([][]byte{chains[0][0].Raw}, chains)).To(MatchError(ErrPeerNotAllowed))
+		Expect(verifier.VerifyPeerCertificate([][]byte{chains[0][0].Raw}, nil)).To(MatchError(ErrPeerNotAllowed))
+
+		Expect(testutil.ToFloat64(peerCertificateRejections.WithLabelValues(verifier.Name, peerRejectionNotAllowed))).To(BeEquivalentTo(1))
+		Expect(testutil.ToFloat64(peerCertificateRejections.WithLabelValues(verifier.Name, peerRejectionUnverified))).To(BeEquivalentTo(1))
+	})
+
+	It("should reject handshakes without a client certificate", func() {
+		Expect(verifier.VerifyPeerCertificate(nil, nil)).To(MatchError(ErrPeerNotAllowed))
+		Expect(testutil.ToFloat64(peerCertificateRejections.WithLabelValues(verifier.Name, peerRejectionMissing))).To(BeEquivalentTo(1))
+	})
+
+	It("should require client certificates when combined with the ClientCAWatcher", func() {
+		for _, opts := range [][]func(*tls.Config){
+			{(&ClientCAWatcher{}).TLSOpt(), verifier.TLSOpt()},
+			{verifier.TLSOpt(), (&ClientCAWatcher{}).TLSOpt()},
+		} {
+			tlsConf := &tls.Config{MinVersion: tls.VersionTLS12}
+			for _, opt := range opts {
+				opt(tlsConf)
+			}
+
+			conf, err := tlsConf.GetConfigForClient(&tls.ClientHelloInfo{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(conf.ClientAuth).To(Equal(tls.RequireAndVerifyClientCert))
+			Expect(conf.VerifyPeerCertificate(nil, nil)).To(MatchError(ErrPeerNotAllowed))
+		}
+	})
+
+	It("should bound the cache size", func() {
+		verifier.CacheSize = 2
+
+		for _, name := range []string{"a.example.com", "b.example.com", "c.example.com"} {
+			verifier.AllowedSANs = append(verifier.AllowedSANs, name)
+			chains := issue(name, name)
+			Expect(verifier.VerifyPeerCertificate([][]byte{chains[0][0].Raw}, chains)).To(Succeed())
+		}
+
+		Expect(len(verifier.cache)).To(BeNumerically("<=", 2))
+	})
+})
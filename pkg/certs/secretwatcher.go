@@ -0,0 +1,182 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// SecretCertWatcher watches a kubernetes.io/tls Secret, such as the serving certificate generated by service-ca,
+// and keeps the certificate it contains in memory so that servers can rotate it without restarting.
+//
+// The watcher runs on every replica, regardless of leader election, as each replica serves the certificate.
+// Note that the manager cache will hold every Secret the manager is allowed to list, unless it is restricted,
+// e.g. with cache.Options.ByObject.
+type SecretCertWatcher struct {
+	client.Client
+
+	// Secret is the namespace and name of the Secret to watch.
+	Secret types.NamespacedName
+
+	// CertKey is the key of the PEM encoded certificate in the Secret data.
+	// Defaults to corev1.TLSCertKey.
+	CertKey string
+
+	// KeyKey is the key of the PEM encoded private key in the Secret data.
+	// Defaults to corev1.TLSPrivateKeyKey.
+	KeyKey string
+
+	// OnRotate is a function that will be called when the certificate changes.
+	// It receives the reconcile context, the old and the new certificate.
+	// The old certificate is nil when the first certificate is loaded by the controller.
+	OnRotate func(ctx context.Context, oldCert, newCert *tls.Certificate)
+
+	cert atomic.Pointer[tls.Certificate]
+}
+
+// Load reads the certificate from the Secret using the given reader.
+// It is intended to be called with the manager's APIReader before the manager is started,
+// so that servers have a certificate to serve from the beginning.
+func (r *SecretCertWatcher) Load(ctx context.Context, reader client.Reader) error {
+	secret := &corev1.Secret{}
+	if err := reader.Get(ctx, r.Secret, secret); err != nil {
+		return fmt.Errorf("failed to get Secret %s: %w", r.Secret.String(), err)
+	}
+
+	cert, err := r.parse(secret)
+	if err != nil {
+		return err
+	}
+
+	r.cert.Store(cert)
+
+	return nil
+}
+
+// Certificate returns the current certificate, or nil if none has been loaded yet.
+func (r *SecretCertWatcher) Certificate() *tls.Certificate {
+	return r.cert.Load()
+}
+
+// GetCertificate returns the current certificate.
+// It is intended to be used as tls.Config.GetCertificate, see DynamicTLSConfig.
+func (r *SecretCertWatcher) GetCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cert := r.cert.Load()
+	if cert == nil {
+		return nil, ErrNoCertificate
+	}
+
+	return cert, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *SecretCertWatcher) SetupWithManager(mgr ctrl.Manager) error {
+	isWatchedSecret := func(obj client.Object) bool {
+		return obj.GetNamespace() == r.Secret.Namespace && obj.GetName() == r.Secret.Name
+	}
+
+	if err := ctrl.NewControllerManagedBy(mgr).
+		Named("servingcertwatcher").
+		WithOptions(controller.Options{NeedLeaderElection: ptr.To(false)}).
+		For(&corev1.Secret{}, builder.WithPredicates(predicate.NewPredicateFuncs(isWatchedSecret))).
+		// Override the default log constructor as it makes the logs very chatty.
+		WithLogConstructor(func(_ *reconcile.Request) logr.Logger {
+			return mgr.GetLogger().WithValues(
+				"controller", "servingcertwatcher",
+				"secret", r.Secret.String(),
+			)
+		}).
+		Complete(r); err != nil {
+		return fmt.Errorf("could not set up controller for serving certificate watcher: %w", err)
+	}
+
+	return nil
+}
+
+// Reconcile reloads the certificate from the Secret and invokes the callback when it has changed.
+func (r *SecretCertWatcher) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	logger.V(1).Info("Reconciling serving certificate Secret")
+	defer logger.V(1).Info("Finished reconciling serving certificate Secret")
+
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, req.NamespacedName, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Keep serving the current certificate, the Secret is expected to be recreated.
+			logger.Info("Serving certificate Secret not found, keeping the current certificate")
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get Secret %s: %w", req.NamespacedName.String(), err)
+	}
+
+	newCert, err := r.parse(secret)
+	if err != nil {
+		// The Secret may be mid-update; keep the current certificate and retry with backoff.
+		return ctrl.Result{}, err
+	}
+
+	oldCert := r.cert.Load()
+	if certificatesEqual(oldCert, newCert) {
+		return ctrl.Result{}, nil
+	}
+
+	r.cert.Store(newCert)
+	logger.Info("Serving certificate rotated")
+
+	if r.OnRotate != nil {
+		r.OnRotate(ctx, oldCert, newCert)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *SecretCertWatcher) parse(secret *corev1.Secret) (*tls.Certificate, error) {
+	certKey := r.CertKey
+	if certKey == "" {
+		certKey = corev1.TLSCertKey
+	}
+
+	keyKey := r.KeyKey
+	if keyKey == "" {
+		keyKey = corev1.TLSPrivateKeyKey
+	}
+
+	cert, err := tls.X509KeyPair(secret.Data[certKey], secret.Data[keyKey])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate from Secret %s/%s: %w", secret.Namespace, secret.Name, err)
+	}
+
+	return &cert, nil
+}
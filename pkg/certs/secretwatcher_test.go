@@ -0,0 +1,136 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"context"
+	"crypto/tls"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("SecretCertWatcher", func() {
+	var (
+		fakeClient client.Client
+		secret     *corev1.Secret
+		watcher    *SecretCertWatcher
+		rotations  []string
+	)
+
+	key := types.NamespacedName{Namespace: "operator", Name: "serving-cert"}
+	req := ctrl.Request{NamespacedName: key}
+
+	setKeyPair := func(commonName string) {
+		GinkgoHelper()
+		certPEM, keyPEM := generateTestKeyPair(commonName)
+		secret.Data = map[string][]byte{
+			corev1.TLSCertKey:       certPEM,
+			corev1.TLSPrivateKeyKey: keyPEM,
+		}
+	}
+
+	BeforeEach(func() {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+			Type:       corev1.SecretTypeTLS,
+		}
+		setKeyPair("initial")
+		fakeClient = fake.NewClientBuilder().WithObjects(secret).Build()
+
+		rotations = nil
+		watcher = &SecretCertWatcher{
+			Client: fakeClient,
+			Secret: key,
+			OnRotate: func(_ context.Context, _, newCert *tls.Certificate) {
+				rotations = append(rotations, newCert.Leaf.Subject.CommonName)
+			},
+		}
+	})
+
+	It("should return an error before a certificate is loaded", func() {
+		_, err := watcher.GetCertificate(nil)
+		Expect(err).To(MatchError(ErrNoCertificate))
+	})
+
+	It("should load the certificate without invoking the callback", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+
+		cert, err := watcher.GetCertificate(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cert.Leaf.Subject.CommonName).To(Equal("initial"))
+		Expect(rotations).To(BeEmpty())
+	})
+
+	It("should not invoke the callback when the certificate is unchanged", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+
+		_, err := watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rotations).To(BeEmpty())
+	})
+
+	It("should rotate the certificate when the Secret changes", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+
+		setKeyPair("rotated")
+		Expect(fakeClient.Update(ctx, secret)).To(Succeed())
+
+		_, err := watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rotations).To(Equal([]string{"rotated"}))
+		Expect(watcher.Certificate().Leaf.Subject.CommonName).To(Equal("rotated"))
+	})
+
+	It("should keep the current certificate and return an error when the Secret is invalid", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+
+		secret.Data[corev1.TLSCertKey] = []byte("garbage")
+		Expect(fakeClient.Update(ctx, secret)).To(Succeed())
+
+		_, err := watcher.Reconcile(ctx, req)
+		Expect(err).To(HaveOccurred())
+		Expect(watcher.Certificate().Leaf.Subject.CommonName).To(Equal("initial"))
+	})
+
+	It("should keep the current certificate when the Secret is deleted", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+		Expect(fakeClient.Delete(ctx, secret)).To(Succeed())
+
+		_, err := watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(watcher.Certificate().Leaf.Subject.CommonName).To(Equal("initial"))
+	})
+
+	It("should configure a tls.Config to serve the current certificate", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+
+		tlsConf := &tls.Config{Certificates: []tls.Certificate{{}}}
+		DynamicTLSConfig(watcher)(tlsConf)
+
+		Expect(tlsConf.Certificates).To(BeEmpty())
+		cert, err := tlsConf.GetCertificate(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cert).To(BeIdenticalTo(watcher.Certificate()))
+	})
+})
@@ -0,0 +1,374 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// CACertKey is the key of the PEM encoded CA bundle in Secrets written by SelfSignedServingCert.
+	// The current CA comes first, followed by the previous CA while it is still valid.
+	CACertKey = "ca.crt"
+	// CAKeyKey is the key of the PEM encoded CA private key in Secrets written by SelfSignedServingCert.
+	CAKeyKey = "ca.key"
+
+	// DefaultCheckInterval is the default interval at which generated certificates are checked for renewal.
+	DefaultCheckInterval = time.Hour
+
+	// DefaultRenewAtFraction is the default fraction of a certificate's lifetime after which it is renewed.
+	DefaultRenewAtFraction = 0.8
+)
+
+// SelfSignedServingCert generates a self-signed CA and a serving certificate issued by it,
+// persists them to a kubernetes.io/tls Secret and renews them before they expire.
+//
+// It is intended for environments without service-ca, such as vanilla Kubernetes, local development or envtest.
+// The Secret can be consumed with SecretCertWatcher for serving, and its CACertKey entry used as caBundle.
+// When the CA is renewed, the previous CA is kept in the bundle until it expires, so that clients
+// trusting the bundle keep accepting certificates issued by either CA during the rotation.
+//
+// SelfSignedServingCert implements manager.Runnable and only runs on the leader, as it writes to the Secret.
+type SelfSignedServingCert struct {
+	client.Client
+
+	// Secret is the namespace and name of the Secret to write the certificates to.
+	Secret types.NamespacedName
+
+	// CommonName is the common name of the serving certificate. Defaults to the first DNS name.
+	CommonName string
+
+	// DNSNames are the DNS subject alternative names of the serving certificate,
+	// typically <service>.<namespace>.svc and <service>.<namespace>.svc.cluster.local.
+	DNSNames []string
+
+	// IPAddresses are the IP subject alternative names of the serving certificate.
+	IPAddresses []net.IP
+
+	// CALifetime is the validity period of the CA. Defaults to DefaultCALifetime.
+	CALifetime time.Duration
+
+	// CertLifetime is the validity period of the serving certificate. Defaults to DefaultCertLifetime.
+	CertLifetime time.Duration
+
+	// RenewBefore is how long before expiry a certificate is renewed.
+	// When unset, certificates are renewed according to RenewAtFraction.
+	RenewBefore time.Duration
+
+	// RenewAtFraction is the fraction of a certificate's lifetime after which it is renewed,
+	// e.g. 0.8 renews certificates once 80% of their lifetime has elapsed. Defaults to DefaultRenewAtFraction.
+	RenewAtFraction float64
+
+	// KeyType is the type of private keys to generate. Defaults to DefaultKeyType.
+	// Changing it regenerates both the CA and the serving certificate.
+	KeyType KeyType
+
+	// CheckInterval is the interval at which the certificates are checked for renewal.
+	// Defaults to DefaultCheckInterval.
+	CheckInterval time.Duration
+
+	// OnRotate is a function that will be called after the Secret has been written with new certificates.
+	// It receives the context passed to Ensure and the updated Secret.
+	OnRotate func(ctx context.Context, secret *corev1.Secret)
+}
+
+// SelfSignedOption configures a SelfSignedServingCert created with NewSelfSignedServingCert.
+type SelfSignedOption func(*SelfSignedServingCert)
+
+// WithNames sets the common name and subject alternative names of the serving certificate.
+// An empty common name defaults to the first DNS name.
+func WithNames(commonName string, dnsNames []string, ipAddresses []net.IP) SelfSignedOption {
+	return func(g *SelfSignedServingCert) {
+		g.CommonName = commonName
+		g.DNSNames = dnsNames
+		g.IPAddresses = ipAddresses
+	}
+}
+
+// WithKeyType sets the type of private keys to generate.
+func WithKeyType(keyType KeyType) SelfSignedOption {
+	return func(g *SelfSignedServingCert) {
+		g.KeyType = keyType
+	}
+}
+
+// WithLifetimes sets the validity periods of the CA and the serving certificate.
+func WithLifetimes(caLifetime, certLifetime time.Duration) SelfSignedOption {
+	return func(g *SelfSignedServingCert) {
+		g.CALifetime = caLifetime
+		g.CertLifetime = certLifetime
+	}
+}
+
+// WithRenewAt renews certificates once the given fraction of their lifetime has elapsed, e.g. 0.8.
+func WithRenewAt(fraction float64) SelfSignedOption {
+	return func(g *SelfSignedServingCert) {
+		g.RenewAtFraction = fraction
+		g.RenewBefore = 0
+	}
+}
+
+// WithRenewBefore renews certificates the given duration before they expire.
+func WithRenewBefore(renewBefore time.Duration) SelfSignedOption {
+	return func(g *SelfSignedServingCert) {
+		g.RenewBefore = renewBefore
+	}
+}
+
+// WithCheckInterval sets the interval at which the certificates are checked for renewal.
+func WithCheckInterval(interval time.Duration) SelfSignedOption {
+	return func(g *SelfSignedServingCert) {
+		g.CheckInterval = interval
+	}
+}
+
+// NewSelfSignedServingCert returns a SelfSignedServingCert writing to the given Secret, configured with the options.
+//
+// Example:
+//
+//	generator := certs.NewSelfSignedServingCert(mgr.GetClient(), secret,
+//	    certs.WithNames("", []string{"webhook.operator.svc"}, nil),
+//	    certs.WithKeyType(certs.KeyTypeECDSAP384),
+//	    certs.WithRenewAt(0.8),
+//	)
+func NewSelfSignedServingCert(c client.Client, secret types.NamespacedName, opts ...SelfSignedOption) *SelfSignedServingCert {
+	g := &SelfSignedServingCert{Client: c, Secret: secret}
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	return g
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+func (g *SelfSignedServingCert) NeedLeaderElection() bool {
+	return true
+}
+
+// Start ensures the certificates immediately and then every CheckInterval until the context is cancelled.
+// Failures are logged and retried at the next check.
+func (g *SelfSignedServingCert) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithValues("secret", g.Secret.String())
+
+	interval := g.CheckInterval
+	if interval <= 0 {
+		interval = DefaultCheckInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := g.Ensure(ctx); err != nil {
+			logger.Error(err, "Failed to ensure self-signed serving certificate")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Ensure makes sure the Secret contains a valid CA and serving certificate matching the configuration,
+// generating and renewing them as needed, and returns the Secret.
+func (g *SelfSignedServingCert) Ensure(ctx context.Context) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	exists := true
+
+	if err := g.Get(ctx, g.Secret, secret); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get Secret %s: %w", g.Secret.String(), err)
+		}
+
+		exists = false
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: g.Secret.Namespace, Name: g.Secret.Name},
+			Type:       corev1.SecretTypeTLS,
+		}
+	}
+
+	data, changed, err := g.render(secret.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	if !changed {
+		return secret, nil
+	}
+
+	secret.Data = data
+
+	if exists {
+		if err := g.Update(ctx, secret); err != nil {
+			return nil, fmt.Errorf("failed to update Secret %s: %w", g.Secret.String(), err)
+		}
+	} else {
+		if err := g.Create(ctx, secret); err != nil {
+			return nil, fmt.Errorf("failed to create Secret %s: %w", g.Secret.String(), err)
+		}
+	}
+
+	log.FromContext(ctx).Info("Self-signed serving certificate rotated", "secret", g.Secret.String())
+
+	if g.OnRotate != nil {
+		g.OnRotate(ctx, secret)
+	}
+
+	return secret, nil
+}
+
+// render returns the Secret data holding valid certificates, and whether it differs from the current data.
+func (g *SelfSignedServingCert) render(current map[string][]byte) (map[string][]byte, bool, error) {
+	ca, caErr := ParseCertificateAuthority(current[CACertKey], current[CAKeyKey])
+	caBundle := current[CACertKey]
+	rotateCA := caErr != nil || g.needsRenewal(ca.Cert) || keyTypeOf(ca.Cert.PublicKey) != g.keyType()
+
+	if rotateCA {
+		newCA, err := NewSelfSignedCA(g.caCommonName(), g.caLifetime(), g.KeyType)
+		if err != nil {
+			return nil, false, err
+		}
+
+		caBundle = newCA.CertPEM
+		// Keep trusting the previous CA while certificates it issued may still be in use.
+		if caErr == nil && time.Now().Before(ca.Cert.NotAfter) {
+			caBundle = append(bytes.Clone(newCA.CertPEM), ca.CertPEM...)
+		}
+
+		ca = newCA
+	}
+
+	if !rotateCA && !g.servingCertNeedsRenewal(ca, current[corev1.TLSCertKey]) {
+		return current, false, nil
+	}
+
+	certPEM, keyPEM, err := ca.IssueServingCert(ServingCertRequest{
+		CommonName:  g.CommonName,
+		DNSNames:    g.DNSNames,
+		IPAddresses: g.IPAddresses,
+		Lifetime:    g.certLifetime(),
+		KeyType:     g.KeyType,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return map[string][]byte{
+		corev1.TLSCertKey:       certPEM,
+		corev1.TLSPrivateKeyKey: keyPEM,
+		CACertKey:               caBundle,
+		CAKeyKey:                ca.KeyPEM,
+	}, true, nil
+}
+
+// servingCertNeedsRenewal reports whether the serving certificate is missing, invalid, not issued by the CA,
+// close to expiry, or no longer matches the configured names and key type.
+func (g *SelfSignedServingCert) servingCertNeedsRenewal(ca *CertificateAuthority, certPEM []byte) bool {
+	cert, err := parseCertificate(certPEM)
+	if err != nil {
+		return true
+	}
+
+	if err := cert.CheckSignatureFrom(ca.Cert); err != nil {
+		return true
+	}
+
+	return g.needsRenewal(cert) || !g.matchesRequest(cert)
+}
+
+// matchesRequest reports whether the certificate was issued for the configured names and key type.
+// Names are compared as sets, so that reordering them does not trigger a regeneration.
+func (g *SelfSignedServingCert) matchesRequest(cert *x509.Certificate) bool {
+	commonName := g.CommonName
+	if commonName == "" && len(g.DNSNames) > 0 {
+		commonName = g.DNSNames[0]
+	}
+
+	ips := make([]string, 0, len(g.IPAddresses))
+	for _, ip := range g.IPAddresses {
+		ips = append(ips, ip.String())
+	}
+
+	certIPs := make([]string, 0, len(cert.IPAddresses))
+	for _, ip := range cert.IPAddresses {
+		certIPs = append(certIPs, ip.String())
+	}
+
+	return cert.Subject.CommonName == commonName &&
+		keyTypeOf(cert.PublicKey) == g.keyType() &&
+		sets.New(cert.DNSNames...).Equal(sets.New(g.DNSNames...)) &&
+		sets.New(certIPs...).Equal(sets.New(ips...))
+}
+
+// needsRenewal reports whether the certificate is within its renewal window.
+func (g *SelfSignedServingCert) needsRenewal(cert *x509.Certificate) bool {
+	renewBefore := g.RenewBefore
+	if renewBefore <= 0 {
+		fraction := g.RenewAtFraction
+		if fraction <= 0 || fraction > 1 {
+			fraction = DefaultRenewAtFraction
+		}
+
+		lifetime := cert.NotAfter.Sub(cert.NotBefore)
+		renewBefore = lifetime - time.Duration(float64(lifetime)*fraction)
+	}
+
+	return !time.Now().Before(cert.NotAfter.Add(-renewBefore))
+}
+
+func (g *SelfSignedServingCert) caCommonName() string {
+	return fmt.Sprintf("%s_%s-signer@%d", g.Secret.Namespace, g.Secret.Name, time.Now().Unix())
+}
+
+func (g *SelfSignedServingCert) caLifetime() time.Duration {
+	if g.CALifetime > 0 {
+		return g.CALifetime
+	}
+
+	return DefaultCALifetime
+}
+
+func (g *SelfSignedServingCert) keyType() KeyType {
+	if g.KeyType != "" {
+		return g.KeyType
+	}
+
+	return DefaultKeyType
+}
+
+func (g *SelfSignedServingCert) certLifetime() time.Duration {
+	if g.CertLifetime > 0 {
+		return g.CertLifetime
+	}
+
+	return DefaultCertLifetime
+}
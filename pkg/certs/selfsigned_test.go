@@ -0,0 +1,199 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("SelfSignedServingCert", func() {
+	var (
+		fakeClient client.Client
+		generator  *SelfSignedServingCert
+		rotations  int
+	)
+
+	key := types.NamespacedName{Namespace: "operator", Name: "webhook-cert"}
+
+	BeforeEach(func() {
+		fakeClient = fake.NewClientBuilder().Build()
+		rotations = 0
+		generator = &SelfSignedServingCert{
+			Client:   fakeClient,
+			Secret:   key,
+			DNSNames: []string{"webhook.operator.svc"},
+			OnRotate: func(_ context.Context, _ *corev1.Secret) {
+				rotations++
+			},
+		}
+	})
+
+	verify := func(secret *corev1.Secret) {
+		GinkgoHelper()
+		keyPair, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+		Expect(err).NotTo(HaveOccurred())
+
+		roots := x509.NewCertPool()
+		Expect(roots.AppendCertsFromPEM(secret.Data[CACertKey])).To(BeTrue())
+		_, err = keyPair.Leaf.Verify(x509.VerifyOptions{DNSName: "webhook.operator.svc", Roots: roots})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	It("should create the Secret with a CA and a serving certificate", func() {
+		secret, err := generator.Ensure(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secret.Type).To(Equal(corev1.SecretTypeTLS))
+		Expect(rotations).To(Equal(1))
+		verify(secret)
+
+		stored := &corev1.Secret{}
+		Expect(fakeClient.Get(ctx, key, stored)).To(Succeed())
+		Expect(stored.Data).To(Equal(secret.Data))
+	})
+
+	It("should not rewrite valid certificates", func() {
+		first, err := generator.Ensure(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		second, err := generator.Ensure(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(second.Data).To(Equal(first.Data))
+		Expect(rotations).To(Equal(1))
+	})
+
+	It("should renew the serving certificate within the renewal window, keeping the CA", func() {
+		first, err := generator.Ensure(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		generator.RenewBefore = 2 * DefaultCertLifetime
+		generator.CALifetime = 10 * DefaultCertLifetime
+		second, err := generator.Ensure(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(rotations).To(Equal(2))
+		Expect(second.Data[corev1.TLSCertKey]).NotTo(Equal(first.Data[corev1.TLSCertKey]))
+		verify(second)
+	})
+
+	It("should keep the previous CA in the bundle when the CA is renewed", func() {
+		generator.CALifetime = time.Hour
+		generator.CertLifetime = time.Hour
+		first, err := generator.Ensure(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		// Put the CA within its renewal window.
+		generator.RenewBefore = 2 * time.Hour
+		second, err := generator.Ensure(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		verify(second)
+
+		Expect(second.Data[CAKeyKey]).NotTo(Equal(first.Data[CAKeyKey]))
+		Expect(string(second.Data[CACertKey])).To(HaveSuffix(string(first.Data[CACertKey])))
+
+		// The bundle should never grow beyond the current and previous CA.
+		third, err := generator.Ensure(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(countCertificates(third.Data[CACertKey])).To(Equal(2))
+	})
+
+	It("should regenerate everything when the Secret holds invalid data", func() {
+		secret, err := generator.Ensure(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		secret.Data[CAKeyKey] = []byte("garbage")
+		Expect(fakeClient.Update(ctx, secret)).To(Succeed())
+
+		secret, err = generator.Ensure(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		verify(secret)
+	})
+
+	It("should reissue the serving certificate when the names change, but not when they are reordered", func() {
+		generator.DNSNames = []string{"webhook.operator.svc", "webhook.operator.svc.cluster.local"}
+		first, err := generator.Ensure(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		generator.DNSNames = []string{"webhook.operator.svc.cluster.local", "webhook.operator.svc"}
+		generator.CommonName = "webhook.operator.svc"
+		_, err = generator.Ensure(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rotations).To(Equal(1))
+
+		generator.DNSNames = []string{"webhook.operator.svc", "webhook.operator.svc.cluster.local", "webhook.example.com"}
+		second, err := generator.Ensure(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rotations).To(Equal(2))
+		Expect(second.Data[CAKeyKey]).To(Equal(first.Data[CAKeyKey]), "the CA should be kept")
+
+		keyPair, err := tls.X509KeyPair(second.Data[corev1.TLSCertKey], second.Data[corev1.TLSPrivateKeyKey])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keyPair.Leaf.DNSNames).To(ContainElement("webhook.example.com"))
+	})
+
+	It("should be configurable with options", func() {
+		generator = NewSelfSignedServingCert(fakeClient, key,
+			WithNames("", []string{"webhook.operator.svc"}, nil),
+			WithKeyType(KeyTypeECDSAP384),
+			WithLifetimes(4*time.Hour, time.Hour),
+			WithRenewAt(0.5),
+		)
+
+		first, err := generator.Ensure(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		verify(first)
+
+		keyPair, err := tls.X509KeyPair(first.Data[corev1.TLSCertKey], first.Data[corev1.TLSPrivateKeyKey])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keyTypeOf(keyPair.Leaf.PublicKey)).To(Equal(KeyTypeECDSAP384))
+
+		// Half of the lifetime has not elapsed yet.
+		second, err := generator.Ensure(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(second.Data).To(Equal(first.Data))
+
+		// Changing the key type regenerates the CA and reissues the serving certificate.
+		WithKeyType(KeyTypeRSA2048)(generator)
+		third, err := generator.Ensure(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(third.Data[corev1.TLSCertKey]).NotTo(Equal(first.Data[corev1.TLSCertKey]))
+		verify(third)
+
+		ca, err := ParseCertificateAuthority(third.Data[CACertKey], third.Data[CAKeyKey])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keyTypeOf(ca.Cert.PublicKey)).To(Equal(KeyTypeRSA2048))
+		Expect(countCertificates(third.Data[CACertKey])).To(Equal(2))
+	})
+})
+
+func countCertificates(bundle []byte) int {
+	count := 0
+	for block, rest := pem.Decode(bundle); block != nil; block, rest = pem.Decode(rest) {
+		count++
+	}
+	return count
+}
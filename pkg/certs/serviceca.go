@@ -0,0 +1,190 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ServingCertSecretAnnotation asks the service-ca operator to generate a serving certificate for a Service
+	// into the Secret named by the annotation value.
+	ServingCertSecretAnnotation = "service.beta.openshift.io/serving-cert-secret-name"
+	// InjectCABundleAnnotation asks the service-ca operator to inject the service CA bundle into a ConfigMap,
+	// a CustomResourceDefinition, a webhook configuration or an APIService.
+	InjectCABundleAnnotation = "service.beta.openshift.io/inject-cabundle"
+	// ServiceCABundleKey is the ConfigMap key the service-ca operator injects the service CA bundle into.
+	ServiceCABundleKey = "service-ca.crt"
+
+	// defaultServiceCAPollInterval is the interval at which the wait helpers check for the service-ca operator's output.
+	defaultServiceCAPollInterval = time.Second
+)
+
+// EnsureServingCertAnnotation makes sure the Service is annotated for the service-ca operator to generate
+// a serving certificate into the named Secret. It reports whether the Service had to be patched.
+func EnsureServingCertAnnotation(ctx context.Context, c client.Client, service types.NamespacedName, secretName string) (bool, error) {
+	return ensureAnnotation(ctx, c, &corev1.Service{}, service, ServingCertSecretAnnotation, secretName)
+}
+
+// EnsureInjectCABundleAnnotation makes sure the object is annotated for the service-ca operator to inject
+// the service CA bundle into it. obj is only used for its type, e.g. &corev1.ConfigMap{}, and is populated
+// with the current state of the object. It reports whether the object had to be patched.
+func EnsureInjectCABundleAnnotation(ctx context.Context, c client.Client, obj client.Object, key types.NamespacedName) (bool, error) {
+	return ensureAnnotation(ctx, c, obj, key, InjectCABundleAnnotation, "true")
+}
+
+// WaitForServingCertSecret waits until the service-ca operator has populated the Secret with a certificate
+// and a private key, and returns it.
+func WaitForServingCertSecret(ctx context.Context, c client.Reader, key types.NamespacedName, timeout time.Duration) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+
+	err := wait.PollUntilContextTimeout(ctx, defaultServiceCAPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		if err := c.Get(ctx, key, secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+
+			return false, fmt.Errorf("failed to get Secret %s: %w", key.String(), err)
+		}
+
+		return len(secret.Data[corev1.TLSCertKey]) > 0 && len(secret.Data[corev1.TLSPrivateKeyKey]) > 0, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed waiting for serving certificate Secret %s: %w", key.String(), err)
+	}
+
+	return secret, nil
+}
+
+// WaitForInjectedCABundle waits until the service-ca operator has injected the CA bundle into the object
+// and returns the bundle. obj is only used for its type and must be a ConfigMap, a CustomResourceDefinition
+// with a conversion webhook, or a validating or mutating webhook configuration. For webhook configurations,
+// it waits until every webhook carries the bundle.
+func WaitForInjectedCABundle(ctx context.Context, c client.Reader, obj client.Object, key types.NamespacedName, timeout time.Duration) ([]byte, error) {
+	if _, err := injectedCABundle(obj); err != nil {
+		return nil, err
+	}
+
+	var caBundle []byte
+
+	err := wait.PollUntilContextTimeout(ctx, defaultServiceCAPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		if err := c.Get(ctx, key, obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+
+			return false, fmt.Errorf("failed to get %T %s: %w", obj, key.String(), err)
+		}
+
+		var err error
+		if caBundle, err = injectedCABundle(obj); err != nil {
+			return false, err
+		}
+
+		return len(caBundle) > 0, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed waiting for CA bundle injection into %T %s: %w", obj, key.String(), err)
+	}
+
+	return caBundle, nil
+}
+
+// injectedCABundle returns the CA bundle injected into the object, or nil if it has not been injected everywhere yet.
+func injectedCABundle(obj client.Object) ([]byte, error) {
+	switch o := obj.(type) {
+	case *corev1.ConfigMap:
+		return []byte(o.Data[ServiceCABundleKey]), nil
+	case *apiextensionsv1.CustomResourceDefinition:
+		conversion := o.Spec.Conversion
+		if conversion == nil || conversion.Webhook == nil || conversion.Webhook.ClientConfig == nil {
+			return nil, nil
+		}
+
+		return conversion.Webhook.ClientConfig.CABundle, nil
+	case *admissionregistrationv1.ValidatingWebhookConfiguration:
+		clientConfigs := make([]admissionregistrationv1.WebhookClientConfig, 0, len(o.Webhooks))
+		for _, webhook := range o.Webhooks {
+			clientConfigs = append(clientConfigs, webhook.ClientConfig)
+		}
+
+		return commonCABundle(clientConfigs), nil
+	case *admissionregistrationv1.MutatingWebhookConfiguration:
+		clientConfigs := make([]admissionregistrationv1.WebhookClientConfig, 0, len(o.Webhooks))
+		for _, webhook := range o.Webhooks {
+			clientConfigs = append(clientConfigs, webhook.ClientConfig)
+		}
+
+		return commonCABundle(clientConfigs), nil
+	default:
+		return nil, fmt.Errorf("unsupported object type %T for CA bundle injection", obj)
+	}
+}
+
+// commonCABundle returns the CA bundle of the first webhook, or nil if any webhook does not carry one yet.
+func commonCABundle(clientConfigs []admissionregistrationv1.WebhookClientConfig) []byte {
+	for _, clientConfig := range clientConfigs {
+		if len(clientConfig.CABundle) == 0 {
+			return nil
+		}
+	}
+
+	if len(clientConfigs) == 0 {
+		return nil
+	}
+
+	return clientConfigs[0].CABundle
+}
+
+func ensureAnnotation(ctx context.Context, c client.Client, obj client.Object, key types.NamespacedName, annotation, value string) (bool, error) {
+	if err := c.Get(ctx, key, obj); err != nil {
+		return false, fmt.Errorf("failed to get %T %s: %w", obj, key.String(), err)
+	}
+
+	if obj.GetAnnotations()[annotation] == value {
+		return false, nil
+	}
+
+	base, ok := obj.DeepCopyObject().(client.Object)
+	if !ok {
+		return false, fmt.Errorf("failed to copy %T %s", obj, key.String())
+	}
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	annotations[annotation] = value
+	obj.SetAnnotations(annotations)
+
+	if err := c.Patch(ctx, obj, client.MergeFrom(base)); err != nil {
+		return false, fmt.Errorf("failed to annotate %T %s with %s: %w", obj, key.String(), annotation, err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Service CA helpers", func() {
+	var fakeClient client.Client
+
+	serviceKey := types.NamespacedName{Namespace: "operator", Name: "metrics"}
+	configMapKey := types.NamespacedName{Namespace: "operator", Name: "service-ca"}
+
+	BeforeEach(func() {
+		fakeClient = fake.NewClientBuilder().WithObjects(
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: serviceKey.Namespace, Name: serviceKey.Name}},
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: configMapKey.Namespace, Name: configMapKey.Name}},
+			&admissionregistrationv1.ValidatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "operator"},
+				Webhooks: []admissionregistrationv1.ValidatingWebhook{
+					{Name: "a.example.com", ClientConfig: admissionregistrationv1.WebhookClientConfig{CABundle: []byte("bundle")}},
+					{Name: "b.example.com"},
+				},
+			},
+		).Build()
+	})
+
+	It("should annotate a Service once", func() {
+		changed, err := EnsureServingCertAnnotation(ctx, fakeClient, serviceKey, "metrics-tls")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+
+		service := &corev1.Service{}
+		Expect(fakeClient.Get(ctx, serviceKey, service)).To(Succeed())
+		Expect(service.Annotations).To(HaveKeyWithValue(ServingCertSecretAnnotation, "metrics-tls"))
+
+		changed, err = EnsureServingCertAnnotation(ctx, fakeClient, serviceKey, "metrics-tls")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+	})
+
+	It("should wait for the serving certificate Secret to be populated", func() {
+		secretKey := types.NamespacedName{Namespace: "operator", Name: "metrics-tls"}
+
+		_, err := WaitForServingCertSecret(ctx, fakeClient, secretKey, 100*time.Millisecond)
+		Expect(err).To(HaveOccurred())
+
+		certPEM, keyPEM := generateTestKeyPair("metrics.operator.svc")
+		Expect(fakeClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: secretKey.Namespace, Name: secretKey.Name},
+			Data:       map[string][]byte{corev1.TLSCertKey: certPEM, corev1.TLSPrivateKeyKey: keyPEM},
+		})).To(Succeed())
+
+		secret, err := WaitForServingCertSecret(ctx, fakeClient, secretKey, time.Second)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secret.Data[corev1.TLSCertKey]).To(Equal(certPEM))
+	})
+
+	It("should annotate a ConfigMap and wait for the injected bundle", func() {
+		configMap := &corev1.ConfigMap{}
+		changed, err := EnsureInjectCABundleAnnotation(ctx, fakeClient, configMap, configMapKey)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(configMap.Annotations).To(HaveKeyWithValue(InjectCABundleAnnotation, "true"))
+
+		configMap.Data = map[string]string{ServiceCABundleKey: "bundle"}
+		Expect(fakeClient.Update(ctx, configMap)).To(Succeed())
+
+		Expect(WaitForInjectedCABundle(ctx, fakeClient, &corev1.ConfigMap{}, configMapKey, time.Second)).To(Equal([]byte("bundle")))
+	})
+
+	It("should wait until every webhook carries the bundle", func() {
+		key := types.NamespacedName{Name: "operator"}
+
+		_, err := WaitForInjectedCABundle(ctx, fakeClient, &admissionregistrationv1.ValidatingWebhookConfiguration{}, key, 100*time.Millisecond)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject unsupported object types", func() {
+		_, err := WaitForInjectedCABundle(ctx, fakeClient, &corev1.Secret{}, serviceKey, time.Second)
+		Expect(err).To(MatchError(ContainSubstring("unsupported object type")))
+	})
+})
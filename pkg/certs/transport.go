@@ -0,0 +1,214 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultDrainTimeout is the time connections of a replaced transport are allowed to finish before being closed.
+const DefaultDrainTimeout = time.Minute
+
+// RotatingTransport is an http.RoundTripper that rebuilds its underlying transport when the trusted CAs
+// or the client certificate change, e.g. when driven by a CABundleWatcher and a FileCertWatcher.
+//
+// Idle connections of a replaced transport are closed immediately. Connections still in use, such as
+// long-lived watches, are given DrainTimeout to finish and are then closed, so that clients reconnect
+// with the new CAs instead of trusting stale ones for the lifetime of the connection.
+//
+// Changes are detected by pointer identity, which matches the watchers of this package:
+// they only return a new pool or certificate when its contents have changed.
+type RotatingTransport struct {
+	// RootCAs returns the CAs trusted for server certificates. When nil, the system roots are used.
+	RootCAs func() *x509.CertPool
+
+	// ClientCertificate returns the client certificate to present. When nil, no client certificate is presented.
+	ClientCertificate func() *tls.Certificate
+
+	// TLSConfig is the base TLS configuration, e.g. from the cluster TLS profile. It is cloned for each transport.
+	TLSConfig *tls.Config
+
+	// NewTransport builds the transport for a TLS configuration and dialer.
+	// Defaults to a clone of http.DefaultTransport using them.
+	NewTransport func(tlsConfig *tls.Config, dial func(ctx context.Context, network, address string) (net.Conn, error)) http.RoundTripper
+
+	// DrainTimeout is the time connections of a replaced transport are allowed to finish. Defaults to DefaultDrainTimeout.
+	DrainTimeout time.Duration
+
+	mu      sync.Mutex
+	current *rotatingTransportState
+}
+
+type rotatingTransportState struct {
+	rootCAs   *x509.CertPool
+	clientCrt *tls.Certificate
+	transport http.RoundTripper
+	conns     *connTracker
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *RotatingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.transport().RoundTrip(req)
+}
+
+// CloseIdleConnections closes the idle connections of the current transport.
+func (t *RotatingTransport) CloseIdleConnections() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.current != nil {
+		closeIdleConnections(t.current.transport)
+	}
+}
+
+// transport returns the transport for the current CAs and client certificate, rebuilding it if they have changed.
+func (t *RotatingTransport) transport() http.RoundTripper {
+	var (
+		rootCAs   *x509.CertPool
+		clientCrt *tls.Certificate
+	)
+
+	if t.RootCAs != nil {
+		rootCAs = t.RootCAs()
+	}
+
+	if t.ClientCertificate != nil {
+		clientCrt = t.ClientCertificate()
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.current != nil && t.current.rootCAs == rootCAs && t.current.clientCrt == clientCrt {
+		return t.current.transport
+	}
+
+	previous := t.current
+	t.current = t.newState(rootCAs, clientCrt)
+
+	if previous != nil {
+		t.drain(previous)
+	}
+
+	return t.current.transport
+}
+
+func (t *RotatingTransport) newState(rootCAs *x509.CertPool, clientCrt *tls.Certificate) *rotatingTransportState {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if t.TLSConfig != nil {
+		tlsConfig = t.TLSConfig.Clone()
+	}
+
+	tlsConfig.RootCAs = rootCAs
+	if clientCrt != nil {
+		tlsConfig.Certificates = []tls.Certificate{*clientCrt}
+	}
+
+	conns := &connTracker{conns: map[net.Conn]struct{}{}}
+	dial := conns.wrap((&net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}).DialContext)
+
+	var transport http.RoundTripper
+
+	if t.NewTransport != nil {
+		transport = t.NewTransport(tlsConfig, dial)
+	} else {
+		httpTransport := http.DefaultTransport.(*http.Transport).Clone()
+		httpTransport.TLSClientConfig = tlsConfig
+		httpTransport.DialContext = dial
+		transport = httpTransport
+	}
+
+	return &rotatingTransportState{rootCAs: rootCAs, clientCrt: clientCrt, transport: transport, conns: conns}
+}
+
+// drain closes the idle connections of a replaced transport and closes the remaining ones after the drain timeout.
+func (t *RotatingTransport) drain(state *rotatingTransportState) {
+	closeIdleConnections(state.transport)
+
+	timeout := t.DrainTimeout
+	if timeout <= 0 {
+		timeout = DefaultDrainTimeout
+	}
+
+	time.AfterFunc(timeout, state.conns.closeAll)
+}
+
+func closeIdleConnections(transport http.RoundTripper) {
+	if closer, ok := transport.(interface{ CloseIdleConnections() }); ok {
+		closer.CloseIdleConnections()
+	}
+}
+
+// connTracker keeps track of the connections opened by a dialer, so that they can be closed at once.
+type connTracker struct {
+	mu    sync.Mutex
+	conns map[net.Conn]struct{}
+}
+
+func (c *connTracker) wrap(dial func(ctx context.Context, network, address string) (net.Conn, error)) func(ctx context.Context, network, address string) (net.Conn, error) {
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		conn, err := dial(ctx, network, address)
+		if err != nil {
+			return nil, err
+		}
+
+		tracked := &trackedConn{Conn: conn, tracker: c}
+
+		c.mu.Lock()
+		c.conns[tracked] = struct{}{}
+		c.mu.Unlock()
+
+		return tracked, nil
+	}
+}
+
+func (c *connTracker) closeAll() {
+	c.mu.Lock()
+	conns := make([]net.Conn, 0, len(c.conns))
+	for conn := range c.conns {
+		conns = append(conns, conn)
+	}
+	c.mu.Unlock()
+
+	for _, conn := range conns {
+		_ = conn.Close()
+	}
+}
+
+// trackedConn removes itself from its tracker when closed.
+type trackedConn struct {
+	net.Conn
+
+	tracker *connTracker
+	once    sync.Once
+}
+
+func (c *trackedConn) Close() error {
+	c.once.Do(func() {
+		c.tracker.mu.Lock()
+		delete(c.tracker.conns, c)
+		c.tracker.mu.Unlock()
+	})
+
+	return c.Conn.Close()
+}
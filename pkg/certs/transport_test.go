@@ -0,0 +1,93 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RotatingTransport", func() {
+	var (
+		server    *httptest.Server
+		rootCAs   atomic.Pointer[x509.CertPool]
+		transport *RotatingTransport
+	)
+
+	get := func() error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		resp, err := (&http.Client{Transport: transport}).Do(req)
+		if err != nil {
+			return err
+		}
+
+		return resp.Body.Close()
+	}
+
+	BeforeEach(func() {
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		DeferCleanup(server.Close)
+
+		rootCAs.Store(server.Client().Transport.(*http.Transport).TLSClientConfig.RootCAs)
+
+		transport = &RotatingTransport{
+			RootCAs:      rootCAs.Load,
+			DrainTimeout: 10 * time.Millisecond,
+		}
+	})
+
+	It("should reuse the transport while the CAs do not change", func() {
+		Expect(get()).To(Succeed())
+		first := transport.current
+
+		Expect(get()).To(Succeed())
+		Expect(transport.current).To(BeIdenticalTo(first))
+	})
+
+	It("should use the new CAs once they have rotated", func() {
+		Expect(get()).To(Succeed())
+
+		rootCAs.Store(x509.NewCertPool())
+		Expect(get()).To(MatchError(ContainSubstring("certificate")))
+	})
+
+	It("should close the connections of the replaced transport after the drain timeout", func() {
+		Expect(get()).To(Succeed())
+		previous := transport.current
+
+		rootCAs.Store(rootCAs.Load().Clone())
+		Expect(get()).To(Succeed())
+		Expect(transport.current).NotTo(BeIdenticalTo(previous))
+
+		Eventually(func() int {
+			previous.conns.mu.Lock()
+			defer previous.conns.mu.Unlock()
+
+			return len(previous.conns.conns)
+		}).Should(BeZero())
+	})
+})
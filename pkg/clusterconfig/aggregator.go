@@ -0,0 +1,355 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/go-logr/logr"
+	configv1 "github.com/openshift/api/config/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var (
+	// ErrUnsupportedClusterConfigKind is returned when a ClusterConfigAggregator is asked to watch a kind
+	// that is not a supported config.openshift.io singleton.
+	ErrUnsupportedClusterConfigKind = errors.New("unsupported cluster config kind")
+	// ErrNoClusterConfigKinds is returned when a ClusterConfigAggregator is set up without any kind to watch.
+	ErrNoClusterConfigKinds = errors.New("no cluster config kinds to watch")
+)
+
+// ClusterConfigSnapshot is a consistent view of the cluster configuration singletons watched by
+// a ClusterConfigAggregator. Kinds that are not watched, or whose object does not exist, are nil.
+// The objects are shared and must not be modified.
+type ClusterConfigSnapshot struct {
+	APIServer      *configv1.APIServer
+	Authentication *configv1.Authentication
+	ClusterVersion *configv1.ClusterVersion
+	Console        *configv1.Console
+	DNS            *configv1.DNS
+	FeatureGate    *configv1.FeatureGate
+	Image          *configv1.Image
+	Infrastructure *configv1.Infrastructure
+	Ingress        *configv1.Ingress
+	Network        *configv1.Network
+	Node           *configv1.Node
+	OAuth          *configv1.OAuth
+	Project        *configv1.Project
+	Proxy          *configv1.Proxy
+	Scheduler      *configv1.Scheduler
+}
+
+// clusterConfigKind describes how a singleton kind is read and stored in a snapshot.
+type clusterConfigKind struct {
+	name       string
+	objectName string
+	newObject  func() client.Object
+	get        func(*ClusterConfigSnapshot) client.Object
+	set        func(*ClusterConfigSnapshot, client.Object)
+}
+
+func newClusterConfigKind[T any, PT interface {
+	*T
+	client.Object
+}](objectName string, field func(*ClusterConfigSnapshot) *PT) clusterConfigKind {
+	return clusterConfigKind{
+		name:       reflect.TypeFor[T]().Name(),
+		objectName: objectName,
+		newObject:  func() client.Object { return PT(new(T)) },
+		get: func(snapshot *ClusterConfigSnapshot) client.Object {
+			// Avoid returning a typed nil.
+			if obj := *field(snapshot); obj != nil {
+				return obj
+			}
+
+			return nil
+		},
+		set: func(snapshot *ClusterConfigSnapshot, obj client.Object) {
+			*field(snapshot), _ = obj.(PT)
+		},
+	}
+}
+
+var clusterConfigKinds = map[reflect.Type]clusterConfigKind{
+	reflect.TypeFor[*configv1.APIServer](): newClusterConfigKind(ClusterName,
+		func(s *ClusterConfigSnapshot) **configv1.APIServer { return &s.APIServer }),
+	reflect.TypeFor[*configv1.Authentication](): newClusterConfigKind(ClusterName,
+		func(s *ClusterConfigSnapshot) **configv1.Authentication { return &s.Authentication }),
+	reflect.TypeFor[*configv1.ClusterVersion](): newClusterConfigKind(ClusterVersionName,
+		func(s *ClusterConfigSnapshot) **configv1.ClusterVersion { return &s.ClusterVersion }),
+	reflect.TypeFor[*configv1.Console](): newClusterConfigKind(ClusterName,
+		func(s *ClusterConfigSnapshot) **configv1.Console { return &s.Console }),
+	reflect.TypeFor[*configv1.DNS](): newClusterConfigKind(ClusterName,
+		func(s *ClusterConfigSnapshot) **configv1.DNS { return &s.DNS }),
+	reflect.TypeFor[*configv1.FeatureGate](): newClusterConfigKind(ClusterName,
+		func(s *ClusterConfigSnapshot) **configv1.FeatureGate { return &s.FeatureGate }),
+	reflect.TypeFor[*configv1.Image](): newClusterConfigKind(ClusterName,
+		func(s *ClusterConfigSnapshot) **configv1.Image { return &s.Image }),
+	reflect.TypeFor[*configv1.Infrastructure](): newClusterConfigKind(ClusterName,
+		func(s *ClusterConfigSnapshot) **configv1.Infrastructure { return &s.Infrastructure }),
+	reflect.TypeFor[*configv1.Ingress](): newClusterConfigKind(ClusterName,
+		func(s *ClusterConfigSnapshot) **configv1.Ingress { return &s.Ingress }),
+	reflect.TypeFor[*configv1.Network](): newClusterConfigKind(ClusterName,
+		func(s *ClusterConfigSnapshot) **configv1.Network { return &s.Network }),
+	reflect.TypeFor[*configv1.Node](): newClusterConfigKind(ClusterName,
+		func(s *ClusterConfigSnapshot) **configv1.Node { return &s.Node }),
+	reflect.TypeFor[*configv1.OAuth](): newClusterConfigKind(ClusterName,
+		func(s *ClusterConfigSnapshot) **configv1.OAuth { return &s.OAuth }),
+	reflect.TypeFor[*configv1.Project](): newClusterConfigKind(ClusterName,
+		func(s *ClusterConfigSnapshot) **configv1.Project { return &s.Project }),
+	reflect.TypeFor[*configv1.Proxy](): newClusterConfigKind(ClusterName,
+		func(s *ClusterConfigSnapshot) **configv1.Proxy { return &s.Proxy }),
+	reflect.TypeFor[*configv1.Scheduler](): newClusterConfigKind(ClusterName,
+		func(s *ClusterConfigSnapshot) **configv1.Scheduler { return &s.Scheduler }),
+}
+
+// ClusterConfigAggregator watches a set of config.openshift.io singletons with a single controller, for operators
+// that would otherwise run one watcher per kind. It dispatches typed callbacks per kind, registered with
+// AddClusterConfigHandler, and exposes a consistent snapshot of all watched objects.
+//
+// A kind is watched when it is listed in Kinds or has a handler. Handlers must be added before SetupWithManager.
+// Call Load before starting the manager so that the snapshot is complete immediately
+// and handlers are only invoked for actual changes.
+//
+// Example:
+//
+//	aggregator := &clusterconfig.ClusterConfigAggregator{Client: mgr.GetClient()}
+//	if err := clusterconfig.AddClusterConfigHandler(aggregator, func(ctx context.Context, _, infra *configv1.Infrastructure) {
+//	    ...
+//	}); err != nil {
+//	    return err
+//	}
+type ClusterConfigAggregator struct {
+	client.Client
+
+	// Kinds are additional kinds to watch without a handler, e.g. &configv1.Proxy{}.
+	Kinds []client.Object
+
+	// OnChange is a function that will be called after any watched object has changed, after the typed handlers.
+	OnChange func(ctx context.Context, snapshot ClusterConfigSnapshot)
+
+	handlers map[string][]func(ctx context.Context, oldObj, newObj client.Object)
+
+	mu       sync.RWMutex
+	snapshot ClusterConfigSnapshot
+}
+
+// AddClusterConfigHandler registers a handler invoked with the old and new object when the singleton of type T changes,
+// and adds T to the watched kinds. The old object is nil the first time the object is observed.
+func AddClusterConfigHandler[T client.Object](a *ClusterConfigAggregator, fn func(ctx context.Context, oldObj, newObj T)) error {
+	kind, ok := clusterConfigKinds[reflect.TypeFor[T]()]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnsupportedClusterConfigKind, reflect.TypeFor[T]().String())
+	}
+
+	if a.handlers == nil {
+		a.handlers = map[string][]func(ctx context.Context, oldObj, newObj client.Object){}
+	}
+
+	a.handlers[kind.name] = append(a.handlers[kind.name], func(ctx context.Context, oldObj, newObj client.Object) {
+		var typedOld, typedNew T
+		if oldObj != nil {
+			typedOld, _ = oldObj.(T)
+		}
+
+		if newObj != nil {
+			typedNew, _ = newObj.(T)
+		}
+
+		fn(ctx, typedOld, typedNew)
+	})
+
+	return nil
+}
+
+// Snapshot returns the last observed state of the watched objects.
+func (a *ClusterConfigAggregator) Snapshot() ClusterConfigSnapshot {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return a.snapshot
+}
+
+// Load reads the current state of all watched objects using the given reader. Objects that do not exist are left nil.
+// It is intended to be called with the manager's APIReader before the manager is started.
+func (a *ClusterConfigAggregator) Load(ctx context.Context, reader client.Reader) error {
+	kinds, err := a.watchedKinds()
+	if err != nil {
+		return err
+	}
+
+	snapshot := ClusterConfigSnapshot{}
+
+	for _, kind := range kinds {
+		obj := kind.newObject()
+		if err := reader.Get(ctx, client.ObjectKey{Name: kind.objectName}, obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+
+			return fmt.Errorf("failed to get %s %q: %w", kind.name, kind.objectName, err)
+		}
+
+		kind.set(&snapshot, obj)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.snapshot = snapshot
+
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (a *ClusterConfigAggregator) SetupWithManager(mgr ctrl.Manager) error {
+	kinds, err := a.watchedKinds()
+	if err != nil {
+		return err
+	}
+
+	if len(kinds) == 0 {
+		return ErrNoClusterConfigKinds
+	}
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named("clusterconfigaggregator").
+		WithOptions(controller.Options{NeedLeaderElection: ptr.To(false)}).
+		// Override the default log constructor as it makes the logs very chatty.
+		WithLogConstructor(func(_ *reconcile.Request) logr.Logger {
+			return mgr.GetLogger().WithValues(
+				"controller", "clusterconfigaggregator",
+			)
+		})
+
+	for _, kind := range kinds {
+		// Requests are named after the kind, as the objects of all kinds share the same name.
+		enqueueKind := handler.EnqueueRequestsFromMapFunc(func(context.Context, client.Object) []reconcile.Request {
+			return []reconcile.Request{{NamespacedName: client.ObjectKey{Name: kind.name}}}
+		})
+
+		isSingleton := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+			return obj.GetName() == kind.objectName
+		})
+
+		b = b.Watches(kind.newObject(), enqueueKind, builder.WithPredicates(isSingleton))
+	}
+
+	if err := b.Complete(a); err != nil {
+		return fmt.Errorf("could not set up controller for cluster config aggregator: %w", err)
+	}
+
+	return nil
+}
+
+// Reconcile records the current state of the kind named by the request and invokes its handlers when it has changed.
+func (a *ClusterConfigAggregator) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx, "kind", req.Name)
+
+	logger.V(1).Info("Reconciling cluster config")
+	defer logger.V(1).Info("Finished reconciling cluster config")
+
+	var kind clusterConfigKind
+
+	for _, candidate := range clusterConfigKinds {
+		if candidate.name == req.Name {
+			kind = candidate
+			break
+		}
+	}
+
+	if kind.newObject == nil {
+		return ctrl.Result{}, fmt.Errorf("%w: %s", ErrUnsupportedClusterConfigKind, req.Name)
+	}
+
+	newObj := kind.newObject()
+	if err := a.Get(ctx, client.ObjectKey{Name: kind.objectName}, newObj); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Keep the last observed object, the singletons are not expected to be deleted.
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get %s %q: %w", kind.name, kind.objectName, err)
+	}
+
+	a.mu.Lock()
+	oldObj := kind.get(&a.snapshot)
+
+	if oldObj != nil && oldObj.GetResourceVersion() == newObj.GetResourceVersion() {
+		a.mu.Unlock()
+		return ctrl.Result{}, nil
+	}
+
+	// Replace the snapshot rather than modifying it, so that snapshots handed out stay consistent.
+	snapshot := a.snapshot
+	kind.set(&snapshot, newObj)
+	a.snapshot = snapshot
+	a.mu.Unlock()
+
+	logger.V(1).Info("Cluster config changed", "resourceVersion", newObj.GetResourceVersion())
+
+	for _, fn := range a.handlers[kind.name] {
+		fn(ctx, oldObj, newObj)
+	}
+
+	if a.OnChange != nil {
+		a.OnChange(ctx, snapshot)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// watchedKinds returns the kinds listed in Kinds and those with handlers.
+func (a *ClusterConfigAggregator) watchedKinds() ([]clusterConfigKind, error) {
+	seen := map[string]bool{}
+
+	var kinds []clusterConfigKind
+
+	for _, obj := range a.Kinds {
+		kind, ok := clusterConfigKinds[reflect.TypeOf(obj)]
+		if !ok {
+			return nil, fmt.Errorf("%w: %T", ErrUnsupportedClusterConfigKind, obj)
+		}
+
+		if !seen[kind.name] {
+			seen[kind.name] = true
+			kinds = append(kinds, kind)
+		}
+	}
+
+	for _, kind := range clusterConfigKinds {
+		if len(a.handlers[kind.name]) > 0 && !seen[kind.name] {
+			seen[kind.name] = true
+			kinds = append(kinds, kind)
+		}
+	}
+
+	return kinds, nil
+}
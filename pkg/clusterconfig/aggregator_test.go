@@ -0,0 +1,113 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("ClusterConfigAggregator", func() {
+	var (
+		fakeClient     client.Client
+		infrastructure *configv1.Infrastructure
+		aggregator     *ClusterConfigAggregator
+		infraChanges   [][2]*configv1.Infrastructure
+		snapshots      []ClusterConfigSnapshot
+	)
+
+	infrastructureReq := ctrl.Request{NamespacedName: client.ObjectKey{Name: "Infrastructure"}}
+
+	BeforeEach(func() {
+		infrastructure = &configv1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: ClusterName},
+			Status:     configv1.InfrastructureStatus{InfrastructureTopology: configv1.HighlyAvailableTopologyMode},
+		}
+		fakeClient = newFakeClient(
+			infrastructure,
+			&configv1.ClusterVersion{ObjectMeta: metav1.ObjectMeta{Name: ClusterVersionName}},
+		)
+
+		infraChanges, snapshots = nil, nil
+		aggregator = &ClusterConfigAggregator{
+			Client: fakeClient,
+			Kinds:  []client.Object{&configv1.ClusterVersion{}, &configv1.Proxy{}},
+			OnChange: func(_ context.Context, snapshot ClusterConfigSnapshot) {
+				snapshots = append(snapshots, snapshot)
+			},
+		}
+		Expect(AddClusterConfigHandler(aggregator, func(_ context.Context, oldObj, newObj *configv1.Infrastructure) {
+			infraChanges = append(infraChanges, [2]*configv1.Infrastructure{oldObj, newObj})
+		})).To(Succeed())
+	})
+
+	It("should reject kinds that are not cluster config singletons", func() {
+		Expect(AddClusterConfigHandler(aggregator, func(context.Context, *corev1.ConfigMap, *corev1.ConfigMap) {})).
+			To(MatchError(ErrUnsupportedClusterConfigKind))
+
+		aggregator.Kinds = append(aggregator.Kinds, &corev1.Secret{})
+		Expect(aggregator.Load(ctx, fakeClient)).To(MatchError(ErrUnsupportedClusterConfigKind))
+	})
+
+	It("should load a snapshot of the watched kinds", func() {
+		Expect(aggregator.Load(ctx, fakeClient)).To(Succeed())
+
+		snapshot := aggregator.Snapshot()
+		Expect(snapshot.Infrastructure).NotTo(BeNil())
+		Expect(snapshot.ClusterVersion).NotTo(BeNil())
+		Expect(snapshot.Proxy).To(BeNil(), "missing objects are left nil")
+		Expect(snapshot.Network).To(BeNil(), "kinds that are not watched are left nil")
+	})
+
+	It("should dispatch typed handlers only when the object changes", func() {
+		Expect(aggregator.Load(ctx, fakeClient)).To(Succeed())
+		before := aggregator.Snapshot()
+
+		_, err := aggregator.Reconcile(ctx, infrastructureReq)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(infraChanges).To(BeEmpty())
+
+		infrastructure.Status.InfrastructureTopology = configv1.SingleReplicaTopologyMode
+		Expect(fakeClient.Status().Update(ctx, infrastructure)).To(Succeed())
+
+		_, err = aggregator.Reconcile(ctx, infrastructureReq)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(infraChanges).To(HaveLen(1))
+		Expect(infraChanges[0][0].Status.InfrastructureTopology).To(Equal(configv1.HighlyAvailableTopologyMode))
+		Expect(infraChanges[0][1].Status.InfrastructureTopology).To(Equal(configv1.SingleReplicaTopologyMode))
+
+		Expect(snapshots).To(HaveLen(1))
+		Expect(snapshots[0].Infrastructure.Status.InfrastructureTopology).To(Equal(configv1.SingleReplicaTopologyMode))
+		Expect(snapshots[0].ClusterVersion).To(BeIdenticalTo(before.ClusterVersion))
+		Expect(before.Infrastructure.Status.InfrastructureTopology).To(Equal(configv1.HighlyAvailableTopologyMode),
+			"earlier snapshots should not be modified")
+	})
+
+	It("should pass a nil old object the first time an object is observed", func() {
+		_, err := aggregator.Reconcile(ctx, infrastructureReq)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(infraChanges).To(HaveLen(1))
+		Expect(infraChanges[0][0]).To(BeNil())
+	})
+})
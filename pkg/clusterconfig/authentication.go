@@ -0,0 +1,156 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// AuthenticationConfig is the cluster authentication configuration relevant to operators validating tokens.
+type AuthenticationConfig struct {
+	// Type is the authentication mode of the cluster. An empty type in the spec defaults to IntegratedOAuth.
+	Type configv1.AuthenticationType
+	// OIDCProviders are the external OIDC providers, when Type is OIDC.
+	OIDCProviders []configv1.OIDCProvider
+}
+
+// NewAuthenticationConfig returns the configuration from the spec of an Authentication.
+func NewAuthenticationConfig(authentication *configv1.Authentication) AuthenticationConfig {
+	authType := authentication.Spec.Type
+	if authType == "" {
+		authType = configv1.AuthenticationTypeIntegratedOAuth
+	}
+
+	config := AuthenticationConfig{Type: authType}
+	for i := range authentication.Spec.OIDCProviders {
+		config.OIDCProviders = append(config.OIDCProviders, *authentication.Spec.OIDCProviders[i].DeepCopy())
+	}
+
+	return config
+}
+
+// IsExternalOIDC reports whether the cluster delegates authentication to external OIDC providers.
+func (c AuthenticationConfig) IsExternalOIDC() bool {
+	return c.Type == configv1.AuthenticationTypeOIDC
+}
+
+// IssuerURLs returns the issuer URLs of the OIDC providers.
+func (c AuthenticationConfig) IssuerURLs() []string {
+	urls := make([]string, 0, len(c.OIDCProviders))
+	for _, provider := range c.OIDCProviders {
+		urls = append(urls, provider.Issuer.URL)
+	}
+
+	return urls
+}
+
+// AuthenticationWatcher watches the Authentication object and exposes the authentication mode and OIDC providers,
+// for operators that must reconfigure their token validation when clusters move to external OIDC.
+//
+// Call Load before starting the manager so that the getters are usable immediately
+// and the callback is only invoked for actual changes.
+type AuthenticationWatcher struct {
+	client.Client
+
+	// OnChange is a function that will be called when the authentication mode or the OIDC providers change.
+	OnChange func(ctx context.Context, oldConfig, newConfig AuthenticationConfig)
+
+	mu     sync.RWMutex
+	config AuthenticationConfig
+}
+
+// Load reads the current Authentication using the given reader.
+// It is intended to be called with the manager's APIReader before the manager is started.
+func (r *AuthenticationWatcher) Load(ctx context.Context, reader client.Reader) error {
+	authentication := &configv1.Authentication{}
+	key := client.ObjectKey{Name: ClusterName}
+
+	if err := reader.Get(ctx, key, authentication); err != nil {
+		return fmt.Errorf("failed to get Authentication %q: %w", key.String(), err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.config = NewAuthenticationConfig(authentication)
+
+	return nil
+}
+
+// Config returns the last observed authentication configuration.
+func (r *AuthenticationWatcher) Config() AuthenticationConfig {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.config
+}
+
+// Type returns the authentication mode of the cluster.
+func (r *AuthenticationWatcher) Type() configv1.AuthenticationType {
+	return r.Config().Type
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *AuthenticationWatcher) SetupWithManager(mgr ctrl.Manager) error {
+	return setupSingletonWatcher(mgr, "authenticationwatcher", &configv1.Authentication{}, ClusterName, r)
+}
+
+// Reconcile records the current authentication configuration and invokes the callback when it has changed.
+func (r *AuthenticationWatcher) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx, "name", req.Name)
+
+	logger.V(1).Info("Reconciling Authentication")
+	defer logger.V(1).Info("Finished reconciling Authentication")
+
+	authentication := &configv1.Authentication{}
+	if err := r.Get(ctx, req.NamespacedName, authentication); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Keep the last observed configuration, the Authentication object is not expected to be deleted.
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get Authentication %s: %w", req.NamespacedName.String(), err)
+	}
+
+	newConfig := NewAuthenticationConfig(authentication)
+
+	r.mu.Lock()
+	oldConfig := r.config
+	r.config = newConfig
+	r.mu.Unlock()
+
+	if equality.Semantic.DeepEqual(oldConfig, newConfig) {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("Authentication configuration changed", "type", newConfig.Type, "issuers", newConfig.IssuerURLs())
+
+	if r.OnChange != nil {
+		r.OnChange(ctx, oldConfig, newConfig)
+	}
+
+	return ctrl.Result{}, nil
+}
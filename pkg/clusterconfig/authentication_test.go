@@ -0,0 +1,79 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("AuthenticationWatcher", func() {
+	var (
+		fakeClient     client.Client
+		authentication *configv1.Authentication
+		watcher        *AuthenticationWatcher
+		changes        []AuthenticationConfig
+	)
+
+	req := ctrl.Request{NamespacedName: client.ObjectKey{Name: ClusterName}}
+
+	BeforeEach(func() {
+		authentication = &configv1.Authentication{ObjectMeta: metav1.ObjectMeta{Name: ClusterName}}
+		fakeClient = newFakeClient(authentication)
+
+		changes = nil
+		watcher = &AuthenticationWatcher{
+			Client: fakeClient,
+			OnChange: func(_ context.Context, _, newConfig AuthenticationConfig) {
+				changes = append(changes, newConfig)
+			},
+		}
+	})
+
+	It("should default to the integrated OAuth server", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+		Expect(watcher.Type()).To(Equal(configv1.AuthenticationTypeIntegratedOAuth))
+		Expect(watcher.Config().IsExternalOIDC()).To(BeFalse())
+	})
+
+	It("should invoke the callback when the cluster moves to external OIDC", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+
+		authentication.Spec.Type = configv1.AuthenticationTypeOIDC
+		authentication.Spec.OIDCProviders = []configv1.OIDCProvider{{
+			Name:   "keycloak",
+			Issuer: configv1.TokenIssuer{URL: "https://keycloak.example.com/realms/openshift"},
+		}}
+		Expect(fakeClient.Update(ctx, authentication)).To(Succeed())
+
+		_, err := watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(HaveLen(1))
+		Expect(changes[0].IsExternalOIDC()).To(BeTrue())
+		Expect(changes[0].IssuerURLs()).To(ConsistOf("https://keycloak.example.com/realms/openshift"))
+
+		_, err = watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,194 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	configv1 "github.com/openshift/api/config/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Capabilities is the set of optional capabilities enabled on the cluster, e.g. Build, ImageRegistry, Console or
+// marketplace, from the ClusterVersion status.
+// The zero value reports every capability as enabled, as on clusters without capabilities.
+type Capabilities struct {
+	enabled sets.Set[configv1.ClusterVersionCapability]
+	known   sets.Set[configv1.ClusterVersionCapability]
+}
+
+// NewCapabilities returns the capabilities listed in the ClusterVersion status.
+func NewCapabilities(clusterVersion *configv1.ClusterVersion) Capabilities {
+	return Capabilities{
+		enabled: sets.New(clusterVersion.Status.Capabilities.EnabledCapabilities...),
+		known:   sets.New(clusterVersion.Status.Capabilities.KnownCapabilities...),
+	}
+}
+
+// Enabled reports whether the capability is enabled. Capabilities unknown to the cluster, e.g. added in a later
+// version, cannot be disabled and are reported as enabled.
+func (c Capabilities) Enabled(capability configv1.ClusterVersionCapability) bool {
+	return c.enabled.Has(capability) || !c.known.Has(capability)
+}
+
+// EnabledCapabilities returns the enabled capabilities listed in the ClusterVersion status, sorted by name.
+func (c Capabilities) EnabledCapabilities() []configv1.ClusterVersionCapability {
+	return sortedCapabilities(c.enabled)
+}
+
+// DisabledCapabilities returns the known capabilities that are not enabled, sorted by name.
+func (c Capabilities) DisabledCapabilities() []configv1.ClusterVersionCapability {
+	return sortedCapabilities(c.known.Difference(c.enabled))
+}
+
+// Changed returns the capabilities enabled in only one of both sets, sorted by name.
+func (c Capabilities) Changed(other Capabilities) []configv1.ClusterVersionCapability {
+	var changed []configv1.ClusterVersionCapability
+
+	for _, capability := range sortedCapabilities(c.known.Union(other.known)) {
+		if c.Enabled(capability) != other.Enabled(capability) {
+			changed = append(changed, capability)
+		}
+	}
+
+	return changed
+}
+
+// Equal reports whether both sets list the same enabled and known capabilities.
+func (c Capabilities) Equal(other Capabilities) bool {
+	return c.enabled.Equal(other.enabled) && c.known.Equal(other.known)
+}
+
+func sortedCapabilities(capabilities sets.Set[configv1.ClusterVersionCapability]) []configv1.ClusterVersionCapability {
+	return slices.Sorted(maps.Keys(capabilities))
+}
+
+// CapabilitiesWatcher watches the ClusterVersion object and reports the optional capabilities enabled on the cluster,
+// so that operators can skip reconciling integrations whose APIs do not exist on clusters without those capabilities,
+// e.g. not watching Builds without the Build capability.
+//
+// Capabilities can be enabled after installation, but not disabled; OnChange lets operators start the integrations
+// of newly enabled capabilities, or restart to watch their APIs.
+//
+// Call Load before starting the manager so that Enabled is usable immediately
+// and the callback is only invoked for actual changes.
+//
+// Example:
+//
+//	capabilities := &clusterconfig.CapabilitiesWatcher{Client: mgr.GetClient()}
+//	if err := capabilities.Load(ctx, mgr.GetAPIReader()); err != nil {
+//	    return err
+//	}
+//
+//	if capabilities.Enabled(configv1.ClusterVersionCapabilityConsole) {
+//	    if err := (&ConsolePluginReconciler{Client: mgr.GetClient()}).SetupWithManager(mgr); err != nil {
+//	        return err
+//	    }
+//	}
+type CapabilitiesWatcher struct {
+	client.Client
+
+	// OnChange is a function that will be called when the enabled capabilities change.
+	OnChange func(ctx context.Context, oldCapabilities, newCapabilities Capabilities)
+
+	mu           sync.RWMutex
+	capabilities Capabilities
+}
+
+// Load reads the current capabilities using the given reader.
+// It is intended to be called with the manager's APIReader before the manager is started.
+func (r *CapabilitiesWatcher) Load(ctx context.Context, reader client.Reader) error {
+	clusterVersion := &configv1.ClusterVersion{}
+	key := client.ObjectKey{Name: ClusterVersionName}
+
+	if err := reader.Get(ctx, key, clusterVersion); err != nil {
+		return fmt.Errorf("failed to get ClusterVersion %q: %w", key.String(), err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.capabilities = NewCapabilities(clusterVersion)
+
+	return nil
+}
+
+// Capabilities returns the last observed capabilities.
+func (r *CapabilitiesWatcher) Capabilities() Capabilities {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.capabilities
+}
+
+// Enabled reports whether the capability is enabled.
+func (r *CapabilitiesWatcher) Enabled(capability configv1.ClusterVersionCapability) bool {
+	return r.Capabilities().Enabled(capability)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *CapabilitiesWatcher) SetupWithManager(mgr ctrl.Manager) error {
+	return setupSingletonWatcher(mgr, "capabilitieswatcher", &configv1.ClusterVersion{}, ClusterVersionName, r)
+}
+
+// Reconcile records the current capabilities and invokes the callback when they have changed.
+func (r *CapabilitiesWatcher) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx, "name", req.Name)
+
+	logger.V(1).Info("Reconciling ClusterVersion capabilities")
+	defer logger.V(1).Info("Finished reconciling ClusterVersion capabilities")
+
+	clusterVersion := &configv1.ClusterVersion{}
+	if err := r.Get(ctx, req.NamespacedName, clusterVersion); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Keep the last observed capabilities, the ClusterVersion object is not expected to be deleted.
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get ClusterVersion %s: %w", req.NamespacedName.String(), err)
+	}
+
+	newCapabilities := NewCapabilities(clusterVersion)
+
+	r.mu.Lock()
+	oldCapabilities := r.capabilities
+	r.capabilities = newCapabilities
+	r.mu.Unlock()
+
+	if oldCapabilities.Equal(newCapabilities) {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("Cluster capabilities changed",
+		"enabled", newCapabilities.EnabledCapabilities(),
+		"changed", oldCapabilities.Changed(newCapabilities),
+	)
+
+	if r.OnChange != nil {
+		r.OnChange(ctx, oldCapabilities, newCapabilities)
+	}
+
+	return ctrl.Result{}, nil
+}
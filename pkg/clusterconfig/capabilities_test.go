@@ -0,0 +1,100 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("CapabilitiesWatcher", func() {
+	var (
+		fakeClient     client.Client
+		clusterVersion *configv1.ClusterVersion
+		watcher        *CapabilitiesWatcher
+		changes        [][]configv1.ClusterVersionCapability
+	)
+
+	req := ctrl.Request{NamespacedName: client.ObjectKey{Name: ClusterVersionName}}
+
+	BeforeEach(func() {
+		clusterVersion = &configv1.ClusterVersion{
+			ObjectMeta: metav1.ObjectMeta{Name: ClusterVersionName},
+			Spec:       configv1.ClusterVersionSpec{ClusterID: "00000000-0000-0000-0000-000000000000"},
+			Status: configv1.ClusterVersionStatus{
+				Capabilities: configv1.ClusterVersionCapabilitiesStatus{
+					EnabledCapabilities: []configv1.ClusterVersionCapability{configv1.ClusterVersionCapabilityConsole},
+					KnownCapabilities: []configv1.ClusterVersionCapability{
+						configv1.ClusterVersionCapabilityBuild,
+						configv1.ClusterVersionCapabilityConsole,
+						configv1.ClusterVersionCapabilityImageRegistry,
+					},
+				},
+			},
+		}
+		fakeClient = newFakeClient(clusterVersion)
+
+		changes = nil
+		watcher = &CapabilitiesWatcher{
+			Client: fakeClient,
+			OnChange: func(_ context.Context, oldCapabilities, newCapabilities Capabilities) {
+				changes = append(changes, oldCapabilities.Changed(newCapabilities))
+			},
+		}
+	})
+
+	It("should report the enabled capabilities", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+		Expect(watcher.Enabled(configv1.ClusterVersionCapabilityConsole)).To(BeTrue())
+		Expect(watcher.Enabled(configv1.ClusterVersionCapabilityBuild)).To(BeFalse())
+		Expect(watcher.Enabled(configv1.ClusterVersionCapabilityMarketplace)).To(BeTrue())
+		Expect(watcher.Capabilities().EnabledCapabilities()).To(Equal([]configv1.ClusterVersionCapability{
+			configv1.ClusterVersionCapabilityConsole,
+		}))
+		Expect(watcher.Capabilities().DisabledCapabilities()).To(Equal([]configv1.ClusterVersionCapability{
+			configv1.ClusterVersionCapabilityBuild,
+			configv1.ClusterVersionCapabilityImageRegistry,
+		}))
+	})
+
+	It("should report every capability as enabled on clusters without capabilities", func() {
+		Expect(Capabilities{}.Enabled(configv1.ClusterVersionCapabilityBuild)).To(BeTrue())
+	})
+
+	It("should invoke the callback when capabilities are enabled", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+
+		_, err := watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+
+		clusterVersion.Status.Capabilities.EnabledCapabilities = append(clusterVersion.Status.Capabilities.EnabledCapabilities,
+			configv1.ClusterVersionCapabilityBuild)
+		Expect(fakeClient.Status().Update(ctx, clusterVersion)).To(Succeed())
+
+		_, err = watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(watcher.Enabled(configv1.ClusterVersionCapabilityBuild)).To(BeTrue())
+		Expect(changes).To(Equal([][]configv1.ClusterVersionCapability{{configv1.ClusterVersionCapabilityBuild}}))
+	})
+})
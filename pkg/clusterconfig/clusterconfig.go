@@ -0,0 +1,61 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clusterconfig provides watchers for the cluster-scoped config.openshift.io singletons,
+// such as Infrastructure, Proxy and FeatureGate, exposing their current state through getters
+// and notifying operators of changes through callbacks.
+package clusterconfig
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// ClusterName is the name of the cluster-scoped config.openshift.io singletons.
+	ClusterName = "cluster"
+)
+
+// setupSingletonWatcher sets up a controller watching the instance of obj with the given name.
+// The controller runs on every replica, regardless of leader election, as every replica needs the configuration.
+func setupSingletonWatcher(mgr ctrl.Manager, name string, obj client.Object, objectName string, r reconcile.Reconciler) error {
+	if err := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{NeedLeaderElection: ptr.To(false)}).
+		For(obj, builder.WithPredicates(predicate.NewPredicateFuncs(func(obj client.Object) bool {
+			// Only watch the singleton.
+			return obj.GetName() == objectName
+		}))).
+		// Override the default log constructor as it makes the logs very chatty.
+		WithLogConstructor(func(_ *reconcile.Request) logr.Logger {
+			return mgr.GetLogger().WithValues(
+				"controller", name,
+			)
+		}).
+		Complete(r); err != nil {
+		return fmt.Errorf("could not set up controller for %s: %w", name, err)
+	}
+
+	return nil
+}
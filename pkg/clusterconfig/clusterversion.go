@@ -0,0 +1,173 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	configv1 "github.com/openshift/api/config/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// ClusterVersionName is the name of the ClusterVersion singleton.
+	ClusterVersionName = "version"
+)
+
+// ClusterVersionState summarizes the version and upgrade state of the cluster.
+type ClusterVersionState struct {
+	// CurrentVersion is the version of the last completed update, or empty while the cluster is being installed.
+	CurrentVersion string
+	// DesiredVersion is the version the cluster is updating or updated to.
+	DesiredVersion string
+	// Progressing is true while the cluster version operator is rolling out the desired version.
+	Progressing bool
+}
+
+// UpgradeInProgress reports whether an upgrade, as opposed to the initial installation, is being rolled out.
+func (s ClusterVersionState) UpgradeInProgress() bool {
+	return s.CurrentVersion != "" && (s.Progressing || s.CurrentVersion != s.DesiredVersion)
+}
+
+// NewClusterVersionState summarizes the status of a ClusterVersion.
+func NewClusterVersionState(clusterVersion *configv1.ClusterVersion) ClusterVersionState {
+	state := ClusterVersionState{DesiredVersion: clusterVersion.Status.Desired.Version}
+
+	// History is ordered from the most recent update.
+	for _, update := range clusterVersion.Status.History {
+		if update.State == configv1.CompletedUpdate {
+			state.CurrentVersion = update.Version
+			break
+		}
+	}
+
+	for _, condition := range clusterVersion.Status.Conditions {
+		if condition.Type == configv1.OperatorProgressing {
+			state.Progressing = condition.Status == configv1.ConditionTrue
+		}
+	}
+
+	return state
+}
+
+// ClusterVersionWatcher watches the ClusterVersion object and reports the current and desired versions
+// of the cluster, so that operators can defer disruptive work during upgrades or record the version
+// they reconciled under.
+//
+// Call Load before starting the manager so that the getters are usable immediately
+// and the callback is only invoked for actual changes.
+type ClusterVersionWatcher struct {
+	client.Client
+
+	// OnChange is a function that will be called when the versions or the progressing state change.
+	OnChange func(ctx context.Context, oldState, newState ClusterVersionState)
+
+	mu    sync.RWMutex
+	state ClusterVersionState
+}
+
+// Load reads the current ClusterVersion using the given reader.
+// It is intended to be called with the manager's APIReader before the manager is started.
+func (r *ClusterVersionWatcher) Load(ctx context.Context, reader client.Reader) error {
+	clusterVersion := &configv1.ClusterVersion{}
+	key := client.ObjectKey{Name: ClusterVersionName}
+
+	if err := reader.Get(ctx, key, clusterVersion); err != nil {
+		return fmt.Errorf("failed to get ClusterVersion %q: %w", key.String(), err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.state = NewClusterVersionState(clusterVersion)
+
+	return nil
+}
+
+// State returns the last observed version state.
+func (r *ClusterVersionWatcher) State() ClusterVersionState {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.state
+}
+
+// CurrentVersion returns the version of the last completed update.
+func (r *ClusterVersionWatcher) CurrentVersion() string {
+	return r.State().CurrentVersion
+}
+
+// DesiredVersion returns the version the cluster is updating or updated to.
+func (r *ClusterVersionWatcher) DesiredVersion() string {
+	return r.State().DesiredVersion
+}
+
+// UpgradeInProgress reports whether the cluster is being upgraded.
+func (r *ClusterVersionWatcher) UpgradeInProgress() bool {
+	return r.State().UpgradeInProgress()
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ClusterVersionWatcher) SetupWithManager(mgr ctrl.Manager) error {
+	return setupSingletonWatcher(mgr, "clusterversionwatcher", &configv1.ClusterVersion{}, ClusterVersionName, r)
+}
+
+// Reconcile records the current version state and invokes the callback when it has changed.
+func (r *ClusterVersionWatcher) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx, "name", req.Name)
+
+	logger.V(1).Info("Reconciling ClusterVersion")
+	defer logger.V(1).Info("Finished reconciling ClusterVersion")
+
+	clusterVersion := &configv1.ClusterVersion{}
+	if err := r.Get(ctx, req.NamespacedName, clusterVersion); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Keep the last observed state, the ClusterVersion object is not expected to be deleted.
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get ClusterVersion %s: %w", req.NamespacedName.String(), err)
+	}
+
+	newState := NewClusterVersionState(clusterVersion)
+
+	r.mu.Lock()
+	oldState := r.state
+	r.state = newState
+	r.mu.Unlock()
+
+	if oldState == newState {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("Cluster version changed",
+		"currentVersion", newState.CurrentVersion,
+		"desiredVersion", newState.DesiredVersion,
+		"progressing", newState.Progressing,
+	)
+
+	if r.OnChange != nil {
+		r.OnChange(ctx, oldState, newState)
+	}
+
+	return ctrl.Result{}, nil
+}
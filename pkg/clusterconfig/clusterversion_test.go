@@ -0,0 +1,109 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("ClusterVersionWatcher", func() {
+	var (
+		fakeClient     client.Client
+		clusterVersion *configv1.ClusterVersion
+		watcher        *ClusterVersionWatcher
+		changes        []ClusterVersionState
+	)
+
+	req := ctrl.Request{NamespacedName: client.ObjectKey{Name: ClusterVersionName}}
+
+	setProgressing := func(status configv1.ConditionStatus) {
+		clusterVersion.Status.Conditions = []configv1.ClusterOperatorStatusCondition{
+			{Type: configv1.OperatorProgressing, Status: status},
+		}
+	}
+
+	BeforeEach(func() {
+		clusterVersion = &configv1.ClusterVersion{
+			ObjectMeta: metav1.ObjectMeta{Name: ClusterVersionName},
+			Spec:       configv1.ClusterVersionSpec{ClusterID: "00000000-0000-0000-0000-000000000000"},
+			Status: configv1.ClusterVersionStatus{
+				Desired: configv1.Release{Version: "4.21.0"},
+				History: []configv1.UpdateHistory{{State: configv1.CompletedUpdate, Version: "4.21.0"}},
+			},
+		}
+		setProgressing(configv1.ConditionFalse)
+		fakeClient = newFakeClient(clusterVersion)
+
+		changes = nil
+		watcher = &ClusterVersionWatcher{
+			Client: fakeClient,
+			OnChange: func(_ context.Context, _, newState ClusterVersionState) {
+				changes = append(changes, newState)
+			},
+		}
+	})
+
+	It("should report a settled cluster", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+		Expect(watcher.CurrentVersion()).To(Equal("4.21.0"))
+		Expect(watcher.DesiredVersion()).To(Equal("4.21.0"))
+		Expect(watcher.UpgradeInProgress()).To(BeFalse())
+	})
+
+	It("should report upgrades and invoke the callback on transitions", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+
+		clusterVersion.Status.Desired.Version = "4.22.0"
+		clusterVersion.Status.History = append([]configv1.UpdateHistory{
+			{State: configv1.PartialUpdate, Version: "4.22.0"},
+		}, clusterVersion.Status.History...)
+		setProgressing(configv1.ConditionTrue)
+		Expect(fakeClient.Status().Update(ctx, clusterVersion)).To(Succeed())
+
+		_, err := watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(watcher.UpgradeInProgress()).To(BeTrue())
+		Expect(watcher.CurrentVersion()).To(Equal("4.21.0"))
+
+		clusterVersion.Status.History[0].State = configv1.CompletedUpdate
+		setProgressing(configv1.ConditionFalse)
+		Expect(fakeClient.Status().Update(ctx, clusterVersion)).To(Succeed())
+
+		_, err = watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(watcher.UpgradeInProgress()).To(BeFalse())
+		Expect(changes).To(Equal([]ClusterVersionState{
+			{CurrentVersion: "4.21.0", DesiredVersion: "4.22.0", Progressing: true},
+			{CurrentVersion: "4.22.0", DesiredVersion: "4.22.0"},
+		}))
+	})
+
+	It("should not report the initial installation as an upgrade", func() {
+		state := NewClusterVersionState(&configv1.ClusterVersion{Status: configv1.ClusterVersionStatus{
+			Desired: configv1.Release{Version: "4.21.0"},
+			History: []configv1.UpdateHistory{{State: configv1.PartialUpdate, Version: "4.21.0"}},
+		}})
+		Expect(state.UpgradeInProgress()).To(BeFalse())
+	})
+})
@@ -0,0 +1,226 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/go-logr/logr"
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// CredentialsMode is the way operators obtain cloud credentials on the cluster.
+type CredentialsMode string
+
+const (
+	// CredentialsModeDefault means the cloud-credential-operator picks between minting and passthrough
+	// based on the capabilities of the root credentials. Operators request credentials with CredentialsRequests.
+	CredentialsModeDefault CredentialsMode = "Default"
+	// CredentialsModeMint means the cloud-credential-operator mints dedicated credentials for each CredentialsRequest.
+	CredentialsModeMint CredentialsMode = "Mint"
+	// CredentialsModePassthrough means the cloud-credential-operator copies the root credentials for each CredentialsRequest.
+	CredentialsModePassthrough CredentialsMode = "Passthrough"
+	// CredentialsModeManual means the administrator provides the credentials Secrets.
+	CredentialsModeManual CredentialsMode = "Manual"
+	// CredentialsModeManualTokenBased means the administrator provides credentials that use short-lived tokens issued
+	// for the service accounts of the operands, such as AWS STS, Azure Workload Identity or GCP Workload Identity.
+	// Operators need to supply the role or identity to assume in their CredentialsRequests.
+	CredentialsModeManualTokenBased CredentialsMode = "ManualTokenBased"
+)
+
+// tokenBasedPlatforms are the platforms supporting short-lived token credentials.
+var tokenBasedPlatforms = []configv1.PlatformType{configv1.AWSPlatformType, configv1.AzurePlatformType, configv1.GCPPlatformType}
+
+// NewCredentialsMode returns the credentials mode from the CloudCredential operator config, the Infrastructure
+// and the Authentication config. Manual mode is token based when the platform supports it and
+// a service account issuer is configured. A nil CloudCredential means the CloudCredential capability is disabled,
+// in which case the mode is Manual. Other nil objects are treated as empty.
+func NewCredentialsMode(cloudCredential *operatorv1.CloudCredential, infrastructure *configv1.Infrastructure, authentication *configv1.Authentication) CredentialsMode {
+	mode := operatorv1.CloudCredentialsModeManual
+	if cloudCredential != nil {
+		mode = cloudCredential.Spec.CredentialsMode
+	}
+
+	switch mode {
+	case operatorv1.CloudCredentialsModeMint:
+		return CredentialsModeMint
+	case operatorv1.CloudCredentialsModePassthrough:
+		return CredentialsModePassthrough
+	case operatorv1.CloudCredentialsModeManual:
+		if authentication == nil || authentication.Spec.ServiceAccountIssuer == "" || infrastructure == nil {
+			return CredentialsModeManual
+		}
+
+		for _, platform := range tokenBasedPlatforms {
+			if platformType(infrastructure.Status) == platform {
+				return CredentialsModeManualTokenBased
+			}
+		}
+
+		return CredentialsModeManual
+	default:
+		return CredentialsModeDefault
+	}
+}
+
+// FetchCredentialsMode fetches the objects the credentials mode is derived from and returns the mode.
+// The cloud-credential-operator is an optional capability: when the CloudCredential kind is not served or the
+// object does not exist, the mode is Manual. Other missing objects are treated as empty.
+func FetchCredentialsMode(ctx context.Context, reader client.Reader) (CredentialsMode, error) {
+	cloudCredential := &operatorv1.CloudCredential{}
+	infrastructure := &configv1.Infrastructure{}
+	authentication := &configv1.Authentication{}
+
+	err := reader.Get(ctx, client.ObjectKey{Name: ClusterName}, cloudCredential)
+	if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+		cloudCredential, err = nil, nil
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("failed to get %T %q: %w", cloudCredential, ClusterName, err)
+	}
+
+	for _, obj := range []client.Object{infrastructure, authentication} {
+		if err := reader.Get(ctx, client.ObjectKey{Name: ClusterName}, obj); err != nil && !apierrors.IsNotFound(err) {
+			return "", fmt.Errorf("failed to get %T %q: %w", obj, ClusterName, err)
+		}
+	}
+
+	return NewCredentialsMode(cloudCredential, infrastructure, authentication), nil
+}
+
+// CredentialsModeWatcher watches the CloudCredential operator config, the Infrastructure and the Authentication config,
+// and surfaces the credentials mode of the cluster, so that operators can alter how they request cloud credentials.
+//
+// The manager scheme must include operator.openshift.io/v1.
+// Call Load before starting the manager so that the getter is usable immediately
+// and the callback is only invoked for actual changes.
+type CredentialsModeWatcher struct {
+	client.Client
+
+	// OnChange is a function that will be called when the credentials mode changes.
+	OnChange func(ctx context.Context, oldMode, newMode CredentialsMode)
+
+	mu   sync.RWMutex
+	mode CredentialsMode
+}
+
+// Load reads the current credentials mode using the given reader.
+// It is intended to be called with the manager's APIReader before the manager is started.
+func (r *CredentialsModeWatcher) Load(ctx context.Context, reader client.Reader) error {
+	mode, err := FetchCredentialsMode(ctx, reader)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.mode = mode
+
+	return nil
+}
+
+// Mode returns the last observed credentials mode.
+func (r *CredentialsModeWatcher) Mode() CredentialsMode {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.mode
+}
+
+// SetupWithManager sets up the controller with the Manager.
+// The CloudCredential operator config is only watched when its kind is served, as it is not on clusters without
+// the CloudCredential capability.
+func (r *CredentialsModeWatcher) SetupWithManager(mgr ctrl.Manager) error {
+	isCluster := builder.WithPredicates(predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetName() == ClusterName
+	}))
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named("credentialsmodewatcher").
+		WithOptions(controller.Options{NeedLeaderElection: ptr.To(false)}).
+		Watches(&configv1.Infrastructure{}, &handler.EnqueueRequestForObject{}, isCluster).
+		Watches(&configv1.Authentication{}, &handler.EnqueueRequestForObject{}, isCluster)
+
+	gvk := operatorv1.GroupVersion.WithKind("CloudCredential")
+
+	_, err := mgr.GetRESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	switch {
+	case err == nil:
+		b = b.Watches(&operatorv1.CloudCredential{}, &handler.EnqueueRequestForObject{}, isCluster)
+	case !meta.IsNoMatchError(err):
+		return fmt.Errorf("failed to get the REST mapping of %s: %w", gvk.String(), err)
+	}
+
+	if err := b.
+		// Override the default log constructor as it makes the logs very chatty.
+		WithLogConstructor(func(_ *reconcile.Request) logr.Logger {
+			return mgr.GetLogger().WithValues(
+				"controller", "credentialsmodewatcher",
+			)
+		}).
+		Complete(r); err != nil {
+		return fmt.Errorf("could not set up controller for credentials mode watcher: %w", err)
+	}
+
+	return nil
+}
+
+// Reconcile recomputes the credentials mode and invokes the callback when it has changed.
+func (r *CredentialsModeWatcher) Reconcile(ctx context.Context, _ ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	logger.V(1).Info("Reconciling credentials mode")
+	defer logger.V(1).Info("Finished reconciling credentials mode")
+
+	newMode, err := FetchCredentialsMode(ctx, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	r.mu.Lock()
+	oldMode := r.mode
+	r.mode = newMode
+	r.mu.Unlock()
+
+	if oldMode == newMode {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("Credentials mode changed", "old", oldMode, "new", newMode)
+
+	if r.OnChange != nil {
+		r.OnChange(ctx, oldMode, newMode)
+	}
+
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+var _ = Describe("CredentialsMode", func() {
+	cloudCredential := func(mode operatorv1.CloudCredentialsMode) *operatorv1.CloudCredential {
+		return &operatorv1.CloudCredential{
+			ObjectMeta: metav1.ObjectMeta{Name: ClusterName},
+			Spec:       operatorv1.CloudCredentialSpec{CredentialsMode: mode},
+		}
+	}
+
+	infrastructure := func(platform configv1.PlatformType) *configv1.Infrastructure {
+		return &configv1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: ClusterName},
+			Status:     configv1.InfrastructureStatus{PlatformStatus: &configv1.PlatformStatus{Type: platform}},
+		}
+	}
+
+	authentication := func(issuer string) *configv1.Authentication {
+		return &configv1.Authentication{
+			ObjectMeta: metav1.ObjectMeta{Name: ClusterName},
+			Spec:       configv1.AuthenticationSpec{ServiceAccountIssuer: issuer},
+		}
+	}
+
+	DescribeTable("NewCredentialsMode",
+		func(cc *operatorv1.CloudCredential, infra *configv1.Infrastructure, auth *configv1.Authentication, expected CredentialsMode) {
+			Expect(NewCredentialsMode(cc, infra, auth)).To(Equal(expected))
+		},
+		Entry("without CloudCredential", nil, nil, nil, CredentialsModeManual),
+		Entry("without CloudCredential with STS", nil,
+			infrastructure(configv1.AWSPlatformType), authentication("https://oidc.example.com"), CredentialsModeManualTokenBased),
+		Entry("default", cloudCredential(""), infrastructure(configv1.AWSPlatformType), authentication(""), CredentialsModeDefault),
+		Entry("mint", cloudCredential(operatorv1.CloudCredentialsModeMint), nil, nil, CredentialsModeMint),
+		Entry("passthrough", cloudCredential(operatorv1.CloudCredentialsModePassthrough), nil, nil, CredentialsModePassthrough),
+		Entry("manual", cloudCredential(operatorv1.CloudCredentialsModeManual),
+			infrastructure(configv1.AWSPlatformType), authentication(""), CredentialsModeManual),
+		Entry("manual with STS", cloudCredential(operatorv1.CloudCredentialsModeManual),
+			infrastructure(configv1.AWSPlatformType), authentication("https://oidc.example.com"), CredentialsModeManualTokenBased),
+		Entry("manual with an issuer on a platform without token credentials", cloudCredential(operatorv1.CloudCredentialsModeManual),
+			infrastructure(configv1.VSpherePlatformType), authentication("https://oidc.example.com"), CredentialsModeManual),
+	)
+
+	Describe("FetchCredentialsMode", func() {
+		It("should report Manual when the CloudCredential capability is disabled", func() {
+			mode, err := FetchCredentialsMode(ctx, newFakeClient(infrastructure(configv1.AWSPlatformType)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mode).To(Equal(CredentialsModeManual))
+
+			notServed := interceptor.NewClient(newFakeClient().(client.WithWatch), interceptor.Funcs{
+				Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					if _, ok := obj.(*operatorv1.CloudCredential); ok {
+						return &meta.NoKindMatchError{GroupKind: operatorv1.GroupVersion.WithKind("CloudCredential").GroupKind()}
+					}
+
+					return c.Get(ctx, key, obj, opts...)
+				},
+			})
+
+			mode, err = FetchCredentialsMode(ctx, notServed)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mode).To(Equal(CredentialsModeManual))
+		})
+	})
+
+	Describe("CredentialsModeWatcher", func() {
+		It("should invoke the callback when the mode changes", func() {
+			cc := cloudCredential(operatorv1.CloudCredentialsModeManual)
+			auth := authentication("")
+			fakeClient := newFakeClient(cc, infrastructure(configv1.AzurePlatformType), auth)
+
+			var changes []CredentialsMode
+			watcher := &CredentialsModeWatcher{
+				Client: fakeClient,
+				OnChange: func(_ context.Context, _, newMode CredentialsMode) {
+					changes = append(changes, newMode)
+				},
+			}
+			Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+			Expect(watcher.Mode()).To(Equal(CredentialsModeManual))
+
+			req := ctrl.Request{NamespacedName: client.ObjectKey{Name: ClusterName}}
+			_, err := watcher.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).To(BeEmpty())
+
+			auth.Spec.ServiceAccountIssuer = "https://oidc.example.com"
+			Expect(fakeClient.Update(ctx, auth)).To(Succeed())
+
+			_, err = watcher.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).To(Equal([]CredentialsMode{CredentialsModeManualTokenBased}))
+		})
+	})
+})
@@ -0,0 +1,128 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// DNSWatcher watches the DNS object and surfaces the base domain of the cluster and the platform DNS settings,
+// for operators generating external DNS names or certificates derived from the base domain.
+//
+// Call Load before starting the manager so that the getters are usable immediately
+// and the callback is only invoked for actual changes.
+type DNSWatcher struct {
+	client.Client
+
+	// OnChange is a function that will be called when the DNS configuration changes.
+	OnChange func(ctx context.Context, oldSpec, newSpec configv1.DNSSpec)
+
+	mu   sync.RWMutex
+	spec configv1.DNSSpec
+}
+
+// Load reads the current DNS configuration using the given reader.
+// It is intended to be called with the manager's APIReader before the manager is started.
+func (r *DNSWatcher) Load(ctx context.Context, reader client.Reader) error {
+	dns := &configv1.DNS{}
+	key := client.ObjectKey{Name: ClusterName}
+
+	if err := reader.Get(ctx, key, dns); err != nil {
+		return fmt.Errorf("failed to get DNS %q: %w", key.String(), err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.spec = *dns.Spec.DeepCopy()
+
+	return nil
+}
+
+// Spec returns a copy of the last observed DNS spec, including the public and private zones and platform settings.
+func (r *DNSWatcher) Spec() configv1.DNSSpec {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return *r.spec.DeepCopy()
+}
+
+// BaseDomain returns the base domain of the cluster, e.g. mycluster.example.com.
+func (r *DNSWatcher) BaseDomain() string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.spec.BaseDomain
+}
+
+// Name returns a DNS name under the base domain, joining the given labels, e.g. Name("api") returns api.<baseDomain>.
+func (r *DNSWatcher) Name(labels ...string) string {
+	return strings.Join(append(slices.Clone(labels), r.BaseDomain()), ".")
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DNSWatcher) SetupWithManager(mgr ctrl.Manager) error {
+	return setupSingletonWatcher(mgr, "dnswatcher", &configv1.DNS{}, ClusterName, r)
+}
+
+// Reconcile records the current DNS configuration and invokes the callback when it has changed.
+func (r *DNSWatcher) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx, "name", req.Name)
+
+	logger.V(1).Info("Reconciling DNS")
+	defer logger.V(1).Info("Finished reconciling DNS")
+
+	dns := &configv1.DNS{}
+	if err := r.Get(ctx, req.NamespacedName, dns); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Keep the last observed configuration, the DNS object is not expected to be deleted.
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get DNS %s: %w", req.NamespacedName.String(), err)
+	}
+
+	newSpec := *dns.Spec.DeepCopy()
+
+	r.mu.Lock()
+	oldSpec := r.spec
+	r.spec = newSpec
+	r.mu.Unlock()
+
+	if equality.Semantic.DeepEqual(oldSpec, newSpec) {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("DNS configuration changed", "baseDomain", newSpec.BaseDomain)
+
+	if r.OnChange != nil {
+		r.OnChange(ctx, *oldSpec.DeepCopy(), *newSpec.DeepCopy())
+	}
+
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("DNSWatcher", func() {
+	var (
+		fakeClient client.Client
+		dns        *configv1.DNS
+		watcher    *DNSWatcher
+		changes    []configv1.DNSSpec
+	)
+
+	req := ctrl.Request{NamespacedName: client.ObjectKey{Name: ClusterName}}
+
+	BeforeEach(func() {
+		dns = &configv1.DNS{
+			ObjectMeta: metav1.ObjectMeta{Name: ClusterName},
+			Spec: configv1.DNSSpec{
+				BaseDomain:  "mycluster.example.com",
+				PrivateZone: &configv1.DNSZone{Tags: map[string]string{"Name": "mycluster-int"}},
+				Platform:    configv1.DNSPlatformSpec{Type: configv1.AWSPlatformType},
+			},
+		}
+		fakeClient = newFakeClient(dns)
+
+		changes = nil
+		watcher = &DNSWatcher{
+			Client: fakeClient,
+			OnChange: func(_ context.Context, _, newSpec configv1.DNSSpec) {
+				changes = append(changes, newSpec)
+			},
+		}
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+	})
+
+	It("should expose the base domain and derived names", func() {
+		Expect(watcher.BaseDomain()).To(Equal("mycluster.example.com"))
+		Expect(watcher.Name("api")).To(Equal("api.mycluster.example.com"))
+		Expect(watcher.Name("metrics", "apps")).To(Equal("metrics.apps.mycluster.example.com"))
+		Expect(watcher.Spec().Platform.Type).To(Equal(configv1.AWSPlatformType))
+	})
+
+	It("should only invoke the callback when the configuration changes", func() {
+		_, err := watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+
+		dns.Spec.PrivateZone.Tags["Name"] = "mycluster-private"
+		Expect(fakeClient.Update(ctx, dns)).To(Succeed())
+
+		_, err = watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(HaveLen(1))
+		Expect(changes[0].PrivateZone.Tags).To(HaveKeyWithValue("Name", "mycluster-private"))
+	})
+})
@@ -0,0 +1,205 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	configv1 "github.com/openshift/api/config/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// ErrFeatureGatesNotResolved is returned when the FeatureGate status does not list the gates of the requested version.
+var ErrFeatureGatesNotResolved = errors.New("feature gates are not resolved for the version")
+
+// FeatureGates is the set of enabled and disabled feature gates for a cluster version.
+// The zero value has no known gates.
+type FeatureGates struct {
+	enabled  sets.Set[configv1.FeatureGateName]
+	disabled sets.Set[configv1.FeatureGateName]
+}
+
+// NewFeatureGates returns the feature gates resolved for the given version in the FeatureGate status.
+// When version is empty and the status lists a single version, that version is used.
+func NewFeatureGates(featureGate *configv1.FeatureGate, version string) (FeatureGates, error) {
+	details := featureGate.Status.FeatureGates
+
+	for i := range details {
+		if details[i].Version != version && (version != "" || len(details) != 1) {
+			continue
+		}
+
+		gates := FeatureGates{
+			enabled:  sets.New[configv1.FeatureGateName](),
+			disabled: sets.New[configv1.FeatureGateName](),
+		}
+
+		for _, gate := range details[i].Enabled {
+			gates.enabled.Insert(gate.Name)
+		}
+
+		for _, gate := range details[i].Disabled {
+			gates.disabled.Insert(gate.Name)
+		}
+
+		return gates, nil
+	}
+
+	return FeatureGates{}, fmt.Errorf("%w %q", ErrFeatureGatesNotResolved, version)
+}
+
+// Enabled reports whether the feature gate is enabled. Unknown gates are reported as disabled.
+func (f FeatureGates) Enabled(name configv1.FeatureGateName) bool {
+	return f.enabled.Has(name)
+}
+
+// Known reports whether the feature gate is listed as either enabled or disabled.
+func (f FeatureGates) Known(name configv1.FeatureGateName) bool {
+	return f.enabled.Has(name) || f.disabled.Has(name)
+}
+
+// EnabledGates returns the enabled feature gates, sorted by name.
+func (f FeatureGates) EnabledGates() []configv1.FeatureGateName {
+	return sortedGates(f.enabled)
+}
+
+// DisabledGates returns the disabled feature gates, sorted by name.
+func (f FeatureGates) DisabledGates() []configv1.FeatureGateName {
+	return sortedGates(f.disabled)
+}
+
+// Equal reports whether both sets list the same enabled and disabled gates.
+func (f FeatureGates) Equal(other FeatureGates) bool {
+	return f.enabled.Equal(other.enabled) && f.disabled.Equal(other.disabled)
+}
+
+func sortedGates(gates sets.Set[configv1.FeatureGateName]) []configv1.FeatureGateName {
+	names := gates.UnsortedList()
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+
+	return names
+}
+
+// FeatureGateWatcher watches the FeatureGate object and resolves the feature gates of the cluster
+// for the version of the operator, mirroring library-go's featuregates on top of controller-runtime.
+//
+// Call Load before starting the manager so that Enabled is usable immediately
+// and the callback is only invoked for actual changes.
+type FeatureGateWatcher struct {
+	client.Client
+
+	// Version is the release version the gates are resolved for, usually the version of the operator's payload.
+	// When empty, the FeatureGate status must list a single version.
+	Version string
+
+	// OnChange is a function that will be called when the resolved feature gates change.
+	OnChange func(ctx context.Context, oldGates, newGates FeatureGates)
+
+	mu    sync.RWMutex
+	gates FeatureGates
+}
+
+// Load resolves the current feature gates using the given reader.
+// It is intended to be called with the manager's APIReader before the manager is started.
+func (r *FeatureGateWatcher) Load(ctx context.Context, reader client.Reader) error {
+	featureGate := &configv1.FeatureGate{}
+	key := client.ObjectKey{Name: ClusterName}
+
+	if err := reader.Get(ctx, key, featureGate); err != nil {
+		return fmt.Errorf("failed to get FeatureGate %q: %w", key.String(), err)
+	}
+
+	gates, err := NewFeatureGates(featureGate, r.Version)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.gates = gates
+
+	return nil
+}
+
+// Gates returns the last resolved feature gates.
+func (r *FeatureGateWatcher) Gates() FeatureGates {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.gates
+}
+
+// Enabled reports whether the feature gate is enabled.
+func (r *FeatureGateWatcher) Enabled(name configv1.FeatureGateName) bool {
+	return r.Gates().Enabled(name)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *FeatureGateWatcher) SetupWithManager(mgr ctrl.Manager) error {
+	return setupSingletonWatcher(mgr, "featuregatewatcher", &configv1.FeatureGate{}, ClusterName, r)
+}
+
+// Reconcile resolves the current feature gates and invokes the callback when they have changed.
+func (r *FeatureGateWatcher) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx, "name", req.Name)
+
+	logger.V(1).Info("Reconciling FeatureGate")
+	defer logger.V(1).Info("Finished reconciling FeatureGate")
+
+	featureGate := &configv1.FeatureGate{}
+	if err := r.Get(ctx, req.NamespacedName, featureGate); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Keep the last resolved gates, the FeatureGate object is not expected to be deleted.
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get FeatureGate %s: %w", req.NamespacedName.String(), err)
+	}
+
+	newGates, err := NewFeatureGates(featureGate, r.Version)
+	if err != nil {
+		// The status is updated for new versions during upgrades, which triggers another reconcile.
+		logger.Info("Feature gates are not resolved for the version yet, keeping the current gates", "version", r.Version)
+		return ctrl.Result{}, nil
+	}
+
+	r.mu.Lock()
+	oldGates := r.gates
+	r.gates = newGates
+	r.mu.Unlock()
+
+	if oldGates.Equal(newGates) {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("Feature gates changed", "enabled", newGates.EnabledGates())
+
+	if r.OnChange != nil {
+		r.OnChange(ctx, oldGates, newGates)
+	}
+
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("FeatureGateWatcher", func() {
+	const (
+		gateA configv1.FeatureGateName = "GateA"
+		gateB configv1.FeatureGateName = "GateB"
+	)
+
+	var (
+		fakeClient  client.Client
+		featureGate *configv1.FeatureGate
+		watcher     *FeatureGateWatcher
+		changes     []FeatureGates
+	)
+
+	req := ctrl.Request{NamespacedName: client.ObjectKey{Name: ClusterName}}
+
+	details := func(version string, enabled, disabled []configv1.FeatureGateName) configv1.FeatureGateDetails {
+		d := configv1.FeatureGateDetails{Version: version}
+		for _, name := range enabled {
+			d.Enabled = append(d.Enabled, configv1.FeatureGateAttributes{Name: name})
+		}
+
+		for _, name := range disabled {
+			d.Disabled = append(d.Disabled, configv1.FeatureGateAttributes{Name: name})
+		}
+
+		return d
+	}
+
+	BeforeEach(func() {
+		featureGate = &configv1.FeatureGate{
+			ObjectMeta: metav1.ObjectMeta{Name: ClusterName},
+			Status: configv1.FeatureGateStatus{
+				FeatureGates: []configv1.FeatureGateDetails{
+					details("4.21.0", []configv1.FeatureGateName{gateA}, []configv1.FeatureGateName{gateB}),
+					details("4.22.0", []configv1.FeatureGateName{gateA, gateB}, nil),
+				},
+			},
+		}
+		fakeClient = newFakeClient(featureGate)
+
+		changes = nil
+		watcher = &FeatureGateWatcher{
+			Client:  fakeClient,
+			Version: "4.21.0",
+			OnChange: func(_ context.Context, _, newGates FeatureGates) {
+				changes = append(changes, newGates)
+			},
+		}
+	})
+
+	It("should resolve the gates of the version", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+		Expect(watcher.Enabled(gateA)).To(BeTrue())
+		Expect(watcher.Enabled(gateB)).To(BeFalse())
+		Expect(watcher.Gates().Known(gateB)).To(BeTrue())
+		Expect(watcher.Gates().Known("Unknown")).To(BeFalse())
+	})
+
+	It("should fail to load when the version is not resolved", func() {
+		watcher.Version = "4.23.0"
+		Expect(watcher.Load(ctx, fakeClient)).To(MatchError(ErrFeatureGatesNotResolved))
+	})
+
+	It("should require a version when several are listed", func() {
+		watcher.Version = ""
+		Expect(watcher.Load(ctx, fakeClient)).To(MatchError(ErrFeatureGatesNotResolved))
+	})
+
+	It("should invoke the callback when the gates of the version change", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+
+		_, err := watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+
+		featureGate.Status.FeatureGates[0] = details("4.21.0", []configv1.FeatureGateName{gateA, gateB}, nil)
+		Expect(fakeClient.Status().Update(ctx, featureGate)).To(Succeed())
+
+		_, err = watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(HaveLen(1))
+		Expect(changes[0].EnabledGates()).To(Equal([]configv1.FeatureGateName{gateA, gateB}))
+		Expect(watcher.Enabled(gateB)).To(BeTrue())
+	})
+})
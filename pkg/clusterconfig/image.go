@@ -0,0 +1,215 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// defaultRegistry is the registry of image references without an explicit registry.
+	defaultRegistry = "docker.io"
+)
+
+// ImageConfig is the cluster image configuration: the registries images may be pulled from
+// and the additional CAs trusted for them.
+type ImageConfig struct {
+	// RegistrySources restricts and configures the registries used by the container runtime.
+	RegistrySources configv1.RegistrySources
+	// AllowedRegistriesForImport restricts the registries image streams may import from.
+	AllowedRegistriesForImport []configv1.RegistryLocation
+	// AdditionalTrustedCA is the name of the ConfigMap in openshift-config holding additional CAs
+	// trusted for registries, keyed by registry hostname.
+	AdditionalTrustedCA string
+	// InternalRegistryHostname is the hostname of the internal registry, if it is deployed.
+	InternalRegistryHostname string
+}
+
+// NewImageConfig returns the configuration of an Image.
+func NewImageConfig(image *configv1.Image) ImageConfig {
+	return ImageConfig{
+		RegistrySources:            *image.Spec.RegistrySources.DeepCopy(),
+		AllowedRegistriesForImport: append([]configv1.RegistryLocation(nil), image.Spec.AllowedRegistriesForImport...),
+		AdditionalTrustedCA:        image.Spec.AdditionalTrustedCA.Name,
+		InternalRegistryHostname:   image.Status.InternalRegistryHostname,
+	}
+}
+
+// Allowed reports whether the image may be pulled according to the allowed and blocked registries.
+// When allowed registries are set, only images from them are allowed; otherwise, images from blocked
+// registries are rejected. Registries may be hostnames, optionally with a wildcard subdomain such as
+// *.example.com, and a repository path prefix.
+func (c ImageConfig) Allowed(imageRef string) bool {
+	if len(c.RegistrySources.AllowedRegistries) > 0 {
+		return matchesAnyRegistry(c.RegistrySources.AllowedRegistries, imageRef)
+	}
+
+	return !matchesAnyRegistry(c.RegistrySources.BlockedRegistries, imageRef)
+}
+
+// Insecure reports whether the image is pulled from a registry configured as insecure,
+// i.e. over plain HTTP or without verifying its certificate.
+//
+// WriteSystemContextFiles writes the files configuring a containers/image SystemContext accordingly.
+func (c ImageConfig) Insecure(imageRef string) bool {
+	return matchesAnyRegistry(c.RegistrySources.InsecureRegistries, imageRef)
+}
+
+// ImageConfigWatcher watches the Image object and tracks the allowed, blocked and insecure registries
+// and the additional trusted CA reference.
+//
+// Call Load before starting the manager so that the getters are usable immediately
+// and the callback is only invoked for actual changes.
+type ImageConfigWatcher struct {
+	client.Client
+
+	// OnChange is a function that will be called when the image configuration changes.
+	OnChange func(ctx context.Context, oldConfig, newConfig ImageConfig)
+
+	mu     sync.RWMutex
+	config ImageConfig
+}
+
+// Load reads the current Image using the given reader.
+// It is intended to be called with the manager's APIReader before the manager is started.
+func (r *ImageConfigWatcher) Load(ctx context.Context, reader client.Reader) error {
+	image := &configv1.Image{}
+	key := client.ObjectKey{Name: ClusterName}
+
+	if err := reader.Get(ctx, key, image); err != nil {
+		return fmt.Errorf("failed to get Image %q: %w", key.String(), err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.config = NewImageConfig(image)
+
+	return nil
+}
+
+// Config returns the last observed image configuration.
+func (r *ImageConfigWatcher) Config() ImageConfig {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.config
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ImageConfigWatcher) SetupWithManager(mgr ctrl.Manager) error {
+	return setupSingletonWatcher(mgr, "imageconfigwatcher", &configv1.Image{}, ClusterName, r)
+}
+
+// Reconcile records the current image configuration and invokes the callback when it has changed.
+func (r *ImageConfigWatcher) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx, "name", req.Name)
+
+	logger.V(1).Info("Reconciling Image")
+	defer logger.V(1).Info("Finished reconciling Image")
+
+	image := &configv1.Image{}
+	if err := r.Get(ctx, req.NamespacedName, image); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Keep the last observed configuration, the Image object is not expected to be deleted.
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get Image %s: %w", req.NamespacedName.String(), err)
+	}
+
+	newConfig := NewImageConfig(image)
+
+	r.mu.Lock()
+	oldConfig := r.config
+	r.config = newConfig
+	r.mu.Unlock()
+
+	if equality.Semantic.DeepEqual(oldConfig, newConfig) {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("Image configuration changed")
+
+	if r.OnChange != nil {
+		r.OnChange(ctx, oldConfig, newConfig)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// splitImageReference returns the registry host and the repository path of an image reference,
+// without its tag or digest. References without a registry are resolved against docker.io, and its official
+// images against its library namespace.
+func splitImageReference(imageRef string) (registry, repository string) {
+	name := imageRef
+	if i := strings.Index(name, "@"); i >= 0 {
+		name = name[:i]
+	}
+
+	// Strip the tag, which follows the last colon after the last slash.
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name = name[:i]
+	}
+
+	registry, repository = defaultRegistry, name
+	if first, rest, found := strings.Cut(name, "/"); found && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		registry, repository = first, rest
+	}
+
+	// Official images of the default registry are in the library namespace.
+	if registry == defaultRegistry && !strings.Contains(repository, "/") {
+		repository = "library/" + repository
+	}
+
+	return registry, repository
+}
+
+// matchesAnyRegistry reports whether the image belongs to any of the registries.
+func matchesAnyRegistry(registries []string, imageRef string) bool {
+	registry, repository := splitImageReference(imageRef)
+	location := registry + "/" + repository
+
+	for _, pattern := range registries {
+		host, path, _ := strings.Cut(pattern, "/")
+
+		hostMatches := host == registry
+		if suffix, ok := strings.CutPrefix(host, "*"); ok {
+			hostMatches = strings.HasSuffix(registry, suffix)
+		}
+
+		if !hostMatches {
+			continue
+		}
+
+		if path == "" || location == registry+"/"+path || strings.HasPrefix(location, registry+"/"+path+"/") {
+			return true
+		}
+	}
+
+	return false
+}
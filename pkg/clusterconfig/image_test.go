@@ -0,0 +1,89 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("ImageConfigWatcher", func() {
+	var (
+		fakeClient client.Client
+		image      *configv1.Image
+		watcher    *ImageConfigWatcher
+		changes    []ImageConfig
+	)
+
+	req := ctrl.Request{NamespacedName: client.ObjectKey{Name: ClusterName}}
+
+	BeforeEach(func() {
+		image = &configv1.Image{
+			ObjectMeta: metav1.ObjectMeta{Name: ClusterName},
+			Spec: configv1.ImageSpec{
+				AdditionalTrustedCA: configv1.ConfigMapNameReference{Name: "registry-cas"},
+				RegistrySources: configv1.RegistrySources{
+					BlockedRegistries:  []string{"docker.io/library", "*.untrusted.example.com"},
+					InsecureRegistries: []string{"registry.lab.example.com:5000"},
+				},
+			},
+		}
+		fakeClient = newFakeClient(image)
+
+		changes = nil
+		watcher = &ImageConfigWatcher{
+			Client: fakeClient,
+			OnChange: func(_ context.Context, _, newConfig ImageConfig) {
+				changes = append(changes, newConfig)
+			},
+		}
+	})
+
+	It("should apply the blocked and insecure registries", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+		config := watcher.Config()
+
+		Expect(config.AdditionalTrustedCA).To(Equal("registry-cas"))
+		Expect(config.Allowed("busybox:latest")).To(BeFalse())
+		Expect(config.Allowed("docker.io/library/busybox@sha256:0000")).To(BeFalse())
+		Expect(config.Allowed("docker.io/someone/busybox")).To(BeTrue())
+		Expect(config.Allowed("registry.untrusted.example.com/app:1.0")).To(BeFalse())
+		Expect(config.Allowed("quay.io/openshift/origin-cli:4.21")).To(BeTrue())
+		Expect(config.Insecure("registry.lab.example.com:5000/app:1.0")).To(BeTrue())
+		Expect(config.Insecure("quay.io/openshift/origin-cli:4.21")).To(BeFalse())
+	})
+
+	It("should only allow the allowed registries when set", func() {
+		image.Spec.RegistrySources = configv1.RegistrySources{AllowedRegistries: []string{"quay.io/openshift"}}
+		Expect(fakeClient.Update(ctx, image)).To(Succeed())
+
+		_, err := watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(HaveLen(1))
+
+		config := watcher.Config()
+		Expect(config.Allowed("quay.io/openshift/origin-cli:4.21")).To(BeTrue())
+		Expect(config.Allowed("quay.io/openshiftish/app:4.21")).To(BeFalse())
+		Expect(config.Allowed("quay.io/someone/app:1.0")).To(BeFalse())
+	})
+})
@@ -0,0 +1,220 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/controller-runtime-common/pkg/references"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ConfigNamespace is the namespace of the objects referenced by the cluster configuration, such as the
+	// AdditionalTrustedCA ConfigMap of the Image.
+	ConfigNamespace = "openshift-config"
+
+	// registriesConfFile, signaturePolicyFile and certsDir are the names of the files written by
+	// WriteSystemContextFiles.
+	registriesConfFile  = "registries.conf"
+	signaturePolicyFile = "policy.json"
+	certsDir            = "certs.d"
+)
+
+// SystemContextFiles are the paths of the files written by ImageConfig.WriteSystemContextFiles, named after the
+// fields of the containers/image SystemContext they are meant for.
+type SystemContextFiles struct {
+	// SystemRegistriesConfPath is the path of the registries.conf blocking and marking registries insecure.
+	SystemRegistriesConfPath string
+	// SignaturePolicyPath is the path of the policy.json rejecting the images that are not allowed.
+	SignaturePolicyPath string
+	// DockerPerHostCertDirPath is the directory of the additional CAs trusted for registries, by registry host.
+	DockerPerHostCertDirPath string
+}
+
+// RegistriesConf returns the registries.conf, in its version 2 format, marking the insecure registries insecure
+// and the blocked registries blocked, and listing the registries searched for unqualified image references.
+func (c ImageConfig) RegistriesConf() []byte {
+	var b strings.Builder
+
+	if search := c.RegistrySources.ContainerRuntimeSearchRegistries; len(search) > 0 {
+		quoted := make([]string, 0, len(search))
+		for _, registry := range search {
+			quoted = append(quoted, strconv.Quote(registry))
+		}
+
+		fmt.Fprintf(&b, "unqualified-search-registries = [%s]\n", strings.Join(quoted, ", "))
+	}
+
+	prefixes := slices.Concat(c.RegistrySources.InsecureRegistries, c.RegistrySources.BlockedRegistries)
+	slices.Sort(prefixes)
+
+	for _, prefix := range slices.Compact(prefixes) {
+		fmt.Fprintf(&b, "\n[[registry]]\nprefix = %s\n", strconv.Quote(prefix))
+
+		// Wildcard prefixes cannot have a location.
+		if !strings.HasPrefix(prefix, "*.") {
+			fmt.Fprintf(&b, "location = %s\n", strconv.Quote(prefix))
+		}
+
+		if slices.Contains(c.RegistrySources.InsecureRegistries, prefix) {
+			b.WriteString("insecure = true\n")
+		}
+
+		if slices.Contains(c.RegistrySources.BlockedRegistries, prefix) {
+			b.WriteString("blocked = true\n")
+		}
+	}
+
+	return []byte(b.String())
+}
+
+// signaturePolicyRequirement is a requirement of a containers/image signature policy.
+type signaturePolicyRequirement struct {
+	Type string `json:"type"`
+}
+
+// signaturePolicy is a containers/image signature policy.
+type signaturePolicy struct {
+	Default    []signaturePolicyRequirement                       `json:"default"`
+	Transports map[string]map[string][]signaturePolicyRequirement `json:"transports,omitempty"`
+}
+
+// SignaturePolicy returns the policy.json enforcing the allowed and blocked registries, as Allowed does: when allowed
+// registries are set, images from other registries are rejected; otherwise, images from blocked registries are.
+// Signatures are not verified.
+func (c ImageConfig) SignaturePolicy() ([]byte, error) {
+	accept := []signaturePolicyRequirement{{Type: "insecureAcceptAnything"}}
+	reject := []signaturePolicyRequirement{{Type: "reject"}}
+
+	policy := signaturePolicy{Default: accept}
+	scopes, requirement := c.RegistrySources.BlockedRegistries, reject
+
+	if len(c.RegistrySources.AllowedRegistries) > 0 {
+		policy.Default = reject
+		scopes, requirement = c.RegistrySources.AllowedRegistries, accept
+	}
+
+	if len(scopes) > 0 {
+		docker := make(map[string][]signaturePolicyRequirement, len(scopes))
+		for _, scope := range scopes {
+			docker[scope] = requirement
+		}
+
+		policy.Transports = map[string]map[string][]signaturePolicyRequirement{"docker": docker}
+	}
+
+	data, err := json.MarshalIndent(policy, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the signature policy: %w", err)
+	}
+
+	return data, nil
+}
+
+// FetchAdditionalTrustedCAs returns the PEM encoded CAs of the AdditionalTrustedCA ConfigMap, in ConfigNamespace,
+// by registry host. The ports of the hosts, separated by ".." in the keys of the ConfigMap, are separated by ":".
+// It returns no CAs when the configuration has no AdditionalTrustedCA.
+func FetchAdditionalTrustedCAs(ctx context.Context, reader client.Reader, config ImageConfig) (map[string]string, error) {
+	if config.AdditionalTrustedCA == "" {
+		return nil, nil
+	}
+
+	configMap, err := references.ResolveConfigMap(ctx, reader, ConfigNamespace,
+		configv1.ConfigMapNameReference{Name: config.AdditionalTrustedCA})
+	if err != nil {
+		return nil, err
+	}
+
+	cas := make(map[string]string, len(configMap.Data))
+	for key, ca := range configMap.Data {
+		cas[strings.ReplaceAll(key, "..", ":")] = ca
+	}
+
+	return cas, nil
+}
+
+// WriteSystemContextFiles writes the registries.conf, the policy.json and the CAs trusted for registries, as
+// returned by FetchAdditionalTrustedCAs, in dir, and returns their paths to set on a containers/image SystemContext.
+//
+// Example:
+//
+//	cas, err := clusterconfig.FetchAdditionalTrustedCAs(ctx, r.Client, watcher.Config())
+//	if err != nil {
+//	    return err
+//	}
+//
+//	files, err := watcher.Config().WriteSystemContextFiles(dir, cas)
+//	if err != nil {
+//	    return err
+//	}
+//
+//	sys := &types.SystemContext{
+//	    SystemRegistriesConfPath: files.SystemRegistriesConfPath,
+//	    SignaturePolicyPath:      files.SignaturePolicyPath,
+//	    DockerPerHostCertDirPath: files.DockerPerHostCertDirPath,
+//	}
+func (c ImageConfig) WriteSystemContextFiles(dir string, trustedCAs map[string]string) (SystemContextFiles, error) {
+	files := SystemContextFiles{
+		SystemRegistriesConfPath: filepath.Join(dir, registriesConfFile),
+		SignaturePolicyPath:      filepath.Join(dir, signaturePolicyFile),
+		DockerPerHostCertDirPath: filepath.Join(dir, certsDir),
+	}
+
+	policy, err := c.SignaturePolicy()
+	if err != nil {
+		return SystemContextFiles{}, err
+	}
+
+	if err := os.WriteFile(files.SystemRegistriesConfPath, c.RegistriesConf(), 0o600); err != nil {
+		return SystemContextFiles{}, fmt.Errorf("failed to write %s: %w", files.SystemRegistriesConfPath, err)
+	}
+
+	if err := os.WriteFile(files.SignaturePolicyPath, policy, 0o600); err != nil {
+		return SystemContextFiles{}, fmt.Errorf("failed to write %s: %w", files.SignaturePolicyPath, err)
+	}
+
+	// Remove the CAs of registries no longer configured.
+	if err := os.RemoveAll(files.DockerPerHostCertDirPath); err != nil {
+		return SystemContextFiles{}, fmt.Errorf("failed to remove %s: %w", files.DockerPerHostCertDirPath, err)
+	}
+
+	for host, ca := range trustedCAs {
+		hostDir := filepath.Join(files.DockerPerHostCertDirPath, host)
+		if filepath.Dir(hostDir) != files.DockerPerHostCertDirPath {
+			return SystemContextFiles{}, fmt.Errorf("invalid registry host %q", host)
+		}
+
+		if err := os.MkdirAll(hostDir, 0o700); err != nil {
+			return SystemContextFiles{}, fmt.Errorf("failed to create %s: %w", hostDir, err)
+		}
+
+		if err := os.WriteFile(filepath.Join(hostDir, "ca.crt"), []byte(ca), 0o600); err != nil {
+			return SystemContextFiles{}, fmt.Errorf("failed to write the CA of %s: %w", host, err)
+		}
+	}
+
+	return files, nil
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("ImageConfig system context", func() {
+	config := ImageConfig{
+		RegistrySources: configv1.RegistrySources{
+			BlockedRegistries:                []string{"docker.io/library", "*.untrusted.example.com"},
+			InsecureRegistries:               []string{"registry.lab.example.com:5000", "docker.io/library"},
+			ContainerRuntimeSearchRegistries: []string{"registry.example.com"},
+		},
+		AdditionalTrustedCA: "registry-cas",
+	}
+
+	It("should mark the blocked and insecure registries in registries.conf", func() {
+		Expect(string(config.RegistriesConf())).To(Equal(`unqualified-search-registries = ["registry.example.com"]
+
+[[registry]]
+prefix = "*.untrusted.example.com"
+blocked = true
+
+[[registry]]
+prefix = "docker.io/library"
+location = "docker.io/library"
+insecure = true
+blocked = true
+
+[[registry]]
+prefix = "registry.lab.example.com:5000"
+location = "registry.lab.example.com:5000"
+insecure = true
+`))
+	})
+
+	It("should reject the blocked registries, or all but the allowed ones", func() {
+		Expect(config.SignaturePolicy()).To(MatchJSON(`{
+			"default": [{"type": "insecureAcceptAnything"}],
+			"transports": {"docker": {
+				"docker.io/library": [{"type": "reject"}],
+				"*.untrusted.example.com": [{"type": "reject"}]
+			}}
+		}`))
+
+		allowed := ImageConfig{RegistrySources: configv1.RegistrySources{AllowedRegistries: []string{"quay.io/openshift"}}}
+		Expect(allowed.SignaturePolicy()).To(MatchJSON(`{
+			"default": [{"type": "reject"}],
+			"transports": {"docker": {"quay.io/openshift": [{"type": "insecureAcceptAnything"}]}}
+		}`))
+
+		Expect(ImageConfig{}.SignaturePolicy()).To(MatchJSON(`{"default": [{"type": "insecureAcceptAnything"}]}`))
+	})
+
+	It("should write the files of the system context with the trusted CAs", func() {
+		fakeClient := newFakeClient(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ConfigNamespace, Name: "registry-cas"},
+			Data:       map[string]string{"registry.lab.example.com..5000": "lab-ca"},
+		})
+
+		cas, err := FetchAdditionalTrustedCAs(ctx, fakeClient, config)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cas).To(Equal(map[string]string{"registry.lab.example.com:5000": "lab-ca"}))
+
+		dir := GinkgoT().TempDir()
+		files, err := config.WriteSystemContextFiles(dir, cas)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(os.ReadFile(files.SystemRegistriesConfPath)).To(Equal(config.RegistriesConf()))
+		policy, err := os.ReadFile(files.SignaturePolicyPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(json.Valid(policy)).To(BeTrue())
+		Expect(os.ReadFile(filepath.Join(files.DockerPerHostCertDirPath, "registry.lab.example.com:5000", "ca.crt"))).
+			To(BeEquivalentTo("lab-ca"))
+
+		_, err = config.WriteSystemContextFiles(dir, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(filepath.Join(files.DockerPerHostCertDirPath, "registry.lab.example.com:5000")).NotTo(BeADirectory())
+
+		_, err = config.WriteSystemContextFiles(dir, map[string]string{"../escape": "ca"})
+		Expect(err).To(MatchError(ContainSubstring("invalid registry host")))
+	})
+})
@@ -0,0 +1,179 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	configv1 "github.com/openshift/api/config/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// InfrastructureWatcher watches the Infrastructure object and surfaces the platform type and topologies
+// of the cluster, so that operators can adapt replica counts and anti-affinity to them.
+//
+// Call Load before starting the manager so that the getters are usable immediately
+// and the callbacks are only invoked for actual changes.
+type InfrastructureWatcher struct {
+	client.Client
+
+	// OnPlatformChange is a function that will be called when the platform type changes.
+	OnPlatformChange func(ctx context.Context, oldPlatform, newPlatform configv1.PlatformType)
+
+	// OnControlPlaneTopologyChange is a function that will be called when the control plane topology changes.
+	OnControlPlaneTopologyChange func(ctx context.Context, oldTopology, newTopology configv1.TopologyMode)
+
+	// OnInfrastructureTopologyChange is a function that will be called when the infrastructure topology changes.
+	OnInfrastructureTopologyChange func(ctx context.Context, oldTopology, newTopology configv1.TopologyMode)
+
+	mu     sync.RWMutex
+	status configv1.InfrastructureStatus
+}
+
+// FetchInfrastructure fetches the Infrastructure object of the cluster.
+func FetchInfrastructure(ctx context.Context, reader client.Reader) (*configv1.Infrastructure, error) {
+	infrastructure := &configv1.Infrastructure{}
+	key := client.ObjectKey{Name: ClusterName}
+
+	if err := reader.Get(ctx, key, infrastructure); err != nil {
+		return nil, fmt.Errorf("failed to get Infrastructure %q: %w", key.String(), err)
+	}
+
+	return infrastructure, nil
+}
+
+// Load reads the current Infrastructure using the given reader.
+// It is intended to be called with the manager's APIReader before the manager is started.
+func (r *InfrastructureWatcher) Load(ctx context.Context, reader client.Reader) error {
+	infrastructure, err := FetchInfrastructure(ctx, reader)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.status = *infrastructure.Status.DeepCopy()
+
+	return nil
+}
+
+// Status returns a copy of the last observed Infrastructure status.
+func (r *InfrastructureWatcher) Status() configv1.InfrastructureStatus {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return *r.status.DeepCopy()
+}
+
+// PlatformType returns the platform type of the cluster.
+func (r *InfrastructureWatcher) PlatformType() configv1.PlatformType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return platformType(r.status)
+}
+
+// ControlPlaneTopology returns the topology of the control plane nodes.
+func (r *InfrastructureWatcher) ControlPlaneTopology() configv1.TopologyMode {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.status.ControlPlaneTopology
+}
+
+// InfrastructureTopology returns the topology of the infrastructure nodes, where operands usually run.
+func (r *InfrastructureWatcher) InfrastructureTopology() configv1.TopologyMode {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.status.InfrastructureTopology
+}
+
+// IsSingleNode reports whether the infrastructure runs on a single node,
+// in which case operands should run a single replica without anti-affinity.
+func (r *InfrastructureWatcher) IsSingleNode() bool {
+	return r.InfrastructureTopology() == configv1.SingleReplicaTopologyMode
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *InfrastructureWatcher) SetupWithManager(mgr ctrl.Manager) error {
+	return setupSingletonWatcher(mgr, "infrastructurewatcher", &configv1.Infrastructure{}, ClusterName, r)
+}
+
+// Reconcile records the current Infrastructure status and invokes the callbacks for the fields that changed.
+func (r *InfrastructureWatcher) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx, "name", req.Name)
+
+	logger.V(1).Info("Reconciling Infrastructure")
+	defer logger.V(1).Info("Finished reconciling Infrastructure")
+
+	infrastructure := &configv1.Infrastructure{}
+	if err := r.Get(ctx, req.NamespacedName, infrastructure); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Keep the last observed status, the Infrastructure object is not expected to be deleted.
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get Infrastructure %s: %w", req.NamespacedName.String(), err)
+	}
+
+	r.mu.Lock()
+	oldStatus := r.status
+	r.status = *infrastructure.Status.DeepCopy()
+	newStatus := r.status
+	r.mu.Unlock()
+
+	if oldPlatform, newPlatform := platformType(oldStatus), platformType(newStatus); oldPlatform != newPlatform {
+		logger.Info("Platform type changed", "old", oldPlatform, "new", newPlatform)
+
+		if r.OnPlatformChange != nil {
+			r.OnPlatformChange(ctx, oldPlatform, newPlatform)
+		}
+	}
+
+	if oldStatus.ControlPlaneTopology != newStatus.ControlPlaneTopology {
+		logger.Info("Control plane topology changed", "old", oldStatus.ControlPlaneTopology, "new", newStatus.ControlPlaneTopology)
+
+		if r.OnControlPlaneTopologyChange != nil {
+			r.OnControlPlaneTopologyChange(ctx, oldStatus.ControlPlaneTopology, newStatus.ControlPlaneTopology)
+		}
+	}
+
+	if oldStatus.InfrastructureTopology != newStatus.InfrastructureTopology {
+		logger.Info("Infrastructure topology changed", "old", oldStatus.InfrastructureTopology, "new", newStatus.InfrastructureTopology)
+
+		if r.OnInfrastructureTopologyChange != nil {
+			r.OnInfrastructureTopologyChange(ctx, oldStatus.InfrastructureTopology, newStatus.InfrastructureTopology)
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func platformType(status configv1.InfrastructureStatus) configv1.PlatformType {
+	if status.PlatformStatus == nil {
+		return ""
+	}
+
+	return status.PlatformStatus.Type
+}
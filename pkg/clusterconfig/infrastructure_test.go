@@ -0,0 +1,101 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("InfrastructureWatcher", func() {
+	var (
+		fakeClient       client.Client
+		infrastructure   *configv1.Infrastructure
+		watcher          *InfrastructureWatcher
+		platformChanges  []configv1.PlatformType
+		topologyChanges  []configv1.TopologyMode
+		infraTopoChanges []configv1.TopologyMode
+	)
+
+	req := ctrl.Request{NamespacedName: client.ObjectKey{Name: ClusterName}}
+
+	BeforeEach(func() {
+		infrastructure = &configv1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: ClusterName},
+			Status: configv1.InfrastructureStatus{
+				PlatformStatus:         &configv1.PlatformStatus{Type: configv1.AWSPlatformType},
+				ControlPlaneTopology:   configv1.HighlyAvailableTopologyMode,
+				InfrastructureTopology: configv1.HighlyAvailableTopologyMode,
+			},
+		}
+		fakeClient = newFakeClient(infrastructure)
+
+		platformChanges, topologyChanges, infraTopoChanges = nil, nil, nil
+		watcher = &InfrastructureWatcher{
+			Client: fakeClient,
+			OnPlatformChange: func(_ context.Context, _, newPlatform configv1.PlatformType) {
+				platformChanges = append(platformChanges, newPlatform)
+			},
+			OnControlPlaneTopologyChange: func(_ context.Context, _, newTopology configv1.TopologyMode) {
+				topologyChanges = append(topologyChanges, newTopology)
+			},
+			OnInfrastructureTopologyChange: func(_ context.Context, _, newTopology configv1.TopologyMode) {
+				infraTopoChanges = append(infraTopoChanges, newTopology)
+			},
+		}
+	})
+
+	It("should expose the loaded state without invoking callbacks", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+		Expect(watcher.PlatformType()).To(Equal(configv1.AWSPlatformType))
+		Expect(watcher.ControlPlaneTopology()).To(Equal(configv1.HighlyAvailableTopologyMode))
+		Expect(watcher.IsSingleNode()).To(BeFalse())
+
+		_, err := watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(platformChanges).To(BeEmpty())
+		Expect(topologyChanges).To(BeEmpty())
+		Expect(infraTopoChanges).To(BeEmpty())
+	})
+
+	It("should invoke the callbacks of the fields that changed", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+
+		infrastructure.Status.ControlPlaneTopology = configv1.SingleReplicaTopologyMode
+		infrastructure.Status.InfrastructureTopology = configv1.SingleReplicaTopologyMode
+		Expect(fakeClient.Status().Update(ctx, infrastructure)).To(Succeed())
+
+		_, err := watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(platformChanges).To(BeEmpty())
+		Expect(topologyChanges).To(Equal([]configv1.TopologyMode{configv1.SingleReplicaTopologyMode}))
+		Expect(infraTopoChanges).To(Equal([]configv1.TopologyMode{configv1.SingleReplicaTopologyMode}))
+		Expect(watcher.IsSingleNode()).To(BeTrue())
+	})
+
+	It("should report the initial state as a change when not loaded", func() {
+		_, err := watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(platformChanges).To(Equal([]configv1.PlatformType{configv1.AWSPlatformType}))
+	})
+})
@@ -0,0 +1,155 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// IngressConfig is the cluster ingress configuration relevant to operators exposing Routes.
+type IngressConfig struct {
+	// Domain is the default domain of Routes.
+	Domain string
+	// AppsDomain is the domain of user Routes when it differs from Domain.
+	AppsDomain string
+	// ComponentRoutes are the custom hostnames and serving certificates configured for component Routes.
+	ComponentRoutes []configv1.ComponentRouteSpec
+}
+
+// NewIngressConfig returns the configuration from the spec of an Ingress.
+func NewIngressConfig(ingress *configv1.Ingress) IngressConfig {
+	return IngressConfig{
+		Domain:          ingress.Spec.Domain,
+		AppsDomain:      ingress.Spec.AppsDomain,
+		ComponentRoutes: append([]configv1.ComponentRouteSpec(nil), ingress.Spec.ComponentRoutes...),
+	}
+}
+
+// RouteHost returns the default host of a Route, <name>-<namespace>.<domain>.
+func (c IngressConfig) RouteHost(namespace, name string) string {
+	return fmt.Sprintf("%s-%s.%s", name, namespace, c.Domain)
+}
+
+// ComponentRoute returns the customization of the component Route with the given namespace and name, if any.
+// When found, operators should use its hostname and serve with the certificate in its ServingCertKeyPairSecret,
+// which lives in the openshift-config namespace.
+func (c IngressConfig) ComponentRoute(namespace, name string) (configv1.ComponentRouteSpec, bool) {
+	for _, route := range c.ComponentRoutes {
+		if route.Namespace == namespace && route.Name == name {
+			return route, true
+		}
+	}
+
+	return configv1.ComponentRouteSpec{}, false
+}
+
+// IngressConfigWatcher watches the Ingress object and surfaces the cluster ingress domain and component Route
+// configuration, so that operators templating Route hosts can react when they change.
+//
+// Call Load before starting the manager so that the getters are usable immediately
+// and the callback is only invoked for actual changes.
+type IngressConfigWatcher struct {
+	client.Client
+
+	// OnChange is a function that will be called when the domain or the component Routes change.
+	OnChange func(ctx context.Context, oldConfig, newConfig IngressConfig)
+
+	mu     sync.RWMutex
+	config IngressConfig
+}
+
+// Load reads the current Ingress using the given reader.
+// It is intended to be called with the manager's APIReader before the manager is started.
+func (r *IngressConfigWatcher) Load(ctx context.Context, reader client.Reader) error {
+	ingress := &configv1.Ingress{}
+	key := client.ObjectKey{Name: ClusterName}
+
+	if err := reader.Get(ctx, key, ingress); err != nil {
+		return fmt.Errorf("failed to get Ingress %q: %w", key.String(), err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.config = NewIngressConfig(ingress)
+
+	return nil
+}
+
+// Config returns the last observed ingress configuration.
+func (r *IngressConfigWatcher) Config() IngressConfig {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.config
+}
+
+// Domain returns the default domain of Routes.
+func (r *IngressConfigWatcher) Domain() string {
+	return r.Config().Domain
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *IngressConfigWatcher) SetupWithManager(mgr ctrl.Manager) error {
+	return setupSingletonWatcher(mgr, "ingressconfigwatcher", &configv1.Ingress{}, ClusterName, r)
+}
+
+// Reconcile records the current ingress configuration and invokes the callback when it has changed.
+func (r *IngressConfigWatcher) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx, "name", req.Name)
+
+	logger.V(1).Info("Reconciling Ingress")
+	defer logger.V(1).Info("Finished reconciling Ingress")
+
+	ingress := &configv1.Ingress{}
+	if err := r.Get(ctx, req.NamespacedName, ingress); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Keep the last observed configuration, the Ingress object is not expected to be deleted.
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get Ingress %s: %w", req.NamespacedName.String(), err)
+	}
+
+	newConfig := NewIngressConfig(ingress)
+
+	r.mu.Lock()
+	oldConfig := r.config
+	r.config = newConfig
+	r.mu.Unlock()
+
+	if equality.Semantic.DeepEqual(oldConfig, newConfig) {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("Ingress configuration changed", "domain", newConfig.Domain, "componentRoutes", len(newConfig.ComponentRoutes))
+
+	if r.OnChange != nil {
+		r.OnChange(ctx, oldConfig, newConfig)
+	}
+
+	return ctrl.Result{}, nil
+}
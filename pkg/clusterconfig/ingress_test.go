@@ -0,0 +1,89 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("IngressConfigWatcher", func() {
+	var (
+		fakeClient client.Client
+		ingress    *configv1.Ingress
+		watcher    *IngressConfigWatcher
+		changes    []IngressConfig
+	)
+
+	req := ctrl.Request{NamespacedName: client.ObjectKey{Name: ClusterName}}
+
+	BeforeEach(func() {
+		ingress = &configv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Name: ClusterName},
+			Spec:       configv1.IngressSpec{Domain: "apps.example.com"},
+		}
+		fakeClient = newFakeClient(ingress)
+
+		changes = nil
+		watcher = &IngressConfigWatcher{
+			Client: fakeClient,
+			OnChange: func(_ context.Context, _, newConfig IngressConfig) {
+				changes = append(changes, newConfig)
+			},
+		}
+	})
+
+	It("should expose the domain and default Route hosts", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+		Expect(watcher.Domain()).To(Equal("apps.example.com"))
+		Expect(watcher.Config().RouteHost("openshift-console", "console")).To(Equal("console-openshift-console.apps.example.com"))
+
+		_, found := watcher.Config().ComponentRoute("openshift-console", "console")
+		Expect(found).To(BeFalse())
+	})
+
+	It("should invoke the callback when component Routes change", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+
+		_, err := watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+
+		ingress.Spec.ComponentRoutes = []configv1.ComponentRouteSpec{{
+			Namespace:                "openshift-console",
+			Name:                     "console",
+			Hostname:                 "console.example.com",
+			ServingCertKeyPairSecret: configv1.SecretNameReference{Name: "console-cert"},
+		}}
+		Expect(fakeClient.Update(ctx, ingress)).To(Succeed())
+
+		_, err = watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(HaveLen(1))
+
+		route, found := watcher.Config().ComponentRoute("openshift-console", "console")
+		Expect(found).To(BeTrue())
+		Expect(route.Hostname).To(BeEquivalentTo("console.example.com"))
+		Expect(route.ServingCertKeyPairSecret.Name).To(Equal("console-cert"))
+	})
+})
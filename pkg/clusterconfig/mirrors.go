@@ -0,0 +1,233 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	configv1 "github.com/openshift/api/config/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// mirrorRule is the merged configuration of all mirror sets for a source.
+type mirrorRule struct {
+	mirrors            []string
+	neverContactSource bool
+}
+
+// mirrorRules maps sources to their rules.
+type mirrorRules map[string]*mirrorRule
+
+func (m mirrorRules) add(source string, mirrors []configv1.ImageMirror, policy configv1.MirrorSourcePolicy) {
+	rule, ok := m[source]
+	if !ok {
+		rule = &mirrorRule{}
+		m[source] = rule
+	}
+
+	for _, mirror := range mirrors {
+		if !slices.Contains(rule.mirrors, string(mirror)) {
+			rule.mirrors = append(rule.mirrors, string(mirror))
+		}
+	}
+
+	// Never contacting the source wins when mirror sets disagree, as it is the safer choice in disconnected clusters.
+	rule.neverContactSource = rule.neverContactSource || policy == configv1.NeverContactSource
+}
+
+// resolve returns the pull locations of the image, using the rule of the most specific matching source.
+func (m mirrorRules) resolve(imageRef, name string) []string {
+	var (
+		source string
+		rule   *mirrorRule
+	)
+
+	for candidate, candidateRule := range m {
+		if (name == candidate || strings.HasPrefix(name, candidate+"/")) && len(candidate) > len(source) {
+			source, rule = candidate, candidateRule
+		}
+	}
+
+	if rule == nil {
+		return []string{imageRef}
+	}
+
+	locations := make([]string, 0, len(rule.mirrors)+1)
+	for _, mirror := range rule.mirrors {
+		locations = append(locations, mirror+strings.TrimPrefix(imageRef, source))
+	}
+
+	if !rule.neverContactSource {
+		locations = append(locations, imageRef)
+	}
+
+	return locations
+}
+
+// MirrorResolver watches ImageDigestMirrorSets and ImageTagMirrorSets and resolves image references
+// to the locations they should be pulled from, honoring cluster mirroring as disconnected environments require.
+//
+// Call Load before starting the manager so that ResolveMirrors is usable immediately.
+// The operator needs RBAC to list and watch imagedigestmirrorsets and imagetagmirrorsets.
+type MirrorResolver struct {
+	client.Client
+
+	// OnChange is a function that will be called after the mirror configuration has changed.
+	OnChange func(ctx context.Context)
+
+	mu          sync.RWMutex
+	digestRules mirrorRules
+	tagRules    mirrorRules
+}
+
+// Load reads the current mirror sets using the given reader.
+// It is intended to be called with the manager's APIReader before the manager is started.
+func (r *MirrorResolver) Load(ctx context.Context, reader client.Reader) error {
+	_, err := r.load(ctx, reader)
+	return err
+}
+
+// ResolveMirrors returns the locations to pull the fully qualified image reference from, in order of preference:
+// the mirrors configured for the most specific matching source, followed by the reference itself unless the
+// source must never be contacted. Digest references use ImageDigestMirrorSets and tag references
+// ImageTagMirrorSets. References without mirrors resolve to themselves.
+func (r *MirrorResolver) ResolveMirrors(imageRef string) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if name, _, isDigest := strings.Cut(imageRef, "@"); isDigest {
+		return r.digestRules.resolve(imageRef, name)
+	}
+
+	name := imageRef
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name = name[:i]
+	}
+
+	return r.tagRules.resolve(imageRef, name)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *MirrorResolver) SetupWithManager(mgr ctrl.Manager) error {
+	// Every event results in the same request, which rebuilds the whole mapping.
+	enqueueSync := handler.EnqueueRequestsFromMapFunc(func(context.Context, client.Object) []reconcile.Request {
+		return []reconcile.Request{{NamespacedName: client.ObjectKey{Name: "mirrors"}}}
+	})
+
+	if err := ctrl.NewControllerManagedBy(mgr).
+		Named("mirrorresolver").
+		WithOptions(controller.Options{NeedLeaderElection: ptr.To(false)}).
+		Watches(&configv1.ImageDigestMirrorSet{}, enqueueSync).
+		Watches(&configv1.ImageTagMirrorSet{}, enqueueSync).
+		// Override the default log constructor as it makes the logs very chatty.
+		WithLogConstructor(func(_ *reconcile.Request) logr.Logger {
+			return mgr.GetLogger().WithValues(
+				"controller", "mirrorresolver",
+			)
+		}).
+		Complete(r); err != nil {
+		return fmt.Errorf("could not set up controller for mirror resolver: %w", err)
+	}
+
+	return nil
+}
+
+// Reconcile rebuilds the mirror mapping and invokes the callback when it has changed.
+func (r *MirrorResolver) Reconcile(ctx context.Context, _ ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	logger.V(1).Info("Reconciling image mirror sets")
+	defer logger.V(1).Info("Finished reconciling image mirror sets")
+
+	changed, err := r.load(ctx, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if !changed {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("Image mirror configuration changed")
+
+	if r.OnChange != nil {
+		r.OnChange(ctx)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// load rebuilds the mapping from the mirror sets and reports whether it changed.
+func (r *MirrorResolver) load(ctx context.Context, reader client.Reader) (bool, error) {
+	digestSets := &configv1.ImageDigestMirrorSetList{}
+	if err := reader.List(ctx, digestSets); err != nil {
+		return false, fmt.Errorf("failed to list ImageDigestMirrorSets: %w", err)
+	}
+
+	tagSets := &configv1.ImageTagMirrorSetList{}
+	if err := reader.List(ctx, tagSets); err != nil {
+		return false, fmt.Errorf("failed to list ImageTagMirrorSets: %w", err)
+	}
+
+	digestRules := mirrorRules{}
+	for _, set := range digestSets.Items {
+		for _, mirrors := range set.Spec.ImageDigestMirrors {
+			digestRules.add(mirrors.Source, mirrors.Mirrors, mirrors.MirrorSourcePolicy)
+		}
+	}
+
+	tagRules := mirrorRules{}
+	for _, set := range tagSets.Items {
+		for _, mirrors := range set.Spec.ImageTagMirrors {
+			tagRules.add(mirrors.Source, mirrors.Mirrors, mirrors.MirrorSourcePolicy)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	changed := !mirrorRulesEqual(r.digestRules, digestRules) || !mirrorRulesEqual(r.tagRules, tagRules)
+	r.digestRules, r.tagRules = digestRules, tagRules
+
+	return changed, nil
+}
+
+func mirrorRulesEqual(a, b mirrorRules) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for source, ruleA := range a {
+		ruleB, ok := b[source]
+		if !ok || ruleA.neverContactSource != ruleB.neverContactSource || !slices.Equal(ruleA.mirrors, ruleB.mirrors) {
+			return false
+		}
+	}
+
+	return true
+}
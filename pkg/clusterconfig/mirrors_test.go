@@ -0,0 +1,113 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("MirrorResolver", func() {
+	const digest = "@sha256:0000000000000000000000000000000000000000000000000000000000000000"
+
+	var (
+		fakeClient client.Client
+		digestSet  *configv1.ImageDigestMirrorSet
+		resolver   *MirrorResolver
+		changes    int
+	)
+
+	BeforeEach(func() {
+		digestSet = &configv1.ImageDigestMirrorSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "release"},
+			Spec: configv1.ImageDigestMirrorSetSpec{
+				ImageDigestMirrors: []configv1.ImageDigestMirrors{
+					{Source: "quay.io/openshift-release-dev", Mirrors: []configv1.ImageMirror{"mirror.example.com/release"}},
+					{
+						Source:             "quay.io/openshift-release-dev/ocp-release",
+						Mirrors:            []configv1.ImageMirror{"mirror.example.com/ocp-release", "backup.example.com/ocp-release"},
+						MirrorSourcePolicy: configv1.NeverContactSource,
+					},
+				},
+			},
+		}
+		tagSet := &configv1.ImageTagMirrorSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "tags"},
+			Spec: configv1.ImageTagMirrorSetSpec{
+				ImageTagMirrors: []configv1.ImageTagMirrors{
+					{Source: "registry.example.com/team", Mirrors: []configv1.ImageMirror{"mirror.example.com/team"}},
+				},
+			},
+		}
+		fakeClient = newFakeClient(digestSet, tagSet)
+
+		changes = 0
+		resolver = &MirrorResolver{
+			Client:   fakeClient,
+			OnChange: func(context.Context) { changes++ },
+		}
+		Expect(resolver.Load(ctx, fakeClient)).To(Succeed())
+	})
+
+	It("should use the most specific source for digest references", func() {
+		Expect(resolver.ResolveMirrors("quay.io/openshift-release-dev/ocp-release" + digest)).To(Equal([]string{
+			"mirror.example.com/ocp-release" + digest,
+			"backup.example.com/ocp-release" + digest,
+		}))
+
+		Expect(resolver.ResolveMirrors("quay.io/openshift-release-dev/ocp-v4.0-art-dev" + digest)).To(Equal([]string{
+			"mirror.example.com/release/ocp-v4.0-art-dev" + digest,
+			"quay.io/openshift-release-dev/ocp-v4.0-art-dev" + digest,
+		}))
+	})
+
+	It("should only use tag mirrors for tag references", func() {
+		Expect(resolver.ResolveMirrors("quay.io/openshift-release-dev/ocp-release:4.21.0")).To(Equal([]string{
+			"quay.io/openshift-release-dev/ocp-release:4.21.0",
+		}))
+
+		Expect(resolver.ResolveMirrors("registry.example.com/team/app:1.0")).To(Equal([]string{
+			"mirror.example.com/team/app:1.0",
+			"registry.example.com/team/app:1.0",
+		}))
+
+		Expect(resolver.ResolveMirrors("registry.example.com/teamwork/app:1.0")).To(Equal([]string{
+			"registry.example.com/teamwork/app:1.0",
+		}))
+	})
+
+	It("should rebuild the mapping when the mirror sets change", func() {
+		_, err := resolver.Reconcile(ctx, ctrl.Request{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(BeZero())
+
+		Expect(fakeClient.Delete(ctx, digestSet)).To(Succeed())
+
+		_, err = resolver.Reconcile(ctx, ctrl.Request{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(Equal(1))
+		Expect(resolver.ResolveMirrors("quay.io/openshift-release-dev/ocp-release" + digest)).To(Equal([]string{
+			"quay.io/openshift-release-dev/ocp-release" + digest,
+		}))
+	})
+})
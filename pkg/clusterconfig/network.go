@@ -0,0 +1,151 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	configv1 "github.com/openshift/api/config/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// NetworkConfig is the network configuration of the cluster, as deployed by the network operator.
+type NetworkConfig struct {
+	// ClusterNetworks are the CIDRs pod IPs are allocated from.
+	ClusterNetworks []string
+	// ServiceNetworks are the CIDRs service IPs are allocated from.
+	ServiceNetworks []string
+	// NetworkType is the network plugin, e.g. OVNKubernetes.
+	NetworkType string
+	// ClusterNetworkMTU is the MTU of the pod network.
+	ClusterNetworkMTU int
+}
+
+// Equal reports whether both configurations are the same.
+func (c NetworkConfig) Equal(other NetworkConfig) bool {
+	return slices.Equal(c.ClusterNetworks, other.ClusterNetworks) &&
+		slices.Equal(c.ServiceNetworks, other.ServiceNetworks) &&
+		c.NetworkType == other.NetworkType &&
+		c.ClusterNetworkMTU == other.ClusterNetworkMTU
+}
+
+// NewNetworkConfig returns the deployed network configuration from the status of a Network.
+func NewNetworkConfig(network *configv1.Network) NetworkConfig {
+	config := NetworkConfig{
+		ServiceNetworks:   slices.Clone(network.Status.ServiceNetwork),
+		NetworkType:       network.Status.NetworkType,
+		ClusterNetworkMTU: network.Status.ClusterNetworkMTU,
+	}
+
+	for _, entry := range network.Status.ClusterNetwork {
+		config.ClusterNetworks = append(config.ClusterNetworks, entry.CIDR)
+	}
+
+	return config
+}
+
+// NetworkWatcher watches the Network object and exposes the cluster and service CIDRs and the network plugin.
+//
+// Call Load before starting the manager so that the getters are usable immediately
+// and the callback is only invoked for actual changes.
+type NetworkWatcher struct {
+	client.Client
+
+	// OnChange is a function that will be called when the network configuration changes.
+	OnChange func(ctx context.Context, oldConfig, newConfig NetworkConfig)
+
+	mu     sync.RWMutex
+	config NetworkConfig
+}
+
+// Load reads the current Network using the given reader.
+// It is intended to be called with the manager's APIReader before the manager is started.
+func (r *NetworkWatcher) Load(ctx context.Context, reader client.Reader) error {
+	network := &configv1.Network{}
+	key := client.ObjectKey{Name: ClusterName}
+
+	if err := reader.Get(ctx, key, network); err != nil {
+		return fmt.Errorf("failed to get Network %q: %w", key.String(), err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.config = NewNetworkConfig(network)
+
+	return nil
+}
+
+// Config returns the last observed network configuration.
+func (r *NetworkWatcher) Config() NetworkConfig {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.config
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NetworkWatcher) SetupWithManager(mgr ctrl.Manager) error {
+	return setupSingletonWatcher(mgr, "networkwatcher", &configv1.Network{}, ClusterName, r)
+}
+
+// Reconcile records the current network configuration and invokes the callback when it has changed.
+func (r *NetworkWatcher) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx, "name", req.Name)
+
+	logger.V(1).Info("Reconciling Network")
+	defer logger.V(1).Info("Finished reconciling Network")
+
+	network := &configv1.Network{}
+	if err := r.Get(ctx, req.NamespacedName, network); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Keep the last observed configuration, the Network object is not expected to be deleted.
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get Network %s: %w", req.NamespacedName.String(), err)
+	}
+
+	newConfig := NewNetworkConfig(network)
+
+	r.mu.Lock()
+	oldConfig := r.config
+	r.config = newConfig
+	r.mu.Unlock()
+
+	if oldConfig.Equal(newConfig) {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("Network configuration changed",
+		"clusterNetworks", newConfig.ClusterNetworks,
+		"serviceNetworks", newConfig.ServiceNetworks,
+		"networkType", newConfig.NetworkType,
+	)
+
+	if r.OnChange != nil {
+		r.OnChange(ctx, oldConfig, newConfig)
+	}
+
+	return ctrl.Result{}, nil
+}
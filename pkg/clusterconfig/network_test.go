@@ -0,0 +1,86 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("NetworkWatcher", func() {
+	var (
+		fakeClient client.Client
+		network    *configv1.Network
+		watcher    *NetworkWatcher
+		changes    []NetworkConfig
+	)
+
+	req := ctrl.Request{NamespacedName: client.ObjectKey{Name: ClusterName}}
+
+	BeforeEach(func() {
+		network = &configv1.Network{
+			ObjectMeta: metav1.ObjectMeta{Name: ClusterName},
+			Status: configv1.NetworkStatus{
+				ClusterNetwork:    []configv1.ClusterNetworkEntry{{CIDR: "10.128.0.0/14", HostPrefix: 23}},
+				ServiceNetwork:    []string{"172.30.0.0/16"},
+				NetworkType:       "OVNKubernetes",
+				ClusterNetworkMTU: 1400,
+			},
+		}
+		fakeClient = newFakeClient(network)
+
+		changes = nil
+		watcher = &NetworkWatcher{
+			Client: fakeClient,
+			OnChange: func(_ context.Context, _, newConfig NetworkConfig) {
+				changes = append(changes, newConfig)
+			},
+		}
+	})
+
+	It("should expose the deployed network configuration", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+		Expect(watcher.Config()).To(Equal(NetworkConfig{
+			ClusterNetworks:   []string{"10.128.0.0/14"},
+			ServiceNetworks:   []string{"172.30.0.0/16"},
+			NetworkType:       "OVNKubernetes",
+			ClusterNetworkMTU: 1400,
+		}))
+	})
+
+	It("should only invoke the callback when the configuration changes", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+
+		_, err := watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+
+		network.Status.ServiceNetwork = append(network.Status.ServiceNetwork, "fd02::/112")
+		Expect(fakeClient.Status().Update(ctx, network)).To(Succeed())
+
+		_, err = watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(HaveLen(1))
+		Expect(changes[0].ServiceNetworks).To(ConsistOf("172.30.0.0/16", "fd02::/112"))
+	})
+})
@@ -0,0 +1,136 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	configv1 "github.com/openshift/api/config/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// NodeWatcher watches the cluster-wide Node configuration and surfaces the cgroup mode and worker latency profile,
+// so that operators can tune operand resource settings and probes to them.
+//
+// Call Load before starting the manager so that the getters are usable immediately
+// and the callbacks are only invoked for actual changes.
+type NodeWatcher struct {
+	client.Client
+
+	// OnCgroupModeChange is a function that will be called when the cgroup mode changes.
+	OnCgroupModeChange func(ctx context.Context, oldMode, newMode configv1.CgroupMode)
+
+	// OnWorkerLatencyProfileChange is a function that will be called when the worker latency profile changes.
+	OnWorkerLatencyProfileChange func(ctx context.Context, oldProfile, newProfile configv1.WorkerLatencyProfileType)
+
+	mu   sync.RWMutex
+	spec configv1.NodeSpec
+}
+
+// Load reads the current Node configuration using the given reader.
+// It is intended to be called with the manager's APIReader before the manager is started.
+func (r *NodeWatcher) Load(ctx context.Context, reader client.Reader) error {
+	node := &configv1.Node{}
+	key := client.ObjectKey{Name: ClusterName}
+
+	if err := reader.Get(ctx, key, node); err != nil {
+		return fmt.Errorf("failed to get Node %q: %w", key.String(), err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.spec = node.Spec
+
+	return nil
+}
+
+// CgroupMode returns the cgroup mode of the nodes. An empty mode means the mode set on the nodes is honored.
+func (r *NodeWatcher) CgroupMode() configv1.CgroupMode {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.spec.CgroupMode
+}
+
+// WorkerLatencyProfile returns the worker latency profile, defaulting to configv1.DefaultUpdateDefaultReaction.
+func (r *NodeWatcher) WorkerLatencyProfile() configv1.WorkerLatencyProfileType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return workerLatencyProfile(r.spec)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NodeWatcher) SetupWithManager(mgr ctrl.Manager) error {
+	return setupSingletonWatcher(mgr, "nodewatcher", &configv1.Node{}, ClusterName, r)
+}
+
+// Reconcile records the current Node configuration and invokes the callbacks for the fields that changed.
+func (r *NodeWatcher) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx, "name", req.Name)
+
+	logger.V(1).Info("Reconciling Node configuration")
+	defer logger.V(1).Info("Finished reconciling Node configuration")
+
+	node := &configv1.Node{}
+	if err := r.Get(ctx, req.NamespacedName, node); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Keep the last observed configuration, the Node object is not expected to be deleted.
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get Node %s: %w", req.NamespacedName.String(), err)
+	}
+
+	r.mu.Lock()
+	oldSpec := r.spec
+	r.spec = node.Spec
+	newSpec := r.spec
+	r.mu.Unlock()
+
+	if oldSpec.CgroupMode != newSpec.CgroupMode {
+		logger.Info("Cgroup mode changed", "old", oldSpec.CgroupMode, "new", newSpec.CgroupMode)
+
+		if r.OnCgroupModeChange != nil {
+			r.OnCgroupModeChange(ctx, oldSpec.CgroupMode, newSpec.CgroupMode)
+		}
+	}
+
+	if oldProfile, newProfile := workerLatencyProfile(oldSpec), workerLatencyProfile(newSpec); oldProfile != newProfile {
+		logger.Info("Worker latency profile changed", "old", oldProfile, "new", newProfile)
+
+		if r.OnWorkerLatencyProfileChange != nil {
+			r.OnWorkerLatencyProfileChange(ctx, oldProfile, newProfile)
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func workerLatencyProfile(spec configv1.NodeSpec) configv1.WorkerLatencyProfileType {
+	if spec.WorkerLatencyProfile == "" {
+		return configv1.DefaultUpdateDefaultReaction
+	}
+
+	return spec.WorkerLatencyProfile
+}
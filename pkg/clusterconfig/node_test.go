@@ -0,0 +1,92 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("NodeWatcher", func() {
+	var (
+		fakeClient     client.Client
+		node           *configv1.Node
+		watcher        *NodeWatcher
+		cgroupChanges  []configv1.CgroupMode
+		profileChanges []configv1.WorkerLatencyProfileType
+	)
+
+	req := ctrl.Request{NamespacedName: client.ObjectKey{Name: ClusterName}}
+
+	BeforeEach(func() {
+		node = &configv1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: ClusterName},
+			Spec:       configv1.NodeSpec{CgroupMode: configv1.CgroupModeV2},
+		}
+		fakeClient = newFakeClient(node)
+
+		cgroupChanges, profileChanges = nil, nil
+		watcher = &NodeWatcher{
+			Client: fakeClient,
+			OnCgroupModeChange: func(_ context.Context, _, newMode configv1.CgroupMode) {
+				cgroupChanges = append(cgroupChanges, newMode)
+			},
+			OnWorkerLatencyProfileChange: func(_ context.Context, _, newProfile configv1.WorkerLatencyProfileType) {
+				profileChanges = append(profileChanges, newProfile)
+			},
+		}
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+	})
+
+	It("should expose the node configuration with defaults", func() {
+		Expect(watcher.CgroupMode()).To(Equal(configv1.CgroupModeV2))
+		Expect(watcher.WorkerLatencyProfile()).To(Equal(configv1.DefaultUpdateDefaultReaction))
+	})
+
+	It("should only invoke the callbacks of the fields that changed", func() {
+		node.Spec.WorkerLatencyProfile = configv1.DefaultUpdateDefaultReaction
+		Expect(fakeClient.Update(ctx, node)).To(Succeed())
+
+		_, err := watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(profileChanges).To(BeEmpty(), "an explicit default is not a change")
+
+		node.Spec.WorkerLatencyProfile = configv1.MediumUpdateAverageReaction
+		Expect(fakeClient.Update(ctx, node)).To(Succeed())
+
+		_, err = watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(profileChanges).To(Equal([]configv1.WorkerLatencyProfileType{configv1.MediumUpdateAverageReaction}))
+		Expect(cgroupChanges).To(BeEmpty())
+		Expect(watcher.WorkerLatencyProfile()).To(Equal(configv1.MediumUpdateAverageReaction))
+	})
+
+	It("should keep the configuration when the Node object is deleted", func() {
+		Expect(fakeClient.Delete(ctx, node)).To(Succeed())
+
+		_, err := watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(watcher.CgroupMode()).To(Equal(configv1.CgroupModeV2))
+		Expect(cgroupChanges).To(BeEmpty())
+	})
+})
@@ -0,0 +1,177 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"golang.org/x/net/http/httpproxy"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// ProxyWatcher watches the cluster-wide Proxy object and exposes the effective proxy configuration
+// from its status, for outbound HTTP clients.
+//
+// For clients to be fully proxy-correct, they also need to trust the proxy's CA. Combine ConfigureTransport
+// with certs.CABundleWatcher.ConfigureTransport, watching a ConfigMap labelled with
+// certs.InjectTrustedCABundleLabel, into which the cluster injects the trusted CA bundle of the proxy.
+type ProxyWatcher struct {
+	client.Client
+
+	// UpdateEnvironment also sets the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables of the process,
+	// and their lowercase variants, for libraries that only read them.
+	UpdateEnvironment bool
+
+	// OnChange is a function that will be called when the proxy configuration changes.
+	OnChange func(ctx context.Context, oldStatus, newStatus configv1.ProxyStatus)
+
+	mu        sync.RWMutex
+	status    configv1.ProxyStatus
+	proxyFunc func(*url.URL) (*url.URL, error)
+}
+
+// Load reads the current Proxy using the given reader.
+// It is intended to be called with the manager's APIReader before the manager is started.
+func (r *ProxyWatcher) Load(ctx context.Context, reader client.Reader) error {
+	proxy := &configv1.Proxy{}
+	key := client.ObjectKey{Name: ClusterName}
+
+	if err := reader.Get(ctx, key, proxy); err != nil {
+		return fmt.Errorf("failed to get Proxy %q: %w", key.String(), err)
+	}
+
+	return r.setStatus(proxy.Status)
+}
+
+// Status returns the last observed proxy configuration.
+func (r *ProxyWatcher) Status() configv1.ProxyStatus {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.status
+}
+
+// ProxyFunc returns a function usable as http.Transport.Proxy, which resolves the proxy of each request
+// with the configuration at the time of the request.
+func (r *ProxyWatcher) ProxyFunc() func(*http.Request) (*url.URL, error) {
+	return func(req *http.Request) (*url.URL, error) {
+		r.mu.RLock()
+		proxyFunc := r.proxyFunc
+		r.mu.RUnlock()
+
+		if proxyFunc == nil {
+			return nil, nil
+		}
+
+		return proxyFunc(req.URL)
+	}
+}
+
+// ConfigureTransport sets the transport to use the cluster-wide proxy.
+func (r *ProxyWatcher) ConfigureTransport(transport *http.Transport) {
+	transport.Proxy = r.ProxyFunc()
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ProxyWatcher) SetupWithManager(mgr ctrl.Manager) error {
+	return setupSingletonWatcher(mgr, "proxywatcher", &configv1.Proxy{}, ClusterName, r)
+}
+
+// Reconcile records the current proxy configuration and invokes the callback when it has changed.
+func (r *ProxyWatcher) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx, "name", req.Name)
+
+	logger.V(1).Info("Reconciling Proxy")
+	defer logger.V(1).Info("Finished reconciling Proxy")
+
+	proxy := &configv1.Proxy{}
+	if err := r.Get(ctx, req.NamespacedName, proxy); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Keep the last observed configuration, the Proxy object is not expected to be deleted.
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get Proxy %s: %w", req.NamespacedName.String(), err)
+	}
+
+	oldStatus := r.Status()
+	if oldStatus == proxy.Status {
+		return ctrl.Result{}, nil
+	}
+
+	if err := r.setStatus(proxy.Status); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	logger.Info("Proxy configuration changed")
+
+	if r.OnChange != nil {
+		r.OnChange(ctx, oldStatus, proxy.Status)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *ProxyWatcher) setStatus(status configv1.ProxyStatus) error {
+	config := &httpproxy.Config{
+		HTTPProxy:  status.HTTPProxy,
+		HTTPSProxy: status.HTTPSProxy,
+		NoProxy:    status.NoProxy,
+	}
+
+	r.mu.Lock()
+	r.status = status
+	r.proxyFunc = config.ProxyFunc()
+	r.mu.Unlock()
+
+	if !r.UpdateEnvironment {
+		return nil
+	}
+
+	for _, env := range []struct {
+		names []string
+		value string
+	}{
+		{[]string{"HTTP_PROXY", "http_proxy"}, status.HTTPProxy},
+		{[]string{"HTTPS_PROXY", "https_proxy"}, status.HTTPSProxy},
+		{[]string{"NO_PROXY", "no_proxy"}, status.NoProxy},
+	} {
+		for _, name := range env.names {
+			var err error
+			if env.value == "" {
+				err = os.Unsetenv(name)
+			} else {
+				err = os.Setenv(name, env.value)
+			}
+
+			if err != nil {
+				return fmt.Errorf("failed to update environment variable %s: %w", name, err)
+			}
+		}
+	}
+
+	return nil
+}
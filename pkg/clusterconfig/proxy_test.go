@@ -0,0 +1,124 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+	"net/http"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("ProxyWatcher", func() {
+	var (
+		fakeClient client.Client
+		proxy      *configv1.Proxy
+		watcher    *ProxyWatcher
+		changes    []configv1.ProxyStatus
+	)
+
+	req := ctrl.Request{NamespacedName: client.ObjectKey{Name: ClusterName}}
+
+	proxyFor := func(rawURL string) string {
+		GinkgoHelper()
+
+		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		proxyURL, err := watcher.ProxyFunc()(httpReq)
+		Expect(err).NotTo(HaveOccurred())
+
+		if proxyURL == nil {
+			return ""
+		}
+
+		return proxyURL.String()
+	}
+
+	BeforeEach(func() {
+		proxy = &configv1.Proxy{
+			ObjectMeta: metav1.ObjectMeta{Name: ClusterName},
+			Status: configv1.ProxyStatus{
+				HTTPProxy:  "http://proxy.example.com:3128",
+				HTTPSProxy: "http://proxy.example.com:3128",
+				NoProxy:    ".cluster.local,.svc",
+			},
+		}
+		fakeClient = newFakeClient(proxy)
+
+		changes = nil
+		watcher = &ProxyWatcher{
+			Client: fakeClient,
+			OnChange: func(_ context.Context, _, newStatus configv1.ProxyStatus) {
+				changes = append(changes, newStatus)
+			},
+		}
+	})
+
+	It("should not proxy anything before the configuration is loaded", func() {
+		Expect(proxyFor("https://api.example.com")).To(BeEmpty())
+	})
+
+	It("should proxy requests according to the loaded configuration", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+
+		Expect(proxyFor("https://api.example.com")).To(Equal("http://proxy.example.com:3128"))
+		Expect(proxyFor("https://operator.namespace.svc")).To(BeEmpty())
+
+		transport := &http.Transport{}
+		watcher.ConfigureTransport(transport)
+		Expect(transport.Proxy).NotTo(BeNil())
+	})
+
+	It("should pick up changes and invoke the callback", func() {
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+
+		_, err := watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+
+		proxy.Status = configv1.ProxyStatus{}
+		Expect(fakeClient.Status().Update(ctx, proxy)).To(Succeed())
+
+		_, err = watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(HaveLen(1))
+		Expect(proxyFor("https://api.example.com")).To(BeEmpty())
+	})
+
+	It("should update the environment when requested", func() {
+		for _, name := range []string{"HTTP_PROXY", "http_proxy", "HTTPS_PROXY", "https_proxy", "NO_PROXY", "no_proxy"} {
+			if value, ok := os.LookupEnv(name); ok {
+				DeferCleanup(os.Setenv, name, value)
+			} else {
+				DeferCleanup(os.Unsetenv, name)
+			}
+		}
+
+		watcher.UpdateEnvironment = true
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+
+		Expect(os.Getenv("HTTPS_PROXY")).To(Equal("http://proxy.example.com:3128"))
+		Expect(os.Getenv("no_proxy")).To(Equal(".cluster.local,.svc"))
+	})
+})
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	configv1 "github.com/openshift/api/config/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// SchedulerWatcher watches the Scheduler configuration and surfaces the default node selector and whether
+// control plane nodes are schedulable, so that operators creating workloads can re-render pod specs.
+//
+// Call Load before starting the manager so that the getters are usable immediately
+// and the callbacks are only invoked for actual changes.
+type SchedulerWatcher struct {
+	client.Client
+
+	// OnDefaultNodeSelectorChange is a function that will be called when the default node selector changes.
+	OnDefaultNodeSelectorChange func(ctx context.Context, oldSelector, newSelector string)
+
+	// OnMastersSchedulableChange is a function that will be called when the schedulability of control plane nodes changes.
+	OnMastersSchedulableChange func(ctx context.Context, mastersSchedulable bool)
+
+	mu   sync.RWMutex
+	spec configv1.SchedulerSpec
+}
+
+// Load reads the current Scheduler configuration using the given reader.
+// It is intended to be called with the manager's APIReader before the manager is started.
+func (r *SchedulerWatcher) Load(ctx context.Context, reader client.Reader) error {
+	scheduler := &configv1.Scheduler{}
+	key := client.ObjectKey{Name: ClusterName}
+
+	if err := reader.Get(ctx, key, scheduler); err != nil {
+		return fmt.Errorf("failed to get Scheduler %q: %w", key.String(), err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.spec = *scheduler.Spec.DeepCopy()
+
+	return nil
+}
+
+// DefaultNodeSelector returns the cluster-wide default node selector, e.g. "type=user-node,region=east".
+func (r *SchedulerWatcher) DefaultNodeSelector() string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.spec.DefaultNodeSelector
+}
+
+// DefaultNodeSelectorLabels returns the default node selector as a map suitable for a pod's nodeSelector.
+func (r *SchedulerWatcher) DefaultNodeSelectorLabels() (map[string]string, error) {
+	selector := r.DefaultNodeSelector()
+	if selector == "" {
+		return nil, nil
+	}
+
+	set, err := labels.ConvertSelectorToLabelsMap(selector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse default node selector %q: %w", selector, err)
+	}
+
+	return set, nil
+}
+
+// MastersSchedulable reports whether workloads can run on control plane nodes.
+func (r *SchedulerWatcher) MastersSchedulable() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.spec.MastersSchedulable
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *SchedulerWatcher) SetupWithManager(mgr ctrl.Manager) error {
+	return setupSingletonWatcher(mgr, "schedulerwatcher", &configv1.Scheduler{}, ClusterName, r)
+}
+
+// Reconcile records the current Scheduler configuration and invokes the callbacks for the fields that changed.
+func (r *SchedulerWatcher) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx, "name", req.Name)
+
+	logger.V(1).Info("Reconciling Scheduler")
+	defer logger.V(1).Info("Finished reconciling Scheduler")
+
+	scheduler := &configv1.Scheduler{}
+	if err := r.Get(ctx, req.NamespacedName, scheduler); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Keep the last observed configuration, the Scheduler object is not expected to be deleted.
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get Scheduler %s: %w", req.NamespacedName.String(), err)
+	}
+
+	r.mu.Lock()
+	oldSpec := r.spec
+	r.spec = *scheduler.Spec.DeepCopy()
+	newSpec := r.spec
+	r.mu.Unlock()
+
+	if oldSpec.DefaultNodeSelector != newSpec.DefaultNodeSelector {
+		logger.Info("Default node selector changed", "old", oldSpec.DefaultNodeSelector, "new", newSpec.DefaultNodeSelector)
+
+		if r.OnDefaultNodeSelectorChange != nil {
+			r.OnDefaultNodeSelectorChange(ctx, oldSpec.DefaultNodeSelector, newSpec.DefaultNodeSelector)
+		}
+	}
+
+	if oldSpec.MastersSchedulable != newSpec.MastersSchedulable {
+		logger.Info("Masters schedulable changed", "mastersSchedulable", newSpec.MastersSchedulable)
+
+		if r.OnMastersSchedulableChange != nil {
+			r.OnMastersSchedulableChange(ctx, newSpec.MastersSchedulable)
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
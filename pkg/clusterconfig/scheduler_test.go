@@ -0,0 +1,84 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("SchedulerWatcher", func() {
+	var (
+		fakeClient       client.Client
+		scheduler        *configv1.Scheduler
+		watcher          *SchedulerWatcher
+		selectorChanges  []string
+		schedulableFlips []bool
+	)
+
+	req := ctrl.Request{NamespacedName: client.ObjectKey{Name: ClusterName}}
+
+	BeforeEach(func() {
+		scheduler = &configv1.Scheduler{
+			ObjectMeta: metav1.ObjectMeta{Name: ClusterName},
+			Spec:       configv1.SchedulerSpec{DefaultNodeSelector: "type=user-node,region=east"},
+		}
+		fakeClient = newFakeClient(scheduler)
+
+		selectorChanges, schedulableFlips = nil, nil
+		watcher = &SchedulerWatcher{
+			Client: fakeClient,
+			OnDefaultNodeSelectorChange: func(_ context.Context, _, newSelector string) {
+				selectorChanges = append(selectorChanges, newSelector)
+			},
+			OnMastersSchedulableChange: func(_ context.Context, mastersSchedulable bool) {
+				schedulableFlips = append(schedulableFlips, mastersSchedulable)
+			},
+		}
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+	})
+
+	It("should expose the default node selector", func() {
+		Expect(watcher.DefaultNodeSelector()).To(Equal("type=user-node,region=east"))
+		Expect(watcher.DefaultNodeSelectorLabels()).To(Equal(map[string]string{"type": "user-node", "region": "east"}))
+		Expect(watcher.MastersSchedulable()).To(BeFalse())
+	})
+
+	It("should invoke the callbacks of the fields that changed", func() {
+		scheduler.Spec.MastersSchedulable = true
+		Expect(fakeClient.Update(ctx, scheduler)).To(Succeed())
+
+		_, err := watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(schedulableFlips).To(Equal([]bool{true}))
+		Expect(selectorChanges).To(BeEmpty())
+
+		scheduler.Spec.DefaultNodeSelector = ""
+		Expect(fakeClient.Update(ctx, scheduler)).To(Succeed())
+
+		_, err = watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(selectorChanges).To(Equal([]string{""}))
+		Expect(watcher.DefaultNodeSelectorLabels()).To(BeNil())
+	})
+})
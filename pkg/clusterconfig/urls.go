@@ -0,0 +1,220 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/go-logr/logr"
+	configv1 "github.com/openshift/api/config/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// OAuthMetadataPath is the path of the OAuth authorization server metadata served by the kube-apiserver.
+const OAuthMetadataPath = "/.well-known/oauth-authorization-server"
+
+// OAuthMetadata is the OAuth authorization server metadata of the cluster, as defined in RFC 8414.
+type OAuthMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+}
+
+// ClusterURLs are the user facing URLs of the cluster.
+type ClusterURLs struct {
+	// ConsoleURL is the URL of the web console, empty when the console is not installed.
+	ConsoleURL string
+	// OAuth is the metadata of the integrated OAuth server, empty when it is not used, e.g. with external OIDC.
+	OAuth OAuthMetadata
+}
+
+// FetchOAuthMetadata fetches the OAuth authorization server metadata from the kube-apiserver.
+// It returns empty metadata when the cluster does not serve it, e.g. when the integrated OAuth server is disabled.
+func FetchOAuthMetadata(ctx context.Context, restClient rest.Interface) (OAuthMetadata, error) {
+	body, err := restClient.Get().AbsPath(OAuthMetadataPath).DoRaw(ctx)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return OAuthMetadata{}, nil
+		}
+
+		return OAuthMetadata{}, fmt.Errorf("failed to get %s: %w", OAuthMetadataPath, err)
+	}
+
+	metadata := OAuthMetadata{}
+	if err := json.Unmarshal(body, &metadata); err != nil {
+		return OAuthMetadata{}, fmt.Errorf("failed to parse %s: %w", OAuthMetadataPath, err)
+	}
+
+	return metadata, nil
+}
+
+// ClusterURLWatcher discovers the console URL from the Console config and the OAuth endpoints from the
+// kube-apiserver well-known metadata, and keeps them cached for operators that embed links or need token endpoints.
+// Both are refreshed when the Console or Authentication config changes.
+//
+// Call Load after SetupWithManager and before starting the manager so that the getters are usable immediately
+// and the callback is only invoked for actual changes.
+type ClusterURLWatcher struct {
+	client.Client
+
+	// RESTClient is used to fetch the OAuth metadata from the kube-apiserver.
+	// Defaults to a client built from the manager's configuration.
+	RESTClient rest.Interface
+
+	// OnChange is a function that will be called when any of the URLs changes.
+	OnChange func(ctx context.Context, oldURLs, newURLs ClusterURLs)
+
+	mu   sync.RWMutex
+	urls ClusterURLs
+}
+
+// Load discovers the current URLs using the given reader.
+// It is intended to be called with the manager's APIReader before the manager is started.
+func (r *ClusterURLWatcher) Load(ctx context.Context, reader client.Reader) error {
+	urls, err := r.discover(ctx, reader)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.urls = urls
+
+	return nil
+}
+
+// URLs returns the last discovered URLs.
+func (r *ClusterURLWatcher) URLs() ClusterURLs {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.urls
+}
+
+// ConsoleURL returns the URL of the web console, or an empty string when the console is not installed.
+func (r *ClusterURLWatcher) ConsoleURL() string {
+	return r.URLs().ConsoleURL
+}
+
+// TokenEndpoint returns the token endpoint of the integrated OAuth server, or an empty string when it is not used.
+func (r *ClusterURLWatcher) TokenEndpoint() string {
+	return r.URLs().OAuth.TokenEndpoint
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ClusterURLWatcher) SetupWithManager(mgr ctrl.Manager) error {
+	if r.RESTClient == nil {
+		discoveryClient, err := discovery.NewDiscoveryClientForConfigAndClient(mgr.GetConfig(), mgr.GetHTTPClient())
+		if err != nil {
+			return fmt.Errorf("failed to create REST client: %w", err)
+		}
+
+		r.RESTClient = discoveryClient.RESTClient()
+	}
+
+	isCluster := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetName() == ClusterName
+	})
+
+	if err := ctrl.NewControllerManagedBy(mgr).
+		Named("clusterurlwatcher").
+		WithOptions(controller.Options{NeedLeaderElection: ptr.To(false)}).
+		For(&configv1.Console{}, builder.WithPredicates(isCluster)).
+		// The OAuth server is enabled or disabled through the Authentication config.
+		Watches(&configv1.Authentication{}, &handler.EnqueueRequestForObject{}, builder.WithPredicates(isCluster)).
+		// Override the default log constructor as it makes the logs very chatty.
+		WithLogConstructor(func(_ *reconcile.Request) logr.Logger {
+			return mgr.GetLogger().WithValues(
+				"controller", "clusterurlwatcher",
+			)
+		}).
+		Complete(r); err != nil {
+		return fmt.Errorf("could not set up controller for cluster URL watcher: %w", err)
+	}
+
+	return nil
+}
+
+// Reconcile rediscovers the URLs and invokes the callback when they have changed.
+func (r *ClusterURLWatcher) Reconcile(ctx context.Context, _ ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	logger.V(1).Info("Reconciling cluster URLs")
+	defer logger.V(1).Info("Finished reconciling cluster URLs")
+
+	newURLs, err := r.discover(ctx, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	r.mu.Lock()
+	oldURLs := r.urls
+	r.urls = newURLs
+	r.mu.Unlock()
+
+	if oldURLs == newURLs {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("Cluster URLs changed", "consoleURL", newURLs.ConsoleURL, "oauthIssuer", newURLs.OAuth.Issuer)
+
+	if r.OnChange != nil {
+		r.OnChange(ctx, oldURLs, newURLs)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func (r *ClusterURLWatcher) discover(ctx context.Context, reader client.Reader) (ClusterURLs, error) {
+	urls := ClusterURLs{}
+
+	console := &configv1.Console{}
+	if err := reader.Get(ctx, client.ObjectKey{Name: ClusterName}, console); err != nil {
+		// The console is an optional capability.
+		if !apierrors.IsNotFound(err) {
+			return ClusterURLs{}, fmt.Errorf("failed to get Console %q: %w", ClusterName, err)
+		}
+	} else {
+		urls.ConsoleURL = console.Status.ConsoleURL
+	}
+
+	if r.RESTClient != nil {
+		metadata, err := FetchOAuthMetadata(ctx, r.RESTClient)
+		if err != nil {
+			return ClusterURLs{}, err
+		}
+
+		urls.OAuth = metadata
+	}
+
+	return urls, nil
+}
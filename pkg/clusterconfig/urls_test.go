@@ -0,0 +1,111 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterconfig
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("ClusterURLWatcher", func() {
+	var (
+		fakeClient client.Client
+		console    *configv1.Console
+		server     *httptest.Server
+		metadata   atomic.Pointer[string]
+		watcher    *ClusterURLWatcher
+		changes    []ClusterURLs
+	)
+
+	req := ctrl.Request{NamespacedName: client.ObjectKey{Name: ClusterName}}
+
+	BeforeEach(func() {
+		metadata.Store(ptr.To(`{"issuer":"https://oauth.apps.example.com","authorization_endpoint":"https://oauth.apps.example.com/oauth/authorize","token_endpoint":"https://oauth.apps.example.com/oauth/token"}`))
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body := metadata.Load()
+			if r.URL.Path != OAuthMetadataPath || body == nil {
+				http.NotFound(w, r)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(*body))
+		}))
+		DeferCleanup(server.Close)
+
+		discoveryClient, err := discovery.NewDiscoveryClientForConfig(&rest.Config{Host: server.URL})
+		Expect(err).NotTo(HaveOccurred())
+
+		console = &configv1.Console{
+			ObjectMeta: metav1.ObjectMeta{Name: ClusterName},
+			Status:     configv1.ConsoleStatus{ConsoleURL: "https://console.apps.example.com"},
+		}
+		fakeClient = newFakeClient(console)
+
+		changes = nil
+		watcher = &ClusterURLWatcher{
+			Client:     fakeClient,
+			RESTClient: discoveryClient.RESTClient(),
+			OnChange: func(_ context.Context, _, newURLs ClusterURLs) {
+				changes = append(changes, newURLs)
+			},
+		}
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+	})
+
+	It("should discover the console URL and the OAuth endpoints", func() {
+		Expect(watcher.ConsoleURL()).To(Equal("https://console.apps.example.com"))
+		Expect(watcher.TokenEndpoint()).To(Equal("https://oauth.apps.example.com/oauth/token"))
+		Expect(watcher.URLs().OAuth.Issuer).To(Equal("https://oauth.apps.example.com"))
+	})
+
+	It("should clear the OAuth endpoints when the cluster stops serving them", func() {
+		metadata.Store(nil)
+
+		_, err := watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(HaveLen(1))
+		Expect(changes[0].OAuth).To(BeZero())
+		Expect(changes[0].ConsoleURL).To(Equal("https://console.apps.example.com"))
+	})
+
+	It("should only invoke the callback when the URLs change", func() {
+		_, err := watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+
+		console.Status.ConsoleURL = "https://console.apps.new.example.com"
+		Expect(fakeClient.Status().Update(ctx, console)).To(Succeed())
+
+		_, err = watcher.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(HaveLen(1))
+		Expect(watcher.ConsoleURL()).To(Equal("https://console.apps.new.example.com"))
+	})
+})
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusteroperator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	configv1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/flowcontrol"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// DefaultEventQPS is the default rate of the events recorded for condition changes, one per minute.
+	DefaultEventQPS = 1.0 / 60
+
+	// DefaultEventBurst is the default number of events for condition changes recorded at once.
+	DefaultEventBurst = 10
+
+	// EventReasonConditionChanged is the reason of the events recorded for condition changes.
+	EventReasonConditionChanged = "ClusterOperatorConditionChanged"
+)
+
+// reportChanges logs and records an event for each managed condition whose status or reason changed from before.
+// Events beyond the rate limit are dropped, the changes are always logged.
+func (w *StatusWriter) reportChanges(ctx context.Context, co *configv1.ClusterOperator, before []configv1.ClusterOperatorStatusCondition) {
+	logger := log.FromContext(ctx).WithValues("clusteroperator", co.Name)
+
+	for _, conditionType := range conditionTypes {
+		oldCondition := findCondition(before, conditionType)
+		newCondition := findCondition(co.Status.Conditions, conditionType)
+
+		if newCondition == nil || (oldCondition != nil &&
+			oldCondition.Status == newCondition.Status && oldCondition.Reason == newCondition.Reason) {
+			continue
+		}
+
+		oldStatus, oldReason := configv1.ConditionUnknown, ""
+		if oldCondition != nil {
+			oldStatus, oldReason = oldCondition.Status, oldCondition.Reason
+		}
+
+		logger.Info("ClusterOperator condition changed",
+			"type", conditionType,
+			"oldStatus", oldStatus, "oldReason", oldReason,
+			"newStatus", newCondition.Status, "newReason", newCondition.Reason,
+			"message", newCondition.Message)
+
+		if w.Recorder == nil || !w.eventRateLimiter().TryAccept() {
+			continue
+		}
+
+		note := fmt.Sprintf("%s changed from %s (%s) to %s (%s)",
+			conditionType, oldStatus, oldReason, newCondition.Status, newCondition.Reason)
+		if newCondition.Message != "" {
+			note += ": " + newCondition.Message
+		}
+
+		w.Recorder.Eventf(co, nil, eventType(*newCondition), EventReasonConditionChanged, "UpdateStatus", "%s", note)
+	}
+}
+
+// eventRateLimiter returns the rate limiter of events, creating the default one on first use.
+func (w *StatusWriter) eventRateLimiter() flowcontrol.RateLimiter {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.EventRateLimiter == nil {
+		w.EventRateLimiter = flowcontrol.NewTokenBucketRateLimiter(DefaultEventQPS, DefaultEventBurst)
+	}
+
+	return w.EventRateLimiter
+}
+
+// eventType returns Warning for conditions reporting a problem, and Normal otherwise.
+func eventType(condition configv1.ClusterOperatorStatusCondition) string {
+	switch {
+	case condition.Type == configv1.OperatorDegraded && condition.Status == configv1.ConditionTrue,
+		condition.Type == configv1.OperatorAvailable && condition.Status == configv1.ConditionFalse:
+		return corev1.EventTypeWarning
+	default:
+		return corev1.EventTypeNormal
+	}
+}
+
+// findCondition returns the condition of the given type, or nil.
+func findCondition(conditions []configv1.ClusterOperatorStatusCondition, conditionType configv1.ClusterStatusConditionType) *configv1.ClusterOperatorStatusCondition {
+	i := slices.IndexFunc(conditions, func(c configv1.ClusterOperatorStatusCondition) bool { return c.Type == conditionType })
+	if i < 0 {
+		return nil
+	}
+
+	return &conditions[i]
+}
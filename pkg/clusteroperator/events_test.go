@@ -0,0 +1,71 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusteroperator
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+var _ = Describe("StatusWriter events", func() {
+	var (
+		recorder *events.FakeRecorder
+		writer   *StatusWriter
+	)
+
+	BeforeEach(func() {
+		recorder = events.NewFakeRecorder(10)
+		writer = &StatusWriter{Client: newFakeClient(), Name: "my-operator", Recorder: recorder}
+	})
+
+	It("should record an event for each condition change", func() {
+		writer.SetAvailable(true, "AsExpected", "")
+		writer.SetDegraded(false, "AsExpected", "")
+		Expect(writer.Sync(ctx)).To(Succeed())
+		Expect(recorder.Events).To(HaveLen(2))
+		Expect(<-recorder.Events).To(Equal("Normal ClusterOperatorConditionChanged Available changed from Unknown () to True (AsExpected)"))
+		<-recorder.Events
+
+		writer.SetDegraded(true, "Broken", "The operand crashed")
+		Expect(writer.Sync(ctx)).To(Succeed())
+		Expect(recorder.Events).To(HaveLen(1))
+		Expect(<-recorder.Events).To(Equal(
+			"Warning ClusterOperatorConditionChanged Degraded changed from False (AsExpected) to True (Broken): The operand crashed"))
+	})
+
+	It("should not record events for unchanged conditions", func() {
+		writer.SetAvailable(true, "AsExpected", "")
+		Expect(writer.Sync(ctx)).To(Succeed())
+		<-recorder.Events
+
+		writer.SetAvailable(true, "AsExpected", "Only the message changed")
+		Expect(writer.Sync(ctx)).To(Succeed())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should drop events beyond the rate limit", func() {
+		writer.EventRateLimiter = flowcontrol.NewTokenBucketRateLimiter(0.001, 1)
+
+		writer.SetAvailable(true, "AsExpected", "")
+		writer.SetDegraded(false, "AsExpected", "")
+		writer.SetProgressing(false, "AsExpected", "")
+		Expect(writer.Sync(ctx)).To(Succeed())
+		Expect(recorder.Events).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,102 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusteroperator
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// RelatedObjects is a registry of the objects an operator manages, which must-gather collects when they are listed
+// in the relatedObjects of the ClusterOperator. Controllers register the objects they manage, from any goroutine,
+// and the registry lists them deduplicated and sorted so that the status does not change with registration order.
+//
+// Example:
+//
+//	relatedObjects := &clusteroperator.RelatedObjects{}
+//	relatedObjects.Register(corev1.SchemeGroupVersion.WithResource("namespaces"), "", "openshift-my-operator")
+//	writer := &clusteroperator.StatusWriter{Client: mgr.GetClient(), Name: "my-operator", RelatedObjects: relatedObjects}
+type RelatedObjects struct {
+	mu      sync.RWMutex
+	objects map[configv1.ObjectReference]struct{}
+}
+
+// Register adds the object with the given resource, namespace and name. The namespace is empty for cluster-scoped
+// objects, and the name may be empty to collect all the objects of the resource in the namespace.
+// The version of the resource is not part of the reference.
+func (r *RelatedObjects) Register(gvr schema.GroupVersionResource, namespace, name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.objects == nil {
+		r.objects = map[configv1.ObjectReference]struct{}{}
+	}
+
+	r.objects[objectReference(gvr, namespace, name)] = struct{}{}
+}
+
+// Unregister removes the object with the given resource, namespace and name.
+func (r *RelatedObjects) Unregister(gvr schema.GroupVersionResource, namespace, name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.objects, objectReference(gvr, namespace, name))
+}
+
+// List returns the registered objects, sorted.
+func (r *RelatedObjects) List() []configv1.ObjectReference {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	objects := make([]configv1.ObjectReference, 0, len(r.objects))
+	for object := range r.objects {
+		objects = append(objects, object)
+	}
+
+	sortObjectReferences(objects)
+
+	return objects
+}
+
+// objectReference returns the reference of the object with the given resource, namespace and name.
+func objectReference(gvr schema.GroupVersionResource, namespace, name string) configv1.ObjectReference {
+	return configv1.ObjectReference{Group: gvr.Group, Resource: gvr.Resource, Namespace: namespace, Name: name}
+}
+
+// sortObjectReferences sorts references by group, resource, namespace and name.
+func sortObjectReferences(objects []configv1.ObjectReference) {
+	slices.SortFunc(objects, func(a, b configv1.ObjectReference) int {
+		return cmp.Or(
+			cmp.Compare(a.Group, b.Group),
+			cmp.Compare(a.Resource, b.Resource),
+			cmp.Compare(a.Namespace, b.Namespace),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+}
+
+// mergeObjectReferences returns the deduplicated and sorted references of both lists.
+func mergeObjectReferences(a, b []configv1.ObjectReference) []configv1.ObjectReference {
+	merged := slices.Concat(a, b)
+	sortObjectReferences(merged)
+
+	return slices.Compact(merged)
+}
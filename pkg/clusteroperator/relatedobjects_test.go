@@ -0,0 +1,69 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusteroperator
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("RelatedObjects", func() {
+	namespaces := corev1.SchemeGroupVersion.WithResource("namespaces")
+	deployments := appsv1.SchemeGroupVersion.WithResource("deployments")
+
+	It("should list registered objects deduplicated and sorted", func() {
+		relatedObjects := &RelatedObjects{}
+		relatedObjects.Register(deployments, "openshift-my-operator", "operand")
+		relatedObjects.Register(namespaces, "", "openshift-my-operator")
+		relatedObjects.Register(deployments, "openshift-my-operator", "operand")
+		relatedObjects.Register(deployments, "openshift-my-operator", "another")
+
+		Expect(relatedObjects.List()).To(Equal([]configv1.ObjectReference{
+			{Resource: "namespaces", Name: "openshift-my-operator"},
+			{Group: "apps", Resource: "deployments", Namespace: "openshift-my-operator", Name: "another"},
+			{Group: "apps", Resource: "deployments", Namespace: "openshift-my-operator", Name: "operand"},
+		}))
+
+		relatedObjects.Unregister(deployments, "openshift-my-operator", "another")
+		Expect(relatedObjects.List()).To(HaveLen(2))
+	})
+
+	It("should be written along with the related objects set on the StatusWriter", func() {
+		fakeClient := newFakeClient()
+		relatedObjects := &RelatedObjects{}
+		writer := &StatusWriter{Client: fakeClient, Name: "my-operator", RelatedObjects: relatedObjects}
+
+		writer.SetRelatedObjects(
+			configv1.ObjectReference{Resource: "namespaces", Name: "openshift-my-operator"},
+			configv1.ObjectReference{Resource: "namespaces", Name: "openshift-my-operator"},
+		)
+		relatedObjects.Register(deployments, "openshift-my-operator", "operand")
+		relatedObjects.Register(namespaces, "", "openshift-my-operator")
+		Expect(writer.Sync(ctx)).To(Succeed())
+
+		co := &configv1.ClusterOperator{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "my-operator"}, co)).To(Succeed())
+		Expect(co.Status.RelatedObjects).To(Equal([]configv1.ObjectReference{
+			{Resource: "namespaces", Name: "openshift-my-operator"},
+			{Group: "apps", Resource: "deployments", Namespace: "openshift-my-operator", Name: "operand"},
+		}))
+	})
+})
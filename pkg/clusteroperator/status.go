@@ -0,0 +1,257 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clusteroperator provides helpers to report the status of an operator in its ClusterOperator resource.
+package clusteroperator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/controller-runtime-common/pkg/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/client-go/util/flowcontrol"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// conditionTypes are the types of the ClusterOperator conditions managed by the StatusWriter.
+var conditionTypes = []configv1.ClusterStatusConditionType{
+	configv1.OperatorAvailable,
+	configv1.OperatorProgressing,
+	configv1.OperatorDegraded,
+	configv1.OperatorUpgradeable,
+}
+
+// StatusWriter maintains the status of the ClusterOperator of an operator: its versions, related objects and
+// Available, Progressing, Degraded and Upgradeable conditions.
+//
+// The status is recorded with the Set methods, from any goroutine, and written with Sync, which creates the
+// ClusterOperator when it does not exist. Only what was set is written: conditions and versions that were not set
+// are left as they are, and the related objects are only replaced once set or registered in RelatedObjects.
+//
+// Example:
+//
+//	writer := &clusteroperator.StatusWriter{Client: mgr.GetClient(), Name: "my-operator"}
+//	writer.SetConditions(state.Conditions(report))
+//	if available {
+//	    writer.SetVersion("operator", os.Getenv("OPERATOR_IMAGE_VERSION"))
+//	}
+//	err := writer.Sync(ctx)
+type StatusWriter struct {
+	Client client.Client
+
+	// Name is the name of the ClusterOperator.
+	Name string
+
+	// Upgradeable optionally gates upgrades on blocking reasons. When set, its condition replaces the Upgradeable
+	// condition set with SetUpgradeable.
+	Upgradeable *Upgradeable
+
+	// RelatedObjects is an optional registry of related objects, listed along with those set with SetRelatedObjects.
+	RelatedObjects *RelatedObjects
+
+	// Recorder optionally records an event for each change of the managed conditions.
+	Recorder events.EventRecorder
+
+	// EventRateLimiter limits the events recorded. Defaults to DefaultEventQPS and DefaultEventBurst.
+	EventRateLimiter flowcontrol.RateLimiter
+
+	mu             sync.Mutex
+	conditions     []configv1.ClusterOperatorStatusCondition
+	versions       []configv1.OperandVersion
+	relatedObjects []configv1.ObjectReference
+}
+
+// SetCondition records the condition of the given type.
+func (w *StatusWriter) SetCondition(conditionType configv1.ClusterStatusConditionType, conditionStatus configv1.ConditionStatus, reason, message string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	condition := configv1.ClusterOperatorStatusCondition{
+		Type:    conditionType,
+		Status:  conditionStatus,
+		Reason:  reason,
+		Message: message,
+	}
+
+	if i := slices.IndexFunc(w.conditions, func(c configv1.ClusterOperatorStatusCondition) bool {
+		return c.Type == conditionType
+	}); i >= 0 {
+		w.conditions[i] = condition
+		return
+	}
+
+	w.conditions = append(w.conditions, condition)
+}
+
+// SetAvailable records the Available condition.
+func (w *StatusWriter) SetAvailable(available bool, reason, message string) {
+	w.SetCondition(configv1.OperatorAvailable, conditionStatus(available), reason, message)
+}
+
+// SetProgressing records the Progressing condition.
+func (w *StatusWriter) SetProgressing(progressing bool, reason, message string) {
+	w.SetCondition(configv1.OperatorProgressing, conditionStatus(progressing), reason, message)
+}
+
+// SetDegraded records the Degraded condition.
+func (w *StatusWriter) SetDegraded(degraded bool, reason, message string) {
+	w.SetCondition(configv1.OperatorDegraded, conditionStatus(degraded), reason, message)
+}
+
+// SetUpgradeable records the Upgradeable condition.
+func (w *StatusWriter) SetUpgradeable(upgradeable bool, reason, message string) {
+	w.SetCondition(configv1.OperatorUpgradeable, conditionStatus(upgradeable), reason, message)
+}
+
+// SetConditions records the Available, Progressing, Degraded and Upgradeable conditions found in conditions,
+// e.g. as computed by conditions.OperatorState or conditions.Summarize. Other conditions are ignored.
+func (w *StatusWriter) SetConditions(conditions []metav1.Condition) {
+	for _, condition := range conditions {
+		conditionType := configv1.ClusterStatusConditionType(condition.Type)
+		if !slices.Contains(conditionTypes, conditionType) {
+			continue
+		}
+
+		w.SetCondition(conditionType, configv1.ConditionStatus(condition.Status), condition.Reason, condition.Message)
+	}
+}
+
+// SetVersion records the version of the named operand, e.g. "operator".
+// Versions should only be reported once the operand runs them, as the cluster version operator waits for them
+// during upgrades.
+func (w *StatusWriter) SetVersion(name, version string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if i := slices.IndexFunc(w.versions, func(v configv1.OperandVersion) bool { return v.Name == name }); i >= 0 {
+		w.versions[i].Version = version
+		return
+	}
+
+	w.versions = append(w.versions, configv1.OperandVersion{Name: name, Version: version})
+}
+
+// SetRelatedObjects records the objects collected by must-gather for the operator, replacing those set before.
+// The related objects are written deduplicated and sorted.
+func (w *StatusWriter) SetRelatedObjects(relatedObjects ...configv1.ObjectReference) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.relatedObjects = append([]configv1.ObjectReference{}, relatedObjects...)
+}
+
+// Sync writes the recorded status to the ClusterOperator, creating it first when it does not exist.
+// The status is only updated when it changed, and the lastTransitionTime of conditions only moves with their status.
+func (w *StatusWriter) Sync(ctx context.Context) error {
+	co := &configv1.ClusterOperator{}
+	if err := w.Client.Get(ctx, client.ObjectKey{Name: w.Name}, co); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get ClusterOperator %s: %w", w.Name, err)
+		}
+
+		co = &configv1.ClusterOperator{ObjectMeta: metav1.ObjectMeta{Name: w.Name}}
+		if err := w.Client.Create(ctx, co); err != nil {
+			return fmt.Errorf("failed to create ClusterOperator %s: %w", w.Name, err)
+		}
+	}
+
+	var before []configv1.ClusterOperatorStatusCondition
+
+	if err := status.Update(ctx, w.Client, co, func(co *configv1.ClusterOperator) error {
+		before = slices.Clone(co.Status.Conditions)
+		w.apply(&co.Status)
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	w.reportChanges(ctx, co, before)
+
+	return nil
+}
+
+// apply sets the recorded status on the given ClusterOperator status.
+func (w *StatusWriter) apply(coStatus *configv1.ClusterOperatorStatus) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, condition := range w.conditions {
+		if condition.Type == configv1.OperatorUpgradeable && w.Upgradeable != nil {
+			continue
+		}
+
+		setCondition(&coStatus.Conditions, condition)
+	}
+
+	if w.Upgradeable != nil {
+		setCondition(&coStatus.Conditions, w.Upgradeable.Condition())
+	}
+
+	for _, version := range w.versions {
+		if i := slices.IndexFunc(coStatus.Versions, func(v configv1.OperandVersion) bool { return v.Name == version.Name }); i >= 0 {
+			coStatus.Versions[i].Version = version.Version
+			continue
+		}
+
+		coStatus.Versions = append(coStatus.Versions, version)
+	}
+
+	switch {
+	case w.RelatedObjects != nil:
+		coStatus.RelatedObjects = mergeObjectReferences(w.relatedObjects, w.RelatedObjects.List())
+	case w.relatedObjects != nil:
+		coStatus.RelatedObjects = mergeObjectReferences(w.relatedObjects, nil)
+	}
+}
+
+// setCondition adds or updates the condition, only moving its lastTransitionTime when its status changes.
+func setCondition(conditions *[]configv1.ClusterOperatorStatusCondition, condition configv1.ClusterOperatorStatusCondition) {
+	i := slices.IndexFunc(*conditions, func(c configv1.ClusterOperatorStatusCondition) bool {
+		return c.Type == condition.Type
+	})
+	if i < 0 {
+		condition.LastTransitionTime = metav1.NewTime(time.Now().Truncate(time.Second))
+		*conditions = append(*conditions, condition)
+
+		return
+	}
+
+	existing := (*conditions)[i]
+	if existing.Status == condition.Status && !existing.LastTransitionTime.IsZero() {
+		condition.LastTransitionTime = existing.LastTransitionTime
+	} else {
+		condition.LastTransitionTime = metav1.NewTime(time.Now().Truncate(time.Second))
+	}
+
+	(*conditions)[i] = condition
+}
+
+// conditionStatus returns the condition status of a boolean.
+func conditionStatus(value bool) configv1.ConditionStatus {
+	if value {
+		return configv1.ConditionTrue
+	}
+
+	return configv1.ConditionFalse
+}
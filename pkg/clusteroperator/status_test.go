@@ -0,0 +1,145 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusteroperator
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/controller-runtime-common/pkg/conditions"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("StatusWriter", func() {
+	const name = "my-operator"
+
+	var (
+		fakeClient client.Client
+		writer     *StatusWriter
+	)
+
+	get := func() *configv1.ClusterOperator {
+		GinkgoHelper()
+
+		co := &configv1.ClusterOperator{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Name: name}, co)).To(Succeed())
+
+		return co
+	}
+
+	condition := func(co *configv1.ClusterOperator, conditionType configv1.ClusterStatusConditionType) *configv1.ClusterOperatorStatusCondition {
+		for i := range co.Status.Conditions {
+			if co.Status.Conditions[i].Type == conditionType {
+				return &co.Status.Conditions[i]
+			}
+		}
+
+		return nil
+	}
+
+	BeforeEach(func() {
+		fakeClient = newFakeClient()
+		writer = &StatusWriter{Client: fakeClient, Name: name}
+	})
+
+	It("should create the ClusterOperator with the recorded status", func() {
+		writer.SetAvailable(true, "AsExpected", "")
+		writer.SetProgressing(false, "AsExpected", "")
+		writer.SetDegraded(false, "AsExpected", "")
+		writer.SetUpgradeable(true, "AsExpected", "")
+		writer.SetVersion("operator", "4.20.0")
+		writer.SetRelatedObjects(configv1.ObjectReference{Resource: "namespaces", Name: "openshift-my-operator"})
+
+		Expect(writer.Sync(ctx)).To(Succeed())
+
+		co := get()
+		Expect(co.Status.Conditions).To(HaveLen(4))
+		Expect(condition(co, configv1.OperatorAvailable).Status).To(Equal(configv1.ConditionTrue))
+		Expect(condition(co, configv1.OperatorDegraded).Status).To(Equal(configv1.ConditionFalse))
+		Expect(condition(co, configv1.OperatorAvailable).LastTransitionTime.IsZero()).To(BeFalse())
+		Expect(co.Status.Versions).To(ConsistOf(configv1.OperandVersion{Name: "operator", Version: "4.20.0"}))
+		Expect(co.Status.RelatedObjects).To(ConsistOf(configv1.ObjectReference{Resource: "namespaces", Name: "openshift-my-operator"}))
+	})
+
+	It("should only move the transition time when the status changes", func() {
+		past := metav1.NewTime(time.Now().Add(-10 * time.Minute).Truncate(time.Second))
+		Expect(fakeClient.Create(ctx, &configv1.ClusterOperator{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+		})).To(Succeed())
+
+		co := get()
+		co.Status.Conditions = []configv1.ClusterOperatorStatusCondition{
+			{Type: configv1.OperatorAvailable, Status: configv1.ConditionTrue, Reason: "AsExpected", LastTransitionTime: past},
+			{Type: configv1.OperatorDegraded, Status: configv1.ConditionFalse, Reason: "AsExpected", LastTransitionTime: past},
+			{Type: "ExternalCondition", Status: configv1.ConditionTrue, LastTransitionTime: past},
+		}
+		Expect(fakeClient.Status().Update(ctx, co)).To(Succeed())
+
+		writer.SetAvailable(true, "AsExpected", "All good")
+		writer.SetDegraded(true, "Broken", "It broke")
+		Expect(writer.Sync(ctx)).To(Succeed())
+
+		co = get()
+		Expect(co.Status.Conditions).To(HaveLen(3))
+		Expect(condition(co, configv1.OperatorAvailable).LastTransitionTime.Time).To(BeTemporally("==", past.Time))
+		Expect(condition(co, configv1.OperatorAvailable).Message).To(Equal("All good"))
+		Expect(condition(co, configv1.OperatorDegraded).LastTransitionTime.Time).To(BeTemporally(">", past.Time))
+		Expect(condition(co, "ExternalCondition")).NotTo(BeNil())
+	})
+
+	It("should update versions in place and keep the others", func() {
+		Expect(fakeClient.Create(ctx, &configv1.ClusterOperator{ObjectMeta: metav1.ObjectMeta{Name: name}})).To(Succeed())
+
+		co := get()
+		co.Status.Versions = []configv1.OperandVersion{{Name: "operator", Version: "4.19.0"}, {Name: "operand", Version: "1.0"}}
+		Expect(fakeClient.Status().Update(ctx, co)).To(Succeed())
+
+		writer.SetVersion("operator", "4.19.1")
+		writer.SetVersion("operator", "4.20.0")
+		Expect(writer.Sync(ctx)).To(Succeed())
+
+		Expect(get().Status.Versions).To(Equal([]configv1.OperandVersion{
+			{Name: "operator", Version: "4.20.0"},
+			{Name: "operand", Version: "1.0"},
+		}))
+	})
+
+	It("should not update an unchanged status", func() {
+		writer.SetAvailable(true, "AsExpected", "")
+		Expect(writer.Sync(ctx)).To(Succeed())
+		resourceVersion := get().ResourceVersion
+
+		Expect(writer.Sync(ctx)).To(Succeed())
+		Expect(get().ResourceVersion).To(Equal(resourceVersion))
+	})
+
+	It("should record the conditions computed by the conditions helpers", func() {
+		state := &conditions.OperatorState{}
+		writer.SetConditions(append(state.Conditions(conditions.StateReport{Progressing: []string{"Rolling out"}}),
+			metav1.Condition{Type: "Ignored", Status: metav1.ConditionTrue, Reason: "Ignored"}))
+		Expect(writer.Sync(ctx)).To(Succeed())
+
+		co := get()
+		Expect(co.Status.Conditions).To(HaveLen(3))
+		Expect(condition(co, configv1.OperatorProgressing).Status).To(Equal(configv1.ConditionTrue))
+		Expect(condition(co, configv1.OperatorProgressing).Message).To(Equal("Rolling out"))
+		Expect(condition(co, configv1.OperatorAvailable).Status).To(Equal(configv1.ConditionTrue))
+	})
+})
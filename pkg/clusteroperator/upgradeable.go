@@ -0,0 +1,129 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusteroperator
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	configv1 "github.com/openshift/api/config/v1"
+)
+
+const (
+	// ReasonAsExpected is the reason of the Upgradeable condition when nothing blocks upgrades.
+	ReasonAsExpected = "AsExpected"
+
+	// ReasonMultipleReasons is the reason of the Upgradeable condition when upgrades are blocked for several reasons.
+	ReasonMultipleReasons = "MultipleReasons"
+)
+
+// Upgradeable gates the upgrades of the cluster on the reasons registered by the components of an operator,
+// and merges them into a single Upgradeable condition. Components block upgrades with a CamelCase reason, e.g.
+// while a migration is pending, and unblock them with the same reason once done. It is safe for concurrent use.
+//
+// Example:
+//
+//	upgradeable := &clusteroperator.Upgradeable{}
+//	writer := &clusteroperator.StatusWriter{Client: mgr.GetClient(), Name: "my-operator", Upgradeable: upgradeable}
+//
+//	upgradeable.Block("PendingMigration", "The storage migration of the operands is in progress")
+//	...
+//	upgradeable.Unblock("PendingMigration")
+type Upgradeable struct {
+	mu      sync.RWMutex
+	reasons map[string]string
+}
+
+// Block blocks upgrades for the given reason, replacing the message of a reason already blocking them.
+func (u *Upgradeable) Block(reason, message string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if u.reasons == nil {
+		u.reasons = map[string]string{}
+	}
+
+	u.reasons[reason] = message
+}
+
+// Unblock removes the given reason blocking upgrades.
+func (u *Upgradeable) Unblock(reason string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	delete(u.reasons, reason)
+}
+
+// Reasons returns the sorted reasons blocking upgrades.
+func (u *Upgradeable) Reasons() []string {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	return u.sortedReasons()
+}
+
+// Condition returns the Upgradeable condition: True when nothing blocks upgrades, and False otherwise with the
+// blocking reason, or ReasonMultipleReasons and a message listing each reason with its message.
+// The condition has no transition time.
+func (u *Upgradeable) Condition() configv1.ClusterOperatorStatusCondition {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+
+	reasons := u.sortedReasons()
+
+	switch len(reasons) {
+	case 0:
+		return configv1.ClusterOperatorStatusCondition{
+			Type:   configv1.OperatorUpgradeable,
+			Status: configv1.ConditionTrue,
+			Reason: ReasonAsExpected,
+		}
+	case 1:
+		return configv1.ClusterOperatorStatusCondition{
+			Type:    configv1.OperatorUpgradeable,
+			Status:  configv1.ConditionFalse,
+			Reason:  reasons[0],
+			Message: u.reasons[reasons[0]],
+		}
+	}
+
+	messages := make([]string, 0, len(reasons))
+	for _, reason := range reasons {
+		messages = append(messages, fmt.Sprintf("%s: %s", reason, u.reasons[reason]))
+	}
+
+	return configv1.ClusterOperatorStatusCondition{
+		Type:    configv1.OperatorUpgradeable,
+		Status:  configv1.ConditionFalse,
+		Reason:  ReasonMultipleReasons,
+		Message: strings.Join(messages, "\n"),
+	}
+}
+
+// sortedReasons returns the sorted reasons blocking upgrades. The lock must be held.
+func (u *Upgradeable) sortedReasons() []string {
+	reasons := make([]string, 0, len(u.reasons))
+	for reason := range u.reasons {
+		reasons = append(reasons, reason)
+	}
+
+	slices.Sort(reasons)
+
+	return reasons
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusteroperator
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Upgradeable", func() {
+	It("should be upgradeable when nothing blocks upgrades", func() {
+		upgradeable := &Upgradeable{}
+		condition := upgradeable.Condition()
+		Expect(condition.Status).To(Equal(configv1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(ReasonAsExpected))
+	})
+
+	It("should merge the blocking reasons", func() {
+		upgradeable := &Upgradeable{}
+		upgradeable.Block("PendingMigration", "Migration in progress")
+
+		condition := upgradeable.Condition()
+		Expect(condition.Status).To(Equal(configv1.ConditionFalse))
+		Expect(condition.Reason).To(Equal("PendingMigration"))
+		Expect(condition.Message).To(Equal("Migration in progress"))
+
+		upgradeable.Block("DeprecatedAPIInUse", "Deprecated APIs are in use")
+		Expect(upgradeable.Reasons()).To(Equal([]string{"DeprecatedAPIInUse", "PendingMigration"}))
+
+		condition = upgradeable.Condition()
+		Expect(condition.Reason).To(Equal(ReasonMultipleReasons))
+		Expect(condition.Message).To(Equal("DeprecatedAPIInUse: Deprecated APIs are in use\nPendingMigration: Migration in progress"))
+
+		upgradeable.Unblock("PendingMigration")
+		upgradeable.Unblock("DeprecatedAPIInUse")
+		upgradeable.Unblock("Unknown")
+		Expect(upgradeable.Condition().Status).To(Equal(configv1.ConditionTrue))
+	})
+
+	It("should replace the Upgradeable condition of the StatusWriter", func() {
+		fakeClient := newFakeClient()
+		upgradeable := &Upgradeable{}
+		writer := &StatusWriter{Client: fakeClient, Name: "my-operator", Upgradeable: upgradeable}
+
+		writer.SetUpgradeable(true, ReasonAsExpected, "")
+		upgradeable.Block("PendingMigration", "Migration in progress")
+		Expect(writer.Sync(ctx)).To(Succeed())
+
+		co := &configv1.ClusterOperator{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "my-operator"}, co)).To(Succeed())
+		Expect(co.Status.Conditions).To(ConsistOf(HaveField("Reason", "PendingMigration")))
+		Expect(co.Status.Conditions[0].Status).To(Equal(configv1.ConditionFalse))
+	})
+})
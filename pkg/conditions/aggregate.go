@@ -0,0 +1,142 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReasonMultipleReasons is the reason of a summarized condition when the failing children report different reasons.
+const ReasonMultipleReasons = "MultipleReasons"
+
+// Policy is how the conditions of children are combined into the condition of their parent.
+type Policy int
+
+const (
+	// AnyTrue makes the parent condition True when the condition of any child is True, e.g. for Degraded or Progressing.
+	AnyTrue Policy = iota
+	// AllTrue makes the parent condition True only when the condition of every child is True, e.g. for Available.
+	// Children without the condition count as not True.
+	AllTrue
+)
+
+// Child is an object whose conditions are summarized on its parent.
+type Child struct {
+	// Name identifies the child in messages, e.g. "Deployment operator/operand".
+	Name string
+	// Conditions are the conditions of the child.
+	Conditions []metav1.Condition
+}
+
+// DeploymentChild returns a Child with the conditions of a Deployment, e.g. Available and Progressing.
+func DeploymentChild(deployment *appsv1.Deployment) Child {
+	child := Child{Name: fmt.Sprintf("Deployment %s/%s", deployment.Namespace, deployment.Name)}
+
+	for _, condition := range deployment.Status.Conditions {
+		child.Conditions = append(child.Conditions, metav1.Condition{
+			Type:               string(condition.Type),
+			Status:             metav1.ConditionStatus(condition.Status),
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+			LastTransitionTime: condition.LastTransitionTime,
+		})
+	}
+
+	return child
+}
+
+// Summarize returns the condition of the given type for a parent, combining the conditions of the same type of its children
+// according to the policy. The message enumerates the children responsible for the status, in order, with their reasons
+// and messages. The reason is the one shared by these children, or ReasonMultipleReasons, and ReasonAsExpected when
+// no child is responsible. The result is meant to be applied with Set.
+//
+// Children whose condition is Unknown are not available with AllTrue. With AnyTrue, they make the parent condition
+// Unknown, and are enumerated, unless the condition of another child is True.
+func Summarize(conditionType string, policy Policy, children ...Child) metav1.Condition {
+	var offending, unknown summary
+
+	for _, child := range children {
+		condition := Get(child.Conditions, conditionType)
+
+		switch {
+		case policy == AnyTrue && condition != nil && condition.Status == metav1.ConditionTrue:
+			offending.add(child, condition, conditionType)
+		case policy == AnyTrue && condition != nil && condition.Status == metav1.ConditionUnknown:
+			unknown.add(child, condition, conditionType)
+		case policy == AllTrue && (condition == nil || condition.Status != metav1.ConditionTrue):
+			offending.add(child, condition, conditionType)
+		}
+	}
+
+	result := metav1.Condition{Type: conditionType, Reason: ReasonAsExpected}
+
+	switch policy {
+	case AnyTrue:
+		result.Status = metav1.ConditionFalse
+		if len(offending.descriptions) > 0 {
+			result.Status = metav1.ConditionTrue
+		} else if len(unknown.descriptions) > 0 {
+			result.Status = metav1.ConditionUnknown
+			offending = unknown
+		}
+	case AllTrue:
+		result.Status = metav1.ConditionTrue
+		if len(offending.descriptions) > 0 {
+			result.Status = metav1.ConditionFalse
+		}
+	}
+
+	if len(offending.descriptions) > 0 {
+		result.Reason = offending.reason
+		result.Message = strings.Join(offending.descriptions, "; ")
+	}
+
+	return result
+}
+
+// summary collects the children responsible for the status of a summarized condition.
+type summary struct {
+	reason       string
+	descriptions []string
+}
+
+// add adds a child with its condition, nil when missing, to the summary.
+func (s *summary) add(child Child, condition *metav1.Condition, conditionType string) {
+	childReason, description := "ConditionMissing", fmt.Sprintf("%s: %s condition missing", child.Name, conditionType)
+	if condition != nil {
+		childReason = condition.Reason
+		description = fmt.Sprintf("%s: %s", child.Name, condition.Reason)
+
+		if condition.Message != "" {
+			description += ": " + condition.Message
+		}
+	}
+
+	switch s.reason {
+	case "":
+		s.reason = childReason
+	case childReason:
+	default:
+		s.reason = ReasonMultipleReasons
+	}
+
+	s.descriptions = append(s.descriptions, description)
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Summarize", func() {
+	available := func(status metav1.ConditionStatus, reason, message string) []metav1.Condition {
+		return []metav1.Condition{{Type: TypeAvailable, Status: status, Reason: reason, Message: message}}
+	}
+
+	It("should require all children to be available with AllTrue", func() {
+		summary := Summarize(TypeAvailable, AllTrue,
+			Child{Name: "Operand a", Conditions: available(metav1.ConditionTrue, ReasonAsExpected, "")},
+			Child{Name: "Operand b", Conditions: available(metav1.ConditionFalse, "NoReplicas", "0/3 replicas ready")},
+			Child{Name: "Operand c"},
+		)
+
+		Expect(summary.Status).To(Equal(metav1.ConditionFalse))
+		Expect(summary.Reason).To(Equal(ReasonMultipleReasons))
+		Expect(summary.Message).To(Equal("Operand b: NoReplicas: 0/3 replicas ready; Operand c: Available condition missing"))
+
+		summary = Summarize(TypeAvailable, AllTrue,
+			Child{Name: "Operand a", Conditions: available(metav1.ConditionTrue, ReasonAsExpected, "")},
+		)
+		Expect(summary.Status).To(Equal(metav1.ConditionTrue))
+		Expect(summary.Reason).To(Equal(ReasonAsExpected))
+	})
+
+	It("should report any degraded child with AnyTrue", func() {
+		degraded := []metav1.Condition{{Type: TypeDegraded, Status: metav1.ConditionTrue, Reason: "CertInvalid", Message: "expired"}}
+
+		summary := Summarize(TypeDegraded, AnyTrue,
+			Child{Name: "Operand a", Conditions: degraded},
+			Child{Name: "Operand b"},
+			Child{Name: "Operand c", Conditions: degraded},
+		)
+
+		Expect(summary.Status).To(Equal(metav1.ConditionTrue))
+		Expect(summary.Reason).To(Equal("CertInvalid"))
+		Expect(summary.Message).To(Equal("Operand a: CertInvalid: expired; Operand c: CertInvalid: expired"))
+
+		Expect(Summarize(TypeDegraded, AnyTrue, Child{Name: "Operand b"}).Status).To(Equal(metav1.ConditionFalse))
+	})
+
+	It("should report the children whose condition is Unknown", func() {
+		unknown := []metav1.Condition{{Type: TypeDegraded, Status: metav1.ConditionUnknown, Reason: "Pending", Message: "not checked yet"}}
+		degraded := []metav1.Condition{{Type: TypeDegraded, Status: metav1.ConditionTrue, Reason: "CertInvalid", Message: "expired"}}
+
+		summary := Summarize(TypeDegraded, AnyTrue,
+			Child{Name: "Operand a", Conditions: unknown},
+			Child{Name: "Operand b"},
+		)
+		Expect(summary.Status).To(Equal(metav1.ConditionUnknown))
+		Expect(summary.Reason).To(Equal("Pending"))
+		Expect(summary.Message).To(Equal("Operand a: Pending: not checked yet"))
+
+		summary = Summarize(TypeDegraded, AnyTrue,
+			Child{Name: "Operand a", Conditions: unknown},
+			Child{Name: "Operand c", Conditions: degraded},
+		)
+		Expect(summary.Status).To(Equal(metav1.ConditionTrue))
+		Expect(summary.Message).To(Equal("Operand c: CertInvalid: expired"))
+
+		summary = Summarize(TypeAvailable, AllTrue,
+			Child{Name: "Operand a", Conditions: available(metav1.ConditionUnknown, "Pending", "")},
+		)
+		Expect(summary.Status).To(Equal(metav1.ConditionFalse))
+		Expect(summary.Message).To(Equal("Operand a: Pending"))
+	})
+
+	It("should convert Deployment conditions", func() {
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "operator", Name: "operand"},
+			Status: appsv1.DeploymentStatus{Conditions: []appsv1.DeploymentCondition{{
+				Type:    appsv1.DeploymentAvailable,
+				Status:  corev1.ConditionFalse,
+				Reason:  "MinimumReplicasUnavailable",
+				Message: "Deployment does not have minimum availability.",
+			}}},
+		}
+
+		summary := Summarize(TypeAvailable, AllTrue, DeploymentChild(deployment))
+		Expect(summary.Status).To(Equal(metav1.ConditionFalse))
+		Expect(summary.Reason).To(Equal("MinimumReplicasUnavailable"))
+		Expect(summary.Message).To(Equal("Deployment operator/operand: MinimumReplicasUnavailable: Deployment does not have minimum availability."))
+	})
+})
@@ -0,0 +1,186 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// MaxReasonLength is the maximum length of reasons produced by a Builder.
+	MaxReasonLength = 128
+	// MaxMessageLength is the maximum length of a condition message accepted by the API server.
+	MaxMessageLength = 32768
+
+	// reasonUnknown replaces reasons that are empty once normalized.
+	reasonUnknown = "Unknown"
+	// truncationSuffix marks truncated messages.
+	truncationSuffix = "..."
+)
+
+// ErrInvalidReason is returned by ValidateReason for reasons that are not CamelCase or are too long.
+var ErrInvalidReason = errors.New("invalid condition reason")
+
+var camelCaseReason = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+
+// ValidateReason checks that a reason is CamelCase and at most MaxReasonLength long,
+// which also satisfies the validation of metav1.Condition by the API server.
+func ValidateReason(reason string) error {
+	if len(reason) > MaxReasonLength {
+		return fmt.Errorf("%w: %q is longer than %d characters", ErrInvalidReason, reason, MaxReasonLength)
+	}
+
+	if !camelCaseReason.MatchString(reason) {
+		return fmt.Errorf("%w: %q is not CamelCase", ErrInvalidReason, reason)
+	}
+
+	return nil
+}
+
+// Builder builds a condition with a standardized reason and message.
+//
+// Example:
+//
+//	conditions.Degraded("CertInvalid").Message("Serving certificate cannot be loaded").Because(err).
+//	    Apply(&status.Conditions, obj.GetGeneration())
+type Builder struct {
+	condition metav1.Condition
+	err       error
+}
+
+// New returns a Builder for a condition of the given type, status and reason.
+func New(conditionType string, status metav1.ConditionStatus, reason string) *Builder {
+	return &Builder{condition: metav1.Condition{Type: conditionType, Status: status, Reason: reason}}
+}
+
+// Available returns a Builder for an Available condition that is True.
+func Available(reason string) *Builder {
+	return New(TypeAvailable, metav1.ConditionTrue, reason)
+}
+
+// Unavailable returns a Builder for an Available condition that is False.
+func Unavailable(reason string) *Builder {
+	return New(TypeAvailable, metav1.ConditionFalse, reason)
+}
+
+// Progressing returns a Builder for a Progressing condition that is True.
+func Progressing(reason string) *Builder {
+	return New(TypeProgressing, metav1.ConditionTrue, reason)
+}
+
+// NotProgressing returns a Builder for a Progressing condition that is False.
+func NotProgressing(reason string) *Builder {
+	return New(TypeProgressing, metav1.ConditionFalse, reason)
+}
+
+// Degraded returns a Builder for a Degraded condition that is True.
+func Degraded(reason string) *Builder {
+	return New(TypeDegraded, metav1.ConditionTrue, reason)
+}
+
+// NotDegraded returns a Builder for a Degraded condition that is False.
+func NotDegraded(reason string) *Builder {
+	return New(TypeDegraded, metav1.ConditionFalse, reason)
+}
+
+// Message sets the message of the condition.
+func (b *Builder) Message(message string) *Builder {
+	b.condition.Message = message
+	return b
+}
+
+// Messagef sets the message of the condition with a format string.
+func (b *Builder) Messagef(format string, args ...any) *Builder {
+	return b.Message(fmt.Sprintf(format, args...))
+}
+
+// Because appends the error to the message of the condition, as "<message>: <error>". A nil error is ignored.
+func (b *Builder) Because(err error) *Builder {
+	b.err = err
+	return b
+}
+
+// Condition returns the condition. Reasons that fail ValidateReason are normalized to CamelCase,
+// so that an invalid reason does not get the whole status update rejected, and messages are truncated
+// to MaxMessageLength.
+func (b *Builder) Condition() metav1.Condition {
+	condition := b.condition
+
+	if ValidateReason(condition.Reason) != nil {
+		condition.Reason = normalizeReason(condition.Reason)
+	}
+
+	if b.err != nil {
+		if condition.Message == "" {
+			condition.Message = b.err.Error()
+		} else {
+			condition.Message += ": " + b.err.Error()
+		}
+	}
+
+	condition.Message = truncateMessage(condition.Message)
+
+	return condition
+}
+
+// Apply sets the condition with Set and reports whether anything changed.
+func (b *Builder) Apply(conditions *[]metav1.Condition, generation int64) bool {
+	return Set(conditions, b.Condition(), generation)
+}
+
+// normalizeReason turns a reason into CamelCase, e.g. "cert invalid" and "cert_invalid" into "CertInvalid".
+func normalizeReason(reason string) string {
+	var normalized strings.Builder
+
+	for _, word := range strings.FieldsFunc(reason, func(r rune) bool {
+		return r > unicode.MaxASCII || (!unicode.IsLetter(r) && !unicode.IsDigit(r))
+	}) {
+		normalized.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+
+	result := normalized.String()
+	if result == "" || !unicode.IsLetter(rune(result[0])) {
+		result = reasonUnknown + result
+	}
+
+	if len(result) > MaxReasonLength {
+		result = result[:MaxReasonLength]
+	}
+
+	return result
+}
+
+// truncateMessage truncates a message to MaxMessageLength bytes without splitting a character.
+func truncateMessage(message string) string {
+	if len(message) <= MaxMessageLength {
+		return message
+	}
+
+	cut := MaxMessageLength - len(truncationSuffix)
+	for cut > 0 && !utf8.RuneStart(message[cut]) {
+		cut--
+	}
+
+	return message[:cut] + truncationSuffix
+}
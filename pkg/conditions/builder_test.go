@@ -0,0 +1,87 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Builder", func() {
+	It("should build conditions with the standard types", func() {
+		err := fmt.Errorf("failed to load certificate: %w", errors.New("expired"))
+
+		condition := Degraded("CertInvalid").Message("Serving certificate cannot be loaded").Because(err).Condition()
+		Expect(condition).To(Equal(metav1.Condition{
+			Type:    TypeDegraded,
+			Status:  metav1.ConditionTrue,
+			Reason:  "CertInvalid",
+			Message: "Serving certificate cannot be loaded: failed to load certificate: expired",
+		}))
+
+		Expect(Unavailable("NoReplicas").Because(errors.New("0/3 ready")).Condition().Message).To(Equal("0/3 ready"))
+		Expect(Available(ReasonAsExpected).Because(nil).Condition().Status).To(Equal(metav1.ConditionTrue))
+		Expect(NotProgressing(ReasonAsExpected).Messagef("%d replicas", 3).Condition().Message).To(Equal("3 replicas"))
+	})
+
+	It("should apply conditions", func() {
+		var conditions []metav1.Condition
+		Expect(Progressing("RollingOut").Apply(&conditions, 2)).To(BeTrue())
+		Expect(IsCurrent(conditions, TypeProgressing, 2)).To(BeTrue())
+		Expect(NotDegraded(ReasonAsExpected).Apply(&conditions, 2)).To(BeTrue())
+		Expect(conditions).To(HaveLen(2))
+	})
+
+	DescribeTable("should validate reasons",
+		func(reason string, valid bool) {
+			if valid {
+				Expect(ValidateReason(reason)).To(Succeed())
+			} else {
+				Expect(ValidateReason(reason)).To(MatchError(ErrInvalidReason))
+			}
+		},
+		Entry("CamelCase", "CertInvalid", true),
+		Entry("with digits", "TLS13Required", true),
+		Entry("lower case", "certInvalid", false),
+		Entry("with spaces", "Cert Invalid", false),
+		Entry("empty", "", false),
+		Entry("too long", "A"+strings.Repeat("a", MaxReasonLength), false),
+	)
+
+	DescribeTable("should normalize invalid reasons",
+		func(reason, expected string) {
+			Expect(New(TypeDegraded, metav1.ConditionTrue, reason).Condition().Reason).To(Equal(expected))
+		},
+		Entry("with spaces", "cert invalid", "CertInvalid"),
+		Entry("snake case", "cert_invalid", "CertInvalid"),
+		Entry("leading digit", "404 not found", "Unknown404NotFound"),
+		Entry("empty", "", "Unknown"),
+	)
+
+	It("should truncate long messages without splitting characters", func() {
+		condition := Degraded("Failing").Message(strings.Repeat("é", MaxMessageLength)).Condition()
+		Expect(len(condition.Message)).To(BeNumerically("<=", MaxMessageLength))
+		Expect(condition.Message).To(HaveSuffix("..."))
+		Expect(utf8.ValidString(condition.Message)).To(BeTrue())
+	})
+})
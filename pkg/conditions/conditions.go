@@ -0,0 +1,143 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conditions provides helpers to manage metav1.Conditions in object statuses,
+// with consistent lastTransitionTime and observedGeneration handling.
+package conditions
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// now returns the current time truncated to seconds, the precision of serialized timestamps,
+// so that conditions compare equal before and after a round trip through the API server.
+func now() metav1.Time {
+	return metav1.NewTime(time.Now().Truncate(time.Second))
+}
+
+// Set adds or updates the condition with the type of the given condition and reports whether anything changed.
+//
+// The observedGeneration of the condition is set to the given generation, which should be the generation
+// of the object the status belongs to. The lastTransitionTime is only moved when the status changes,
+// in which case the time of the given condition is used if set, and the current time otherwise.
+func Set(conditions *[]metav1.Condition, condition metav1.Condition, generation int64) bool {
+	condition.ObservedGeneration = generation
+
+	existing := Get(*conditions, condition.Type)
+	if existing == nil {
+		if condition.LastTransitionTime.IsZero() {
+			condition.LastTransitionTime = now()
+		}
+
+		*conditions = append(*conditions, condition)
+
+		return true
+	}
+
+	if existing.Status != condition.Status || existing.LastTransitionTime.IsZero() {
+		if condition.LastTransitionTime.IsZero() {
+			condition.LastTransitionTime = now()
+		}
+	} else {
+		condition.LastTransitionTime = existing.LastTransitionTime
+	}
+
+	if *existing == condition {
+		return false
+	}
+
+	*existing = condition
+
+	return true
+}
+
+// MarkTrue sets the condition of the given type to True and reports whether anything changed.
+func MarkTrue(conditions *[]metav1.Condition, generation int64, conditionType, reason, message string) bool {
+	return mark(conditions, generation, conditionType, metav1.ConditionTrue, reason, message)
+}
+
+// MarkFalse sets the condition of the given type to False and reports whether anything changed.
+func MarkFalse(conditions *[]metav1.Condition, generation int64, conditionType, reason, message string) bool {
+	return mark(conditions, generation, conditionType, metav1.ConditionFalse, reason, message)
+}
+
+// MarkUnknown sets the condition of the given type to Unknown and reports whether anything changed.
+func MarkUnknown(conditions *[]metav1.Condition, generation int64, conditionType, reason, message string) bool {
+	return mark(conditions, generation, conditionType, metav1.ConditionUnknown, reason, message)
+}
+
+func mark(conditions *[]metav1.Condition, generation int64, conditionType string, status metav1.ConditionStatus, reason, message string) bool {
+	return Set(conditions, metav1.Condition{
+		Type:    conditionType,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	}, generation)
+}
+
+// Remove removes the condition of the given type and reports whether it was present.
+func Remove(conditions *[]metav1.Condition, conditionType string) bool {
+	for i := range *conditions {
+		if (*conditions)[i].Type == conditionType {
+			*conditions = append((*conditions)[:i], (*conditions)[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// Get returns the condition of the given type, or nil if it is not present.
+// The returned pointer refers to the element of the slice.
+func Get(conditions []metav1.Condition, conditionType string) *metav1.Condition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+
+	return nil
+}
+
+// IsTrue reports whether the condition of the given type is present and True.
+func IsTrue(conditions []metav1.Condition, conditionType string) bool {
+	return hasStatus(conditions, conditionType, metav1.ConditionTrue)
+}
+
+// IsFalse reports whether the condition of the given type is present and False.
+func IsFalse(conditions []metav1.Condition, conditionType string) bool {
+	return hasStatus(conditions, conditionType, metav1.ConditionFalse)
+}
+
+// IsUnknown reports whether the condition of the given type is absent or Unknown.
+func IsUnknown(conditions []metav1.Condition, conditionType string) bool {
+	condition := Get(conditions, conditionType)
+	return condition == nil || condition.Status == metav1.ConditionUnknown
+}
+
+// IsCurrent reports whether the condition of the given type is present and was set for the given generation,
+// meaning it reflects the current spec of the object rather than a previous one.
+func IsCurrent(conditions []metav1.Condition, conditionType string, generation int64) bool {
+	condition := Get(conditions, conditionType)
+	return condition != nil && condition.ObservedGeneration == generation
+}
+
+func hasStatus(conditions []metav1.Condition, conditionType string, status metav1.ConditionStatus) bool {
+	condition := Get(conditions, conditionType)
+	return condition != nil && condition.Status == status
+}
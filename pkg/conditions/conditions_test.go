@@ -0,0 +1,85 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Conditions", func() {
+	var conditions []metav1.Condition
+
+	BeforeEach(func() {
+		conditions = nil
+	})
+
+	It("should add a condition with the generation and a transition time", func() {
+		Expect(MarkTrue(&conditions, 3, "Available", "AsExpected", "All good")).To(BeTrue())
+
+		condition := Get(conditions, "Available")
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.ObservedGeneration).To(BeEquivalentTo(3))
+		Expect(condition.LastTransitionTime.IsZero()).To(BeFalse())
+		Expect(condition.LastTransitionTime.Time).To(Equal(condition.LastTransitionTime.Truncate(time.Second)))
+		Expect(IsTrue(conditions, "Available")).To(BeTrue())
+		Expect(IsCurrent(conditions, "Available", 3)).To(BeTrue())
+	})
+
+	It("should report no change when setting the same condition", func() {
+		Expect(MarkFalse(&conditions, 1, "Degraded", "AsExpected", "")).To(BeTrue())
+		Expect(MarkFalse(&conditions, 1, "Degraded", "AsExpected", "")).To(BeFalse())
+		Expect(conditions).To(HaveLen(1))
+	})
+
+	It("should keep the transition time when only the reason, message or generation change", func() {
+		past := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+		Expect(Set(&conditions, metav1.Condition{Type: "Progressing", Status: metav1.ConditionTrue, Reason: "Rolling", LastTransitionTime: past}, 1)).To(BeTrue())
+
+		Expect(MarkTrue(&conditions, 2, "Progressing", "Scaling", "Scaling up")).To(BeTrue())
+		condition := Get(conditions, "Progressing")
+		Expect(condition.LastTransitionTime).To(Equal(past))
+		Expect(condition.Reason).To(Equal("Scaling"))
+		Expect(condition.ObservedGeneration).To(BeEquivalentTo(2))
+		Expect(IsCurrent(conditions, "Progressing", 1)).To(BeFalse())
+
+		Expect(MarkFalse(&conditions, 2, "Progressing", "AsExpected", "")).To(BeTrue())
+		Expect(Get(conditions, "Progressing").LastTransitionTime.After(past.Time)).To(BeTrue())
+	})
+
+	It("should report unknown for missing conditions", func() {
+		Expect(IsUnknown(conditions, "Available")).To(BeTrue())
+		Expect(IsTrue(conditions, "Available")).To(BeFalse())
+		Expect(IsFalse(conditions, "Available")).To(BeFalse())
+
+		Expect(MarkUnknown(&conditions, 1, "Available", "Initializing", "")).To(BeTrue())
+		Expect(IsUnknown(conditions, "Available")).To(BeTrue())
+	})
+
+	It("should remove conditions", func() {
+		MarkTrue(&conditions, 1, "Available", "AsExpected", "")
+		MarkFalse(&conditions, 1, "Degraded", "AsExpected", "")
+
+		Expect(Remove(&conditions, "Available")).To(BeTrue())
+		Expect(Remove(&conditions, "Available")).To(BeFalse())
+		Expect(conditions).To(HaveLen(1))
+		Expect(conditions[0].Type).To(Equal("Degraded"))
+	})
+})
@@ -0,0 +1,228 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// ErrNoObjects is returned when an Exporter is set up without objects to export.
+var ErrNoObjects = errors.New("no objects to export the conditions of")
+
+var objectConditionDesc = prometheus.NewDesc(
+	"object_condition",
+	"Status of the conditions of an object, 1 for the current status of the condition and 0 for the others.",
+	[]string{"kind", "namespace", "name", "type", "status"},
+	nil,
+)
+
+// conditionStatuses are the statuses exported for every condition, so that alerts can match a status being 1.
+var conditionStatuses = []string{"True", "False", "Unknown"}
+
+// Exporter exports the status.conditions of selected kinds as the object_condition gauge, so that cluster admins
+// can alert on Degraded operands without per-operator exporters. Both metav1.Conditions and the conditions of
+// built-in kinds such as Deployments are supported.
+//
+// The objects are read from the manager's cache when metrics are collected, so the gauge does not go stale.
+// The Exporter runs on every replica, regardless of leader election, as every replica serves metrics.
+//
+// Example:
+//
+//	exporter := &conditions.Exporter{Objects: []client.Object{&v1alpha1.Operand{}, &appsv1.Deployment{}}}
+//	if err := exporter.SetupWithManager(mgr); err != nil {
+//	    return err
+//	}
+type Exporter struct {
+	// Objects are the kinds to export the conditions of, e.g. &appsv1.Deployment{}.
+	Objects []client.Object
+
+	// ConditionTypes restricts the exported conditions to the given types. All conditions are exported when empty.
+	ConditionTypes []string
+
+	// Reader lists the objects. Defaults to the manager's cache.
+	Reader client.Reader
+
+	// Scheme resolves the kinds of Objects. Defaults to the manager's scheme.
+	Scheme *runtime.Scheme
+
+	// Registerer is where the Exporter registers itself when started. Defaults to the controller-runtime metrics registry.
+	Registerer prometheus.Registerer
+
+	mu     sync.RWMutex
+	logger logr.Logger
+}
+
+// SetupWithManager fills the defaults from the manager and adds the Exporter to it.
+func (e *Exporter) SetupWithManager(mgr ctrl.Manager) error {
+	if len(e.Objects) == 0 {
+		return ErrNoObjects
+	}
+
+	if e.Reader == nil {
+		e.Reader = mgr.GetCache()
+	}
+
+	if e.Scheme == nil {
+		e.Scheme = mgr.GetScheme()
+	}
+
+	if err := mgr.Add(e); err != nil {
+		return fmt.Errorf("failed to add conditions exporter to manager: %w", err)
+	}
+
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+// The Exporter runs on every replica, as every replica serves metrics.
+func (e *Exporter) NeedLeaderElection() bool {
+	return false
+}
+
+// Start starts watching the objects when the Reader is a cache, registers the Exporter and blocks until the context is done.
+func (e *Exporter) Start(ctx context.Context) error {
+	e.mu.Lock()
+	e.logger = log.FromContext(ctx).WithName("conditions-exporter")
+	e.mu.Unlock()
+
+	if informers, ok := e.Reader.(cache.Informers); ok {
+		for _, obj := range e.Objects {
+			if _, err := informers.GetInformer(ctx, obj); err != nil {
+				return fmt.Errorf("failed to get informer for %T: %w", obj, err)
+			}
+		}
+	}
+
+	registerer := e.Registerer
+	if registerer == nil {
+		registerer = metrics.Registry
+	}
+
+	if err := registerer.Register(e); err != nil {
+		return fmt.Errorf("failed to register conditions exporter: %w", err)
+	}
+
+	defer registerer.Unregister(e)
+
+	<-ctx.Done()
+
+	return nil
+}
+
+// Describe implements prometheus.Collector.
+func (e *Exporter) Describe(ch chan<- *prometheus.Desc) {
+	ch <- objectConditionDesc
+}
+
+// Collect implements prometheus.Collector.
+func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
+	e.mu.RLock()
+	logger := e.logger
+	e.mu.RUnlock()
+
+	for _, obj := range e.Objects {
+		if err := e.collect(ch, obj); err != nil {
+			logger.Error(err, "Failed to collect conditions", "kind", fmt.Sprintf("%T", obj))
+		}
+	}
+}
+
+func (e *Exporter) collect(ch chan<- prometheus.Metric, obj client.Object) error {
+	gvk, err := apiutil.GVKForObject(obj, e.Scheme)
+	if err != nil {
+		return fmt.Errorf("failed to get the kind of %T: %w", obj, err)
+	}
+
+	listObj, err := e.Scheme.New(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err != nil {
+		return fmt.Errorf("failed to create a list of %s: %w", gvk.Kind, err)
+	}
+
+	list, ok := listObj.(client.ObjectList)
+	if !ok {
+		return fmt.Errorf("%T is not a list", listObj)
+	}
+
+	// Listing from the cache is served from memory.
+	if err := e.Reader.List(context.Background(), list); err != nil {
+		return fmt.Errorf("failed to list %s: %w", gvk.Kind, err)
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return fmt.Errorf("failed to extract the items of %s: %w", gvk.Kind, err)
+	}
+
+	for _, item := range items {
+		itemObj, ok := item.(client.Object)
+		if !ok {
+			continue
+		}
+
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(item)
+		if err != nil {
+			return fmt.Errorf("failed to convert %s %s: %w", gvk.Kind, client.ObjectKeyFromObject(itemObj).String(), err)
+		}
+
+		conditions, _, _ := unstructured.NestedSlice(content, "status", "conditions")
+		for _, entry := range conditions {
+			condition, ok := entry.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			conditionType, _ := condition["type"].(string)
+			status, _ := condition["status"].(string)
+
+			if conditionType == "" || (len(e.ConditionTypes) > 0 && !slices.Contains(e.ConditionTypes, conditionType)) {
+				continue
+			}
+
+			for _, candidate := range conditionStatuses {
+				value := 0.0
+				if candidate == status {
+					value = 1
+				}
+
+				ch <- prometheus.MustNewConstMetric(
+					objectConditionDesc,
+					prometheus.GaugeValue,
+					value,
+					gvk.Kind, itemObj.GetNamespace(), itemObj.GetName(), conditionType, candidate,
+				)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Exporter", func() {
+	var exporter *Exporter
+
+	BeforeEach(func() {
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "operator", Name: "operand"},
+			Status: appsv1.DeploymentStatus{Conditions: []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionFalse},
+				{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionTrue},
+			}},
+		}
+
+		exporter = &Exporter{
+			Objects:        []client.Object{&appsv1.Deployment{}},
+			ConditionTypes: []string{"Available"},
+			Reader:         fake.NewClientBuilder().WithObjects(deployment).Build(),
+			Scheme:         clientgoscheme.Scheme,
+		}
+	})
+
+	It("should export the selected conditions", func() {
+		expected := `
+# HELP object_condition Status of the conditions of an object, 1 for the current status of the condition and 0 for the others.
+# TYPE object_condition gauge
+object_condition{kind="Deployment",name="operand",namespace="operator",status="False",type="Available"} 1
+object_condition{kind="Deployment",name="operand",namespace="operator",status="True",type="Available"} 0
+object_condition{kind="Deployment",name="operand",namespace="operator",status="Unknown",type="Available"} 0
+`
+		Expect(testutil.CollectAndCompare(exporter, strings.NewReader(expected), "object_condition")).To(Succeed())
+	})
+
+	It("should register itself while running", func() {
+		registry := prometheus.NewRegistry()
+		exporter.Registerer = registry
+
+		runCtx, cancel := context.WithCancel(ctx)
+		done := make(chan error)
+
+		go func() {
+			done <- exporter.Start(runCtx)
+		}()
+
+		Eventually(func() (int, error) {
+			return testutil.GatherAndCount(registry, "object_condition")
+		}).Should(Equal(3))
+
+		cancel()
+		Eventually(done, time.Second).Should(Receive(BeNil()))
+		Expect(testutil.GatherAndCount(registry, "object_condition")).To(BeZero())
+	})
+})
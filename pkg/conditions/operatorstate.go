@@ -0,0 +1,182 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// TypeAvailable is the condition type reporting that the operand is functional.
+	TypeAvailable = "Available"
+	// TypeProgressing is the condition type reporting that the operand is moving to a new state.
+	TypeProgressing = "Progressing"
+	// TypeDegraded is the condition type reporting that the operand is not in its desired state.
+	TypeDegraded = "Degraded"
+
+	// ReasonAsExpected is the reason used when a condition is in its healthy state.
+	ReasonAsExpected = "AsExpected"
+	// ReasonReconcileError is the reason used when Degraded is True because errors are reported.
+	ReasonReconcileError = "ReconcileError"
+	// ReasonInProgress is the reason used when Progressing is True.
+	ReasonInProgress = "InProgress"
+	// ReasonUnavailable is the reason used when Available is False.
+	ReasonUnavailable = "Unavailable"
+)
+
+// StateReport is what a reconciliation reports to an OperatorState.
+type StateReport struct {
+	// Errors are the failures of the reconciliation.
+	Errors []error
+	// Progressing describes the work in progress, such as a rollout. Progressing is True when it is not empty.
+	Progressing []string
+	// Unavailable describes why the operand is not functional. Available is False when it is not empty.
+	Unavailable []string
+}
+
+// OperatorState computes the canonical OpenShift Available, Progressing and Degraded conditions from the reports
+// of successive reconciliations, damping Degraded so that transient errors do not make it flap.
+//
+// Degraded only becomes True once errors have been reported continuously for DegradedAfter,
+// and only goes back to False once no error has been reported for RecoveredAfter.
+// Use one OperatorState per object, and requeue after RequeueAfter so that pending flips are applied.
+type OperatorState struct {
+	// DegradedAfter is how long errors must be reported before Degraded becomes True.
+	DegradedAfter time.Duration
+	// RecoveredAfter is how long no error must be reported before Degraded goes back to False.
+	RecoveredAfter time.Duration
+
+	// now returns the current time, it is replaced in tests.
+	now func() time.Time
+
+	mu            sync.Mutex
+	degraded      bool
+	failingSince  time.Time
+	healthySince  time.Time
+	pendingFlipAt time.Time
+}
+
+// Apply records the report and sets the Available, Progressing and Degraded conditions accordingly.
+// It reports whether any condition changed.
+func (s *OperatorState) Apply(conditions *[]metav1.Condition, generation int64, report StateReport) bool {
+	changed := false
+	for _, condition := range s.Conditions(report) {
+		changed = Set(conditions, condition, generation) || changed
+	}
+
+	return changed
+}
+
+// Conditions records the report and returns the Available, Progressing and Degraded conditions.
+// The conditions have no transition time nor generation, they are meant to be applied with Set.
+func (s *OperatorState) Conditions(report StateReport) []metav1.Condition {
+	available := metav1.Condition{Type: TypeAvailable, Status: metav1.ConditionTrue, Reason: ReasonAsExpected}
+	if len(report.Unavailable) > 0 {
+		available.Status = metav1.ConditionFalse
+		available.Reason = ReasonUnavailable
+		available.Message = strings.Join(report.Unavailable, "; ")
+	}
+
+	progressing := metav1.Condition{Type: TypeProgressing, Status: metav1.ConditionFalse, Reason: ReasonAsExpected}
+	if len(report.Progressing) > 0 {
+		progressing.Status = metav1.ConditionTrue
+		progressing.Reason = ReasonInProgress
+		progressing.Message = strings.Join(report.Progressing, "; ")
+	}
+
+	degraded := metav1.Condition{Type: TypeDegraded, Status: metav1.ConditionFalse, Reason: ReasonAsExpected}
+	if s.recordErrors(len(report.Errors) > 0) {
+		messages := make([]string, 0, len(report.Errors))
+		for _, err := range report.Errors {
+			messages = append(messages, err.Error())
+		}
+
+		degraded.Status = metav1.ConditionTrue
+		degraded.Reason = ReasonReconcileError
+		degraded.Message = strings.Join(messages, "; ")
+
+		if len(messages) == 0 {
+			degraded.Message = "Recovering from previous errors"
+		}
+	}
+
+	return []metav1.Condition{available, progressing, degraded}
+}
+
+// RequeueAfter returns how long to wait before reconciling again so that a pending flip of Degraded is applied,
+// or zero when none is pending.
+func (s *OperatorState) RequeueAfter() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.pendingFlipAt.IsZero() {
+		return 0
+	}
+
+	return max(s.pendingFlipAt.Sub(s.clock()), time.Second)
+}
+
+// recordErrors records whether errors were reported and returns whether the state is degraded.
+func (s *OperatorState) recordErrors(failing bool) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.clock()
+
+	if failing {
+		s.healthySince = time.Time{}
+		if s.failingSince.IsZero() {
+			s.failingSince = now
+		}
+	} else {
+		s.failingSince = time.Time{}
+		if s.healthySince.IsZero() {
+			s.healthySince = now
+		}
+	}
+
+	s.pendingFlipAt = time.Time{}
+
+	switch {
+	case failing && !s.degraded:
+		if flipAt := s.failingSince.Add(s.DegradedAfter); now.Before(flipAt) {
+			s.pendingFlipAt = flipAt
+		} else {
+			s.degraded = true
+		}
+	case !failing && s.degraded:
+		if flipAt := s.healthySince.Add(s.RecoveredAfter); now.Before(flipAt) {
+			s.pendingFlipAt = flipAt
+		} else {
+			s.degraded = false
+		}
+	}
+
+	return s.degraded
+}
+
+func (s *OperatorState) clock() time.Time {
+	if s.now != nil {
+		return s.now()
+	}
+
+	return time.Now()
+}
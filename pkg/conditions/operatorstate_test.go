@@ -0,0 +1,100 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("OperatorState", func() {
+	var (
+		clock      time.Time
+		state      *OperatorState
+		conditions []metav1.Condition
+	)
+
+	failing := StateReport{Errors: []error{errors.New("failed to apply Deployment")}}
+
+	BeforeEach(func() {
+		clock = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		state = &OperatorState{
+			DegradedAfter:  time.Minute,
+			RecoveredAfter: 30 * time.Second,
+			now:            func() time.Time { return clock },
+		}
+		conditions = nil
+	})
+
+	It("should compute Available and Progressing from the report", func() {
+		state.Apply(&conditions, 1, StateReport{Progressing: []string{"Rolling out 1/3"}, Unavailable: []string{"No ready replica"}})
+
+		Expect(IsFalse(conditions, TypeAvailable)).To(BeTrue())
+		Expect(Get(conditions, TypeAvailable).Message).To(Equal("No ready replica"))
+		Expect(IsTrue(conditions, TypeProgressing)).To(BeTrue())
+		Expect(Get(conditions, TypeProgressing).Reason).To(Equal(ReasonInProgress))
+		Expect(IsFalse(conditions, TypeDegraded)).To(BeTrue())
+	})
+
+	It("should only become Degraded once errors persist", func() {
+		state.Apply(&conditions, 1, failing)
+		Expect(IsFalse(conditions, TypeDegraded)).To(BeTrue())
+		Expect(state.RequeueAfter()).To(Equal(time.Minute))
+
+		clock = clock.Add(30 * time.Second)
+		state.Apply(&conditions, 1, failing)
+		Expect(IsFalse(conditions, TypeDegraded)).To(BeTrue())
+		Expect(state.RequeueAfter()).To(Equal(30 * time.Second))
+
+		clock = clock.Add(30 * time.Second)
+		Expect(state.Apply(&conditions, 1, failing)).To(BeTrue())
+		Expect(IsTrue(conditions, TypeDegraded)).To(BeTrue())
+		Expect(Get(conditions, TypeDegraded).Message).To(Equal("failed to apply Deployment"))
+		Expect(state.RequeueAfter()).To(BeZero())
+	})
+
+	It("should reset the inertia when errors stop", func() {
+		state.Apply(&conditions, 1, failing)
+
+		clock = clock.Add(50 * time.Second)
+		state.Apply(&conditions, 1, StateReport{})
+
+		clock = clock.Add(50 * time.Second)
+		state.Apply(&conditions, 1, failing)
+		Expect(IsFalse(conditions, TypeDegraded)).To(BeTrue())
+	})
+
+	It("should hold Degraded until the recovery has lasted", func() {
+		state.DegradedAfter = 0
+		state.Apply(&conditions, 1, failing)
+		Expect(IsTrue(conditions, TypeDegraded)).To(BeTrue())
+
+		clock = clock.Add(time.Second)
+		state.Apply(&conditions, 1, StateReport{})
+		Expect(IsTrue(conditions, TypeDegraded)).To(BeTrue())
+		Expect(state.RequeueAfter()).To(Equal(30 * time.Second))
+
+		clock = clock.Add(30 * time.Second)
+		state.Apply(&conditions, 1, StateReport{})
+		Expect(IsFalse(conditions, TypeDegraded)).To(BeTrue())
+		Expect(Get(conditions, TypeDegraded).Reason).To(Equal(ReasonAsExpected))
+	})
+})
@@ -0,0 +1,141 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package deletionprotection provides a validating admission webhook handler that prevents the deletion of
+// designated resources, such as the singleton configuration resource of an operator, unless they are annotated to
+// force their deletion.
+package deletionprotection
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// DefaultForceDeleteAnnotation is the annotation that allows the deletion of a protected resource when set to "true".
+const DefaultForceDeleteAnnotation = "operator.openshift.io/force-delete"
+
+// ProtectedResource designates the resources protected from deletion.
+type ProtectedResource struct {
+	// GroupKind is the group and kind of the resources, e.g. operator.openshift.io, KubeAPIServer.
+	GroupKind schema.GroupKind
+
+	// Namespace restricts the protection to a namespace. Resources of any namespace match when empty.
+	Namespace string
+
+	// Name restricts the protection to a name, e.g. "cluster". Resources of any name match when empty.
+	Name string
+}
+
+// Policy tells which resources are protected from deletion and how to force their deletion.
+type Policy struct {
+	// Resources are the protected resources.
+	Resources []ProtectedResource
+
+	// ForceDeleteAnnotation allows the deletion of a protected resource when set to "true" on it.
+	// Defaults to DefaultForceDeleteAnnotation.
+	ForceDeleteAnnotation string
+}
+
+// Handler is a validating admission handler denying the deletion of the resources protected by its policy.
+// The ValidatingWebhookConfiguration must send it the DELETE operations of the protected resources.
+//
+// Example:
+//
+//	handler := &deletionprotection.Handler{Policy: deletionprotection.Policy{
+//	    Resources: []deletionprotection.ProtectedResource{{
+//	        GroupKind: schema.GroupKind{Group: "operator.openshift.io", Kind: "MyOperatorConfig"},
+//	        Name:      "cluster",
+//	    }},
+//	}}
+//	if err := handler.SetupWithManager(mgr, "/validate-deletion"); err != nil {
+//	    return err
+//	}
+type Handler struct {
+	Policy Policy
+}
+
+// SetupWithManager registers the handler on the webhook server of the manager at the given path.
+func (h *Handler) SetupWithManager(mgr ctrl.Manager, path string) error {
+	mgr.GetWebhookServer().Register(path, &admission.Webhook{Handler: h})
+
+	return nil
+}
+
+// Handle implements admission.Handler.
+func (h *Handler) Handle(_ context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Delete {
+		return admission.Allowed("")
+	}
+
+	groupKind := schema.GroupKind{Group: req.Kind.Group, Kind: req.Kind.Kind}
+	if !h.protects(groupKind, req.Namespace, req.Name) {
+		return admission.Allowed("")
+	}
+
+	object := &metav1.PartialObjectMetadata{}
+	if len(req.OldObject.Raw) > 0 {
+		if err := json.Unmarshal(req.OldObject.Raw, object); err != nil {
+			return admission.Errored(http.StatusBadRequest, fmt.Errorf("failed to decode the deleted object: %w", err))
+		}
+	}
+
+	annotation := h.forceDeleteAnnotation()
+	if object.Annotations[annotation] == "true" {
+		return admission.Allowed(fmt.Sprintf("deletion forced by the %s annotation", annotation))
+	}
+
+	return admission.Denied(fmt.Sprintf("%s %s is protected from deletion, set the %s annotation to \"true\" to force it",
+		groupKind.String(), qualifiedName(req.Namespace, req.Name), annotation))
+}
+
+// protects reports whether the policy protects the resource with the given kind, namespace and name.
+func (h *Handler) protects(groupKind schema.GroupKind, namespace, name string) bool {
+	for _, resource := range h.Policy.Resources {
+		if resource.GroupKind == groupKind &&
+			(resource.Namespace == "" || resource.Namespace == namespace) &&
+			(resource.Name == "" || resource.Name == name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// forceDeleteAnnotation returns the annotation forcing deletions.
+func (h *Handler) forceDeleteAnnotation() string {
+	if h.Policy.ForceDeleteAnnotation == "" {
+		return DefaultForceDeleteAnnotation
+	}
+
+	return h.Policy.ForceDeleteAnnotation
+}
+
+// qualifiedName returns namespace/name, or name for cluster-scoped resources.
+func qualifiedName(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+
+	return namespace + "/" + name
+}
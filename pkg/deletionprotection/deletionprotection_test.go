@@ -0,0 +1,93 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deletionprotection
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ = Describe("Handler", func() {
+	var handler *Handler
+
+	request := func(operation admissionv1.Operation, kind, name string, annotations map[string]string) admission.Request {
+		GinkgoHelper()
+
+		raw, err := json.Marshal(&metav1.PartialObjectMetadata{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "operator.openshift.io/v1", Kind: kind},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: annotations},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		return admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: operation,
+			Kind:      metav1.GroupVersionKind{Group: "operator.openshift.io", Version: "v1", Kind: kind},
+			Name:      name,
+			OldObject: runtime.RawExtension{Raw: raw},
+		}}
+	}
+
+	BeforeEach(func() {
+		handler = &Handler{Policy: Policy{Resources: []ProtectedResource{{
+			GroupKind: schema.GroupKind{Group: "operator.openshift.io", Kind: "Config"},
+			Name:      "cluster",
+		}}}}
+	})
+
+	It("should deny the deletion of protected resources", func() {
+		response := handler.Handle(ctx, request(admissionv1.Delete, "Config", "cluster", nil))
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring(DefaultForceDeleteAnnotation))
+	})
+
+	It("should allow forced deletions", func() {
+		response := handler.Handle(ctx, request(admissionv1.Delete, "Config", "cluster",
+			map[string]string{DefaultForceDeleteAnnotation: "true"}))
+		Expect(response.Allowed).To(BeTrue())
+
+		handler.Policy.ForceDeleteAnnotation = "example.com/force"
+		response = handler.Handle(ctx, request(admissionv1.Delete, "Config", "cluster",
+			map[string]string{DefaultForceDeleteAnnotation: "true"}))
+		Expect(response.Allowed).To(BeFalse())
+
+		response = handler.Handle(ctx, request(admissionv1.Delete, "Config", "cluster",
+			map[string]string{"example.com/force": "true"}))
+		Expect(response.Allowed).To(BeTrue())
+	})
+
+	It("should allow other operations and resources", func() {
+		Expect(handler.Handle(ctx, request(admissionv1.Update, "Config", "cluster", nil)).Allowed).To(BeTrue())
+		Expect(handler.Handle(ctx, request(admissionv1.Delete, "Config", "other", nil)).Allowed).To(BeTrue())
+		Expect(handler.Handle(ctx, request(admissionv1.Delete, "Other", "cluster", nil)).Allowed).To(BeTrue())
+	})
+
+	It("should fail on undecodable objects", func() {
+		req := request(admissionv1.Delete, "Config", "cluster", nil)
+		req.OldObject.Raw = []byte("{")
+
+		response := handler.Handle(ctx, req)
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Code).To(BeEquivalentTo(400))
+	})
+})
@@ -0,0 +1,210 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeevents "k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// DefaultVerbosity is the default verbosity events are logged at, by type.
+var DefaultVerbosity = map[string]int{
+	corev1.EventTypeNormal:  0,
+	corev1.EventTypeWarning: 0,
+}
+
+// MirrorOptions configures which events a Mirror logs and writes to a file.
+type MirrorOptions struct {
+	// Logger logs the events. Defaults to the "events" logger of controller-runtime.
+	Logger logr.Logger
+
+	// Verbosity is the verbosity the events are logged at, by type. Events of other types are not logged.
+	// Defaults to DefaultVerbosity.
+	Verbosity map[string]int
+
+	// Path is the optional path of a file the events are appended to, as JSON lines.
+	Path string
+
+	// FileTypes are the types of the events written to the file. Defaults to all types.
+	FileTypes []string
+}
+
+// Mirror is an events.EventRecorder mirroring the events it records into structured logs and, optionally, a file, so
+// that must-gather and pod logs contain the event stream even once the events have been garbage collected.
+//
+// Example:
+//
+//	mirror, err := events.NewMirror(mgr.GetEventRecorder("console-operator"), events.MirrorOptions{
+//	    Path: "/var/log/console-operator/events.log",
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	defer mirror.Close()
+//
+//	recorder := &events.Recorder{Recorder: mirror}
+type Mirror struct {
+	recorder kubeevents.EventRecorder
+	opts     MirrorOptions
+
+	mu   sync.Mutex
+	file *os.File
+}
+
+var _ kubeevents.EventRecorder = &Mirror{}
+
+// mirroredEvent is an event written to the file.
+type mirroredEvent struct {
+	Time      time.Time `json:"time"`
+	Type      string    `json:"type"`
+	Reason    string    `json:"reason"`
+	Action    string    `json:"action"`
+	Regarding string    `json:"regarding"`
+	Related   string    `json:"related,omitempty"`
+	Note      string    `json:"note"`
+}
+
+// NewMirror returns a Mirror of the events recorded by recorder, opening the file of the options if set.
+func NewMirror(recorder kubeevents.EventRecorder, opts MirrorOptions) (*Mirror, error) {
+	if opts.Logger.GetSink() == nil {
+		opts.Logger = ctrl.Log.WithName("events")
+	}
+
+	if opts.Verbosity == nil {
+		opts.Verbosity = DefaultVerbosity
+	}
+
+	m := &Mirror{recorder: recorder, opts: opts}
+
+	if opts.Path != "" {
+		file, err := os.OpenFile(opts.Path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open event file %q: %w", opts.Path, err)
+		}
+
+		m.file = file
+	}
+
+	return m, nil
+}
+
+// Eventf records the event and mirrors it.
+func (m *Mirror) Eventf(regarding, related runtime.Object, eventtype, reason, action, note string, args ...any) {
+	m.recorder.Eventf(regarding, related, eventtype, reason, action, note, args...)
+
+	message := note
+	if len(args) > 0 {
+		message = fmt.Sprintf(note, args...)
+	}
+
+	if verbosity, ok := m.opts.Verbosity[eventtype]; ok {
+		keysAndValues := []any{"type", eventtype, "reason", reason, "action", action, "regarding", describe(regarding)}
+		if related != nil {
+			keysAndValues = append(keysAndValues, "related", describe(related))
+		}
+
+		m.opts.Logger.V(verbosity).Info(message, keysAndValues...)
+	}
+
+	// The file is checked by write, under the lock, as Close may be called concurrently.
+	if m.opts.Path != "" && (len(m.opts.FileTypes) == 0 || slices.Contains(m.opts.FileTypes, eventtype)) {
+		m.write(mirroredEvent{
+			Time:      time.Now(),
+			Type:      eventtype,
+			Reason:    reason,
+			Action:    action,
+			Regarding: describe(regarding),
+			Related:   describe(related),
+			Note:      message,
+		})
+	}
+}
+
+// Close closes the file of the Mirror, if any. Events recorded afterwards are no longer written to it.
+func (m *Mirror) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.file == nil {
+		return nil
+	}
+
+	err := m.file.Close()
+	m.file = nil
+
+	if err != nil {
+		return fmt.Errorf("failed to close event file %q: %w", m.opts.Path, err)
+	}
+
+	return nil
+}
+
+// write appends the event to the file. Failures are logged, as recording events does not fail.
+func (m *Mirror) write(event mirroredEvent) {
+	line, err := json.Marshal(event)
+	if err != nil {
+		m.opts.Logger.Error(err, "Failed to encode event")
+
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.file == nil {
+		return
+	}
+
+	if _, err := m.file.Write(append(line, '\n')); err != nil {
+		m.opts.Logger.Error(err, "Failed to write event", "path", m.opts.Path)
+	}
+}
+
+// describe returns the kind, namespace and name of an object, e.g. ConfigMap/ns/name, or an empty string for nil.
+func describe(obj runtime.Object) string {
+	if obj == nil || reflect.ValueOf(obj).IsNil() {
+		return ""
+	}
+
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	if kind == "" {
+		kind = reflect.Indirect(reflect.ValueOf(obj)).Type().Name()
+	}
+
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return kind
+	}
+
+	if accessor.GetNamespace() == "" {
+		return kind + "/" + accessor.GetName()
+	}
+
+	return kind + "/" + accessor.GetNamespace() + "/" + accessor.GetName()
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeevents "k8s.io/client-go/tools/events"
+)
+
+var _ = Describe("Mirror", func() {
+	var (
+		fake *kubeevents.FakeRecorder
+		logs []string
+		obj  *corev1.ConfigMap
+		opts MirrorOptions
+	)
+
+	BeforeEach(func() {
+		fake = kubeevents.NewFakeRecorder(100)
+		logs = nil
+		obj = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "config"}}
+		opts = MirrorOptions{
+			Logger: funcr.New(func(_, args string) { logs = append(logs, args) }, funcr.Options{Verbosity: 1}),
+		}
+	})
+
+	It("should record and log the events", func() {
+		mirror, err := NewMirror(fake, opts)
+		Expect(err).NotTo(HaveOccurred())
+
+		mirror.Eventf(obj, nil, corev1.EventTypeWarning, "Failed", "Apply", "failed to apply %s", "config")
+
+		Expect(fake.Events).To(Receive(Equal("Warning Failed failed to apply config")))
+		Expect(logs).To(ConsistOf(And(
+			ContainSubstring(`"msg"="failed to apply config"`),
+			ContainSubstring(`"type"="Warning"`),
+			ContainSubstring(`"reason"="Failed"`),
+			ContainSubstring(`"regarding"="ConfigMap/ns/config"`),
+		)))
+	})
+
+	It("should log the events at the verbosity of their type", func() {
+		opts.Verbosity = map[string]int{corev1.EventTypeNormal: 2, corev1.EventTypeWarning: 1}
+		mirror, err := NewMirror(fake, opts)
+		Expect(err).NotTo(HaveOccurred())
+
+		mirror.Eventf(obj, nil, corev1.EventTypeNormal, "Applied", "Apply", "applied")
+		mirror.Eventf(obj, nil, corev1.EventTypeWarning, "Failed", "Apply", "failed")
+
+		Expect(fake.Events).To(HaveLen(2))
+		Expect(logs).To(ConsistOf(ContainSubstring(`"reason"="Failed"`)))
+	})
+
+	It("should write the events of the file types to the file", func() {
+		opts.Path = filepath.Join(GinkgoT().TempDir(), "events.log")
+		opts.FileTypes = []string{corev1.EventTypeWarning}
+		mirror, err := NewMirror(fake, opts)
+		Expect(err).NotTo(HaveOccurred())
+
+		mirror.Eventf(obj, nil, corev1.EventTypeNormal, "Applied", "Apply", "applied")
+		mirror.Eventf(obj, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "secret"}}, corev1.EventTypeWarning, "Failed", "Apply", "failed")
+		Expect(mirror.Close()).To(Succeed())
+		mirror.Eventf(obj, nil, corev1.EventTypeWarning, "Failed", "Apply", "failed after close")
+
+		content, err := os.ReadFile(opts.Path)
+		Expect(err).NotTo(HaveOccurred())
+
+		lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+		Expect(lines).To(HaveLen(1))
+
+		var event mirroredEvent
+		Expect(json.Unmarshal([]byte(lines[0]), &event)).To(Succeed())
+		Expect(event.Type).To(Equal(corev1.EventTypeWarning))
+		Expect(event.Reason).To(Equal("Failed"))
+		Expect(event.Regarding).To(Equal("ConfigMap/ns/config"))
+		Expect(event.Related).To(Equal("Secret/ns/secret"))
+		Expect(event.Note).To(Equal("failed"))
+	})
+
+	It("should write the events concurrently with Close", func() {
+		opts.Path = filepath.Join(GinkgoT().TempDir(), "events.log")
+		opts.Logger = logr.Discard()
+		mirror, err := NewMirror(kubeevents.NewFakeRecorder(100), opts)
+		Expect(err).NotTo(HaveOccurred())
+
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Go(func() {
+				mirror.Eventf(obj, nil, corev1.EventTypeWarning, "Failed", "Apply", "failed")
+			})
+		}
+
+		Expect(mirror.Close()).To(Succeed())
+		wg.Wait()
+	})
+
+	It("should fail when the file cannot be opened", func() {
+		opts.Path = filepath.Join(GinkgoT().TempDir(), "missing", "events.log")
+
+		_, err := NewMirror(fake, opts)
+		Expect(err).To(MatchError(ContainSubstring("failed to open event file")))
+	})
+})
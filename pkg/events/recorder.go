@@ -0,0 +1,311 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package events provides event recorder wrappers deduplicating and rate limiting the events of operators, so that
+// repeated reconciliations do not flood etcd with identical events, and mirroring them into logs.
+package events
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeevents "k8s.io/client-go/tools/events"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	// DefaultWindow is the default window within which identical events are recorded once.
+	DefaultWindow = 5 * time.Minute
+
+	// DefaultObjectQPS is the default rate of events recorded per object, one every 5 minutes once the burst is spent,
+	// as for the client-go event spam filter.
+	DefaultObjectQPS = 1.0 / 300
+	// DefaultObjectBurst is the default number of events recorded per object at once.
+	DefaultObjectBurst = 25
+)
+
+// CorrelatorFunc returns the key of an event: events with the same key within the window are recorded once.
+type CorrelatorFunc func(regarding runtime.Object, eventtype, reason, action, message string) string
+
+// DefaultCorrelator correlates the events of the same object with the same type, reason, action and message.
+func DefaultCorrelator(regarding runtime.Object, eventtype, reason, action, message string) string {
+	return strings.Join([]string{objectKey(regarding), eventtype, reason, action, message}, "\x00")
+}
+
+// ReasonCorrelator correlates the events of the same object with the same type, reason and action, regardless of
+// their message. It suits events whose message changes at every occurrence, e.g. because it includes an error.
+func ReasonCorrelator(regarding runtime.Object, eventtype, reason, action, _ string) string {
+	return strings.Join([]string{objectKey(regarding), eventtype, reason, action}, "\x00")
+}
+
+// Recorder is an events.EventRecorder recording identical events once per window and rate limiting the events of
+// each object. The occurrences it holds back are recorded as a single event, with their number, when the window
+// expires or the Recorder stops, so that no event is lost.
+//
+// Example:
+//
+//	recorder := &events.Recorder{Recorder: mgr.GetEventRecorder("console-operator")}
+//	if err := recorder.SetupWithManager(mgr); err != nil {
+//	    return err
+//	}
+type Recorder struct {
+	// Recorder records the events.
+	Recorder kubeevents.EventRecorder
+
+	// Window is the window within which identical events are recorded once. Defaults to DefaultWindow.
+	Window time.Duration
+
+	// ObjectQPS is the rate of events recorded per object. Defaults to DefaultObjectQPS.
+	ObjectQPS float64
+	// ObjectBurst is the number of events recorded per object at once. Defaults to DefaultObjectBurst.
+	ObjectBurst int
+
+	// Correlator returns the key of the identical events. Defaults to DefaultCorrelator.
+	Correlator CorrelatorFunc
+
+	clock clock.PassiveClock
+
+	mu       sync.Mutex
+	pending  map[string]*pendingEvent
+	limiters map[string]*rate.Limiter
+}
+
+var _ kubeevents.EventRecorder = &Recorder{}
+
+// pendingEvent is an event recorded, or held back, within the window.
+type pendingEvent struct {
+	regarding, related        runtime.Object
+	eventtype, reason, action string
+	message                   string
+	expires                   time.Time
+	occurrences               int
+}
+
+// SetupWithManager adds the Recorder to the manager, which flushes the held back events when it stops.
+func (r *Recorder) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.Add(r); err != nil {
+		return fmt.Errorf("failed to add event recorder to manager: %w", err)
+	}
+
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+// Events are recorded by every replica, so the Recorder runs on all of them.
+func (r *Recorder) NeedLeaderElection() bool {
+	return false
+}
+
+// Start records the held back events whose window has expired, until the context is done, then records all the
+// held back events.
+func (r *Recorder) Start(ctx context.Context) error {
+	ticker := time.NewTicker(r.window())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			r.Flush()
+
+			return nil
+		case <-ticker.C:
+			r.flush(false)
+		}
+	}
+}
+
+// Eventf records an event, unless an identical event has been recorded within the window or the object has exceeded
+// its rate of events, in which case the event is held back.
+func (r *Recorder) Eventf(regarding, related runtime.Object, eventtype, reason, action, note string, args ...any) {
+	message := note
+	if len(args) > 0 {
+		message = fmt.Sprintf(note, args...)
+	}
+
+	key := r.correlator()(regarding, eventtype, reason, action, message)
+
+	r.mu.Lock()
+
+	now := r.now()
+	if r.pending == nil {
+		r.pending = map[string]*pendingEvent{}
+		r.limiters = map[string]*rate.Limiter{}
+	}
+
+	var expired *pendingEvent
+	if event, ok := r.pending[key]; ok {
+		if now.Before(event.expires) {
+			// Keep the latest objects and message, which may differ with a custom correlator.
+			event.regarding, event.related, event.message = regarding, related, message
+			event.occurrences++
+			r.mu.Unlock()
+
+			return
+		}
+
+		delete(r.pending, key)
+
+		if event.occurrences > 0 {
+			expired = event
+		}
+	}
+
+	event := &pendingEvent{
+		regarding: regarding,
+		related:   related,
+		eventtype: eventtype,
+		reason:    reason,
+		action:    action,
+		message:   message,
+		expires:   now.Add(r.window()),
+	}
+	r.pending[key] = event
+
+	record := r.limiter(regarding).AllowN(now, 1)
+	if !record {
+		event.occurrences++
+	}
+
+	r.mu.Unlock()
+
+	if expired != nil {
+		r.record(expired)
+	}
+
+	if record {
+		r.Recorder.Eventf(regarding, related, eventtype, reason, action, "%s", message)
+	}
+}
+
+// Flush records all the held back events.
+func (r *Recorder) Flush() {
+	r.flush(true)
+}
+
+// flush records the held back events whose window has expired, or all of them.
+func (r *Recorder) flush(all bool) {
+	r.mu.Lock()
+
+	now := r.now()
+
+	var flushed []*pendingEvent
+
+	for _, key := range slices.Sorted(maps.Keys(r.pending)) {
+		event := r.pending[key]
+		if !all && now.Before(event.expires) {
+			continue
+		}
+
+		delete(r.pending, key)
+
+		if event.occurrences > 0 {
+			flushed = append(flushed, event)
+		}
+	}
+
+	// Forget the limiters of the objects which have not recorded events recently.
+	for key, limiter := range r.limiters {
+		if limiter.TokensAt(now) >= float64(limiter.Burst()) {
+			delete(r.limiters, key)
+		}
+	}
+
+	r.mu.Unlock()
+
+	for _, event := range flushed {
+		r.record(event)
+	}
+}
+
+// record records a held back event with its number of occurrences.
+func (r *Recorder) record(event *pendingEvent) {
+	message := event.message
+	if event.occurrences > 1 {
+		message = fmt.Sprintf("%s (repeated %d times)", message, event.occurrences)
+	}
+
+	r.Recorder.Eventf(event.regarding, event.related, event.eventtype, event.reason, event.action, "%s", message)
+}
+
+// limiter returns the limiter of the events of the object, with r.mu held.
+func (r *Recorder) limiter(regarding runtime.Object) *rate.Limiter {
+	key := objectKey(regarding)
+
+	limiter, ok := r.limiters[key]
+	if !ok {
+		qps := r.ObjectQPS
+		if qps <= 0 {
+			qps = DefaultObjectQPS
+		}
+
+		burst := r.ObjectBurst
+		if burst <= 0 {
+			burst = DefaultObjectBurst
+		}
+
+		limiter = rate.NewLimiter(rate.Limit(qps), burst)
+		r.limiters[key] = limiter
+	}
+
+	return limiter
+}
+
+func (r *Recorder) window() time.Duration {
+	if r.Window > 0 {
+		return r.Window
+	}
+
+	return DefaultWindow
+}
+
+func (r *Recorder) correlator() CorrelatorFunc {
+	if r.Correlator != nil {
+		return r.Correlator
+	}
+
+	return DefaultCorrelator
+}
+
+func (r *Recorder) now() time.Time {
+	if r.clock != nil {
+		return r.clock.Now()
+	}
+
+	return time.Now()
+}
+
+// objectKey returns the key identifying an object: its type and UID, or its namespace and name when it has no UID.
+func objectKey(obj runtime.Object) string {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return fmt.Sprintf("%T", obj)
+	}
+
+	if uid := accessor.GetUID(); uid != "" {
+		return fmt.Sprintf("%T/%s", obj, uid)
+	}
+
+	return fmt.Sprintf("%T/%s/%s", obj, accessor.GetNamespace(), accessor.GetName())
+}
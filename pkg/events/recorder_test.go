@@ -0,0 +1,108 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeevents "k8s.io/client-go/tools/events"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+var _ = Describe("Recorder", func() {
+	var (
+		fake     *kubeevents.FakeRecorder
+		clock    *clocktesting.FakeClock
+		recorder *Recorder
+		obj      *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		fake = kubeevents.NewFakeRecorder(100)
+		clock = clocktesting.NewFakeClock(time.Now())
+		recorder = &Recorder{Recorder: fake, Window: time.Minute, clock: clock}
+		obj = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "config", UID: "uid"}}
+	})
+
+	It("should record identical events once per window with their number of occurrences", func() {
+		for range 3 {
+			recorder.Eventf(obj, nil, corev1.EventTypeWarning, "Failed", "Apply", "failed to apply %s", "config")
+		}
+
+		Expect(fake.Events).To(Receive(Equal("Warning Failed failed to apply config")))
+		Expect(fake.Events).NotTo(Receive())
+
+		clock.Step(time.Minute)
+		recorder.Eventf(obj, nil, corev1.EventTypeWarning, "Failed", "Apply", "failed to apply %s", "config")
+
+		Expect(fake.Events).To(Receive(Equal("Warning Failed failed to apply config (repeated 2 times)")))
+		Expect(fake.Events).To(Receive(Equal("Warning Failed failed to apply config")))
+		Expect(fake.Events).NotTo(Receive())
+	})
+
+	It("should record different events", func() {
+		recorder.Eventf(obj, nil, corev1.EventTypeNormal, "Applied", "Apply", "applied")
+		recorder.Eventf(obj, nil, corev1.EventTypeWarning, "Failed", "Apply", "failed")
+		recorder.Eventf(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "other"}}, nil, corev1.EventTypeNormal, "Applied", "Apply", "applied")
+
+		Expect(fake.Events).To(HaveLen(3))
+	})
+
+	It("should correlate events with the correlator", func() {
+		recorder.Correlator = ReasonCorrelator
+
+		recorder.Eventf(obj, nil, corev1.EventTypeWarning, "Failed", "Apply", "conflict 1")
+		recorder.Eventf(obj, nil, corev1.EventTypeWarning, "Failed", "Apply", "conflict 2")
+		recorder.Flush()
+
+		Expect(fake.Events).To(Receive(Equal("Warning Failed conflict 1")))
+		Expect(fake.Events).To(Receive(Equal("Warning Failed conflict 2")))
+		Expect(fake.Events).NotTo(Receive())
+	})
+
+	It("should rate limit the events of an object", func() {
+		recorder.ObjectQPS = 1
+		recorder.ObjectBurst = 1
+
+		recorder.Eventf(obj, nil, corev1.EventTypeNormal, "Applied", "Apply", "applied 1")
+		recorder.Eventf(obj, nil, corev1.EventTypeNormal, "Applied", "Apply", "applied 2")
+
+		Expect(fake.Events).To(Receive(Equal("Normal Applied applied 1")))
+		Expect(fake.Events).NotTo(Receive())
+
+		recorder.Flush()
+		Expect(fake.Events).To(Receive(Equal("Normal Applied applied 2")))
+	})
+
+	It("should record the held back events when it stops", func() {
+		recorder.Eventf(obj, nil, corev1.EventTypeNormal, "Applied", "Apply", "applied")
+		recorder.Eventf(obj, nil, corev1.EventTypeNormal, "Applied", "Apply", "applied")
+		Expect(fake.Events).To(Receive(Equal("Normal Applied applied")))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		Expect(recorder.Start(ctx)).To(Succeed())
+
+		Expect(fake.Events).To(Receive(Equal("Normal Applied applied")))
+		Expect(fake.Events).NotTo(Receive())
+	})
+})
@@ -0,0 +1,258 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package expectations tracks the creations and deletions a reconciler expects to observe in the cache, so that it does
+// not act on a stale cache, e.g. create an operand twice because the first creation was not observed yet. It ports the
+// expectations of the kube-controller-manager.
+package expectations
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/openshift/controller-runtime-common/pkg/reconcileerr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// DefaultTTL is how long expectations are waited for by default before being considered satisfied, so that missed
+// events do not block a reconciler forever.
+const DefaultTTL = 5 * time.Minute
+
+// ErrUnsatisfied is wrapped by the errors returned by Await while expectations are pending.
+var ErrUnsatisfied = errors.New("expectations are not satisfied")
+
+// OwnerFunc returns the owner expecting the creation or deletion of an object, and false when there is none.
+type OwnerFunc func(obj client.Object) (types.NamespacedName, bool)
+
+// ControllerOf returns the key of the controller of obj, assuming it is in the namespace of obj.
+// Cluster-scoped owners of namespaced objects need a custom OwnerFunc.
+func ControllerOf(obj client.Object) (types.NamespacedName, bool) {
+	ref := metav1.GetControllerOf(obj)
+	if ref == nil {
+		return types.NamespacedName{}, false
+	}
+
+	return types.NamespacedName{Namespace: obj.GetNamespace(), Name: ref.Name}, true
+}
+
+// Tracker tracks the creations and deletions expected by owners. It is safe for concurrent use.
+//
+// Reconcilers call Await before acting on the objects of an owner, ExpectCreations before creating objects and
+// ExpectDeletions before deleting objects, and lower the expectations of failed requests with CreationObserved and
+// DeletionObserved. The controller observes the events of the objects with the Observe predicate.
+//
+// Example:
+//
+//	tracker := &expectations.Tracker{}
+//	err := ctrl.NewControllerManagedBy(mgr).
+//	    For(&v1alpha1.Operand{}).
+//	    Owns(&corev1.Pod{}, builder.WithPredicates(tracker.Observe(nil))).
+//	    Complete(r)
+//
+//	// In Reconcile:
+//	if err := tracker.Await(req.NamespacedName); err != nil {
+//	    return reconcileerr.ToResult(err)
+//	}
+//	tracker.ExpectCreations(req.NamespacedName, 1)
+//	if err := r.Create(ctx, pod); err != nil {
+//	    tracker.CreationObserved(req.NamespacedName)
+//	    return ctrl.Result{}, err
+//	}
+type Tracker struct {
+	// TTL is how long expectations are waited for before being considered satisfied. Defaults to DefaultTTL.
+	TTL time.Duration
+
+	// now returns the current time, it is replaced in tests.
+	now func() time.Time
+
+	mu           sync.Mutex
+	expectations map[types.NamespacedName]*expectation
+}
+
+// expectation is what an owner expects to observe.
+type expectation struct {
+	creations int
+	deletions map[types.UID]struct{}
+	expiresAt time.Time
+}
+
+// satisfied reports whether nothing is expected anymore.
+func (e *expectation) satisfied() bool {
+	return e.creations <= 0 && len(e.deletions) == 0
+}
+
+// ExpectCreations expects count more creations of objects of the owner.
+func (t *Tracker) ExpectCreations(owner types.NamespacedName, count int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.expectation(owner).creations += count
+}
+
+// ExpectDeletions expects the deletions of the objects of the owner.
+func (t *Tracker) ExpectDeletions(owner types.NamespacedName, objs ...client.Object) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	e := t.expectation(owner)
+	for _, obj := range objs {
+		e.deletions[obj.GetUID()] = struct{}{}
+	}
+}
+
+// CreationObserved lowers the creations expected by the owner, either because one was observed or because it failed.
+func (t *Tracker) CreationObserved(owner types.NamespacedName) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if e, ok := t.expectations[owner]; ok && e.creations > 0 {
+		e.creations--
+	}
+}
+
+// DeletionObserved lowers the deletions expected by the owner, either because it was observed or because it failed.
+func (t *Tracker) DeletionObserved(owner types.NamespacedName, obj client.Object) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if e, ok := t.expectations[owner]; ok {
+		delete(e.deletions, obj.GetUID())
+	}
+}
+
+// Satisfied reports whether the owner expects nothing, or its expectations expired.
+func (t *Tracker) Satisfied(owner types.NamespacedName) bool {
+	_, satisfied := t.pending(owner)
+	return satisfied
+}
+
+// Await returns nil when the owner's expectations are satisfied, and otherwise an error wrapping ErrUnsatisfied that
+// reconcileerr.ToResult converts to a requeue once the expectations expire. Observing the expected events enqueues
+// the owner earlier, when the controller watches the objects.
+func (t *Tracker) Await(owner types.NamespacedName) error {
+	e, satisfied := t.pending(owner)
+	if satisfied {
+		return nil
+	}
+
+	return reconcileerr.RequeueAfter(t.expiresIn(e), fmt.Errorf("%w: waiting for %d creations and %d deletions of %s",
+		ErrUnsatisfied, max(e.creations, 0), len(e.deletions), owner.String()))
+}
+
+// Forget forgets the expectations of the owner, e.g. once it is deleted.
+func (t *Tracker) Forget(owner types.NamespacedName) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.expectations, owner)
+}
+
+// Observe returns a predicate lowering the expectations of the owners of the objects of its events, returned by
+// ownerOf, or ControllerOf when nil. Creations are observed on create events, and deletions on delete events and
+// on update events setting the deletion timestamp. The predicate passes all events.
+func (t *Tracker) Observe(ownerOf OwnerFunc) predicate.Predicate {
+	if ownerOf == nil {
+		ownerOf = ControllerOf
+	}
+
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			if owner, ok := ownerOf(e.Object); ok {
+				t.CreationObserved(owner)
+			}
+
+			return true
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if e.ObjectOld.GetDeletionTimestamp() == nil && e.ObjectNew.GetDeletionTimestamp() != nil {
+				if owner, ok := ownerOf(e.ObjectNew); ok {
+					t.DeletionObserved(owner, e.ObjectNew)
+				}
+			}
+
+			return true
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			if owner, ok := ownerOf(e.Object); ok {
+				t.DeletionObserved(owner, e.Object)
+			}
+
+			return true
+		},
+	}
+}
+
+// pending returns a copy of the pending expectation of the owner, and whether it is satisfied or expired, in which
+// case it is forgotten.
+func (t *Tracker) pending(owner types.NamespacedName) (expectation, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	e, ok := t.expectations[owner]
+	if !ok {
+		return expectation{}, true
+	}
+
+	if e.satisfied() || !t.clock().Before(e.expiresAt) {
+		delete(t.expectations, owner)
+
+		return expectation{}, true
+	}
+
+	return expectation{creations: e.creations, deletions: maps.Clone(e.deletions), expiresAt: e.expiresAt}, false
+}
+
+// expectation returns the expectation of the owner, renewing its expiry. The lock must be held.
+func (t *Tracker) expectation(owner types.NamespacedName) *expectation {
+	if t.expectations == nil {
+		t.expectations = map[types.NamespacedName]*expectation{}
+	}
+
+	e, ok := t.expectations[owner]
+	if !ok || !t.clock().Before(e.expiresAt) {
+		e = &expectation{deletions: map[types.UID]struct{}{}}
+		t.expectations[owner] = e
+	}
+
+	ttl := t.TTL
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+
+	e.expiresAt = t.clock().Add(ttl)
+
+	return e
+}
+
+// expiresIn returns how long until the expectation expires.
+func (t *Tracker) expiresIn(e expectation) time.Duration {
+	return max(e.expiresAt.Sub(t.clock()), time.Second)
+}
+
+func (t *Tracker) clock() time.Time {
+	if t.now != nil {
+		return t.now()
+	}
+
+	return time.Now()
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package expectations
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/controller-runtime-common/pkg/reconcileerr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+var _ = Describe("Tracker", func() {
+	var (
+		tracker *Tracker
+		now     time.Time
+	)
+
+	owner := types.NamespacedName{Namespace: "ns", Name: "owner"}
+
+	pod := func(uid types.UID) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      string(uid),
+			UID:       uid,
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "example.com/v1", Kind: "Operand", Name: "owner", UID: "owner-uid", Controller: ptr.To(true)},
+			},
+		}}
+	}
+
+	BeforeEach(func() {
+		now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		tracker = &Tracker{TTL: time.Minute, now: func() time.Time { return now }}
+	})
+
+	It("should be satisfied without expectations", func() {
+		Expect(tracker.Satisfied(owner)).To(BeTrue())
+		Expect(tracker.Await(owner)).To(Succeed())
+	})
+
+	It("should wait for the expected creations to be observed", func() {
+		tracker.ExpectCreations(owner, 2)
+
+		err := tracker.Await(owner)
+		Expect(err).To(MatchError(ErrUnsatisfied))
+		Expect(reconcileerr.ToResult(err)).To(HaveField("RequeueAfter", time.Minute))
+
+		observe := tracker.Observe(nil)
+		Expect(observe.Create(event.CreateEvent{Object: pod("first")})).To(BeTrue())
+		Expect(tracker.Satisfied(owner)).To(BeFalse())
+
+		// A failed creation is never observed.
+		tracker.CreationObserved(owner)
+		Expect(tracker.Satisfied(owner)).To(BeTrue())
+	})
+
+	It("should wait for the expected deletions to be observed", func() {
+		first, second := pod("first"), pod("second")
+		tracker.ExpectDeletions(owner, first, second)
+
+		observe := tracker.Observe(nil)
+		Expect(observe.Delete(event.DeleteEvent{Object: pod("other")})).To(BeTrue())
+		Expect(observe.Delete(event.DeleteEvent{Object: first})).To(BeTrue())
+		Expect(tracker.Satisfied(owner)).To(BeFalse())
+
+		deleting := second.DeepCopy()
+		deleting.DeletionTimestamp = &metav1.Time{Time: now}
+		Expect(observe.Update(event.UpdateEvent{ObjectOld: second, ObjectNew: deleting})).To(BeTrue())
+		Expect(tracker.Satisfied(owner)).To(BeTrue())
+	})
+
+	It("should ignore the events of objects of other owners", func() {
+		tracker.ExpectCreations(owner, 1)
+
+		orphan := pod("orphan")
+		orphan.OwnerReferences = nil
+		other := pod("other")
+		other.Namespace = "other"
+
+		observe := tracker.Observe(nil)
+		Expect(observe.Create(event.CreateEvent{Object: orphan})).To(BeTrue())
+		Expect(observe.Create(event.CreateEvent{Object: other})).To(BeTrue())
+		Expect(tracker.Satisfied(owner)).To(BeFalse())
+	})
+
+	It("should expire the expectations", func() {
+		tracker.ExpectCreations(owner, 1)
+
+		now = now.Add(30 * time.Second)
+		Expect(reconcileerr.ToResult(tracker.Await(owner))).To(HaveField("RequeueAfter", 30*time.Second))
+
+		now = now.Add(30 * time.Second)
+		Expect(tracker.Satisfied(owner)).To(BeTrue())
+
+		// Expectations raised after the expiry start afresh.
+		tracker.ExpectCreations(owner, 1)
+		tracker.CreationObserved(owner)
+		Expect(tracker.Satisfied(owner)).To(BeTrue())
+	})
+
+	It("should forget the expectations of an owner", func() {
+		tracker.ExpectCreations(owner, 1)
+		tracker.Forget(owner)
+		Expect(tracker.Satisfied(owner)).To(BeTrue())
+	})
+})
@@ -0,0 +1,159 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package finalizer provides helpers to manage finalizers and a reconciler running cleanup before deletion.
+package finalizer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openshift/controller-runtime-common/pkg/reconcileerr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// DefaultRequeueAfter is the delay after which the cleanup of an object is checked again while it is not done.
+const DefaultRequeueAfter = 10 * time.Second
+
+// EnsurePresent adds the finalizer to obj with a patch, unless it is already present or obj is being deleted,
+// as finalizers cannot be added to deleted objects. It returns whether obj was patched.
+func EnsurePresent(ctx context.Context, c client.Client, obj client.Object, finalizer string) (bool, error) {
+	if !obj.GetDeletionTimestamp().IsZero() || controllerutil.ContainsFinalizer(obj, finalizer) {
+		return false, nil
+	}
+
+	patch := client.MergeFromWithOptions(obj.DeepCopyObject().(client.Object), client.MergeFromWithOptimisticLock{})
+	controllerutil.AddFinalizer(obj, finalizer)
+
+	if err := c.Patch(ctx, obj, patch); err != nil {
+		return false, fmt.Errorf("failed to add finalizer %s to %T %s: %w", finalizer, obj, client.ObjectKeyFromObject(obj).String(), err)
+	}
+
+	return true, nil
+}
+
+// EnsureRemoved removes the finalizer from obj with a patch, unless it is already absent.
+// It returns whether obj was patched. An object that no longer exists is not an error.
+func EnsureRemoved(ctx context.Context, c client.Client, obj client.Object, finalizer string) (bool, error) {
+	if !controllerutil.ContainsFinalizer(obj, finalizer) {
+		return false, nil
+	}
+
+	patch := client.MergeFromWithOptions(obj.DeepCopyObject().(client.Object), client.MergeFromWithOptimisticLock{})
+	controllerutil.RemoveFinalizer(obj, finalizer)
+
+	if err := c.Patch(ctx, obj, patch); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("failed to remove finalizer %s from %T %s: %w", finalizer, obj, client.ObjectKeyFromObject(obj).String(), err)
+	}
+
+	return true, nil
+}
+
+// Handler cleans up what an object owns before it is deleted.
+type Handler[T client.Object] interface {
+	// Finalize runs or advances the cleanup of obj. It returns whether the cleanup is done, after which the
+	// finalizer is removed. It is called again until the cleanup is done, so it must be idempotent.
+	Finalize(ctx context.Context, obj T) (done bool, err error)
+}
+
+// HandlerFunc is a function implementing Handler.
+type HandlerFunc[T client.Object] func(ctx context.Context, obj T) (bool, error)
+
+// Finalize implements Handler.
+func (f HandlerFunc[T]) Finalize(ctx context.Context, obj T) (bool, error) {
+	return f(ctx, obj)
+}
+
+// Reconciler adds the finalizer to objects before reconciling them, and runs the handler once they are deleted,
+// removing the finalizer when the cleanup is done. It is an object reconciler to use with reconcileerr.Reconciler.
+//
+// While the cleanup is not done, a reconcileerr.RequeueAfter error is returned so that the object is checked
+// again after RequeueAfter. Deleted objects without the finalizer are ignored.
+//
+// Example:
+//
+//	r := &reconcileerr.Reconciler[*v1alpha1.Operand]{
+//	    Client:     mgr.GetClient(),
+//	    NewObject:  func() *v1alpha1.Operand { return &v1alpha1.Operand{} },
+//	    Conditions: func(o *v1alpha1.Operand) *[]metav1.Condition { return &o.Status.Conditions },
+//	    Object: &finalizer.Reconciler[*v1alpha1.Operand]{
+//	        Client:    mgr.GetClient(),
+//	        Finalizer: "operator.openshift.io/operand-cleanup",
+//	        Handler:   cleanupHandler,
+//	        Object:    operandReconciler,
+//	    },
+//	}
+type Reconciler[T client.Object] struct {
+	Client client.Client
+
+	// Finalizer is the name of the finalizer, e.g. operator.openshift.io/cleanup.
+	Finalizer string
+
+	// Handler cleans up deleted objects.
+	Handler Handler[T]
+
+	// Object reconciles objects that are not deleted.
+	Object reconcileerr.ObjectReconciler[T]
+
+	// RequeueAfter is the delay after which the cleanup is checked again while it is not done.
+	// Defaults to DefaultRequeueAfter.
+	RequeueAfter time.Duration
+}
+
+// Reconcile implements reconcileerr.ObjectReconciler.
+func (r *Reconciler[T]) Reconcile(ctx context.Context, obj T) error {
+	if obj.GetDeletionTimestamp().IsZero() {
+		if _, err := EnsurePresent(ctx, r.Client, obj, r.Finalizer); err != nil {
+			return err
+		}
+
+		return r.Object.Reconcile(ctx, obj)
+	}
+
+	if !controllerutil.ContainsFinalizer(obj, r.Finalizer) {
+		return nil
+	}
+
+	done, err := r.Handler.Finalize(ctx, obj)
+	if err != nil {
+		return fmt.Errorf("failed to finalize %T %s: %w", obj, client.ObjectKeyFromObject(obj).String(), err)
+	}
+
+	if !done {
+		requeueAfter := r.RequeueAfter
+		if requeueAfter == 0 {
+			requeueAfter = DefaultRequeueAfter
+		}
+
+		return reconcileerr.RequeueAfter(requeueAfter, fmt.Errorf("waiting for the cleanup of %T %s", obj, client.ObjectKeyFromObject(obj).String()))
+	}
+
+	if _, err := EnsureRemoved(ctx, r.Client, obj, r.Finalizer); err != nil {
+		return err
+	}
+
+	log.FromContext(ctx).V(1).Info("Cleanup done, removed finalizer", "finalizer", r.Finalizer)
+
+	return nil
+}
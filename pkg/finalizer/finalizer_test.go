@@ -0,0 +1,186 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package finalizer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/controller-runtime-common/pkg/reconcileerr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testFinalizer = "operator.openshift.io/cleanup"
+
+var _ = Describe("EnsurePresent and EnsureRemoved", func() {
+	var (
+		fakeClient client.Client
+		cm         *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		cm = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: "ns"}}
+		fakeClient = fake.NewClientBuilder().WithObjects(cm).Build()
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(cm), cm)).To(Succeed())
+	})
+
+	It("should add and remove the finalizer once", func() {
+		patched, err := EnsurePresent(ctx, fakeClient, cm, testFinalizer)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(patched).To(BeTrue())
+
+		patched, err = EnsurePresent(ctx, fakeClient, cm, testFinalizer)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(patched).To(BeFalse())
+
+		stored := &corev1.ConfigMap{}
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(cm), stored)).To(Succeed())
+		Expect(stored.Finalizers).To(ConsistOf(testFinalizer))
+
+		patched, err = EnsureRemoved(ctx, fakeClient, cm, testFinalizer)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(patched).To(BeTrue())
+
+		patched, err = EnsureRemoved(ctx, fakeClient, cm, testFinalizer)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(patched).To(BeFalse())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(cm), stored)).To(Succeed())
+		Expect(stored.Finalizers).To(BeEmpty())
+	})
+
+	It("should fail on stale objects", func() {
+		stale := cm.DeepCopy()
+		cm.Data = map[string]string{"key": "value"}
+		Expect(fakeClient.Update(ctx, cm)).To(Succeed())
+
+		_, err := EnsurePresent(ctx, fakeClient, stale, testFinalizer)
+		Expect(apierrors.IsConflict(err)).To(BeTrue())
+	})
+
+	It("should ignore objects that no longer exist", func() {
+		_, err := EnsurePresent(ctx, fakeClient, cm, testFinalizer)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeClient.Delete(ctx, cm)).To(Succeed())
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(cm), cm)).To(Succeed())
+
+		// Removing the finalizer deletes the object, so that the second removal from a stale copy is not found.
+		stale := cm.DeepCopy()
+		_, err = EnsureRemoved(ctx, fakeClient, cm, testFinalizer)
+		Expect(err).NotTo(HaveOccurred())
+
+		stale.ResourceVersion = ""
+		patched, err := EnsureRemoved(ctx, fakeClient, stale, testFinalizer)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(patched).To(BeFalse())
+	})
+})
+
+var _ = Describe("Reconciler", func() {
+	var (
+		fakeClient client.Client
+		cm         *corev1.ConfigMap
+		reconciled int
+		done       bool
+		finalized  int
+		reconciler *Reconciler[*corev1.ConfigMap]
+	)
+
+	get := func() error {
+		return fakeClient.Get(ctx, client.ObjectKeyFromObject(cm), cm)
+	}
+
+	BeforeEach(func() {
+		cm = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: "ns"}}
+		fakeClient = fake.NewClientBuilder().WithObjects(cm).Build()
+		Expect(get()).To(Succeed())
+
+		reconciled, finalized, done = 0, 0, false
+		reconciler = &Reconciler[*corev1.ConfigMap]{
+			Client:    fakeClient,
+			Finalizer: testFinalizer,
+			Handler: HandlerFunc[*corev1.ConfigMap](func(context.Context, *corev1.ConfigMap) (bool, error) {
+				finalized++
+				return done, nil
+			}),
+			Object: reconcileerr.ObjectReconcilerFunc[*corev1.ConfigMap](func(context.Context, *corev1.ConfigMap) error {
+				reconciled++
+				return nil
+			}),
+			RequeueAfter: time.Minute,
+		}
+	})
+
+	It("should add the finalizer before reconciling", func() {
+		Expect(reconciler.Reconcile(ctx, cm)).To(Succeed())
+		Expect(reconciled).To(Equal(1))
+
+		Expect(get()).To(Succeed())
+		Expect(cm.Finalizers).To(ConsistOf(testFinalizer))
+	})
+
+	It("should requeue until the cleanup is done and then remove the finalizer", func() {
+		Expect(reconciler.Reconcile(ctx, cm)).To(Succeed())
+		Expect(fakeClient.Delete(ctx, cm)).To(Succeed())
+		Expect(get()).To(Succeed())
+
+		err := reconciler.Reconcile(ctx, cm)
+		after, ok := reconcileerr.RequeueAfterOf(err)
+		Expect(ok).To(BeTrue())
+		Expect(after).To(Equal(time.Minute))
+		Expect(finalized).To(Equal(1))
+		Expect(get()).To(Succeed())
+
+		done = true
+		Expect(reconciler.Reconcile(ctx, cm)).To(Succeed())
+		Expect(finalized).To(Equal(2))
+		Expect(reconciled).To(Equal(1))
+		Expect(apierrors.IsNotFound(get())).To(BeTrue())
+	})
+
+	It("should return cleanup errors", func() {
+		reconciler.Handler = HandlerFunc[*corev1.ConfigMap](func(context.Context, *corev1.ConfigMap) (bool, error) {
+			return false, errors.New("boom")
+		})
+
+		Expect(reconciler.Reconcile(ctx, cm)).To(Succeed())
+		Expect(fakeClient.Delete(ctx, cm)).To(Succeed())
+		Expect(get()).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, cm)).To(MatchError(ContainSubstring("boom")))
+		Expect(get()).To(Succeed())
+		Expect(cm.Finalizers).To(ConsistOf(testFinalizer))
+	})
+
+	It("should ignore deleted objects without the finalizer", func() {
+		cm.Finalizers = []string{"other"}
+		Expect(fakeClient.Update(ctx, cm)).To(Succeed())
+		Expect(fakeClient.Delete(ctx, cm)).To(Succeed())
+		Expect(get()).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, cm)).To(Succeed())
+		Expect(finalized).To(BeZero())
+		Expect(reconciled).To(BeZero())
+	})
+})
@@ -0,0 +1,163 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// DefaultQuietPeriod is how long no event must enqueue a request before it is added to the queue by default.
+const DefaultQuietPeriod = time.Second
+
+// Debounced is an event handler coalescing the bursts of events enqueuing the same request: the requests enqueued by
+// Handler are only added to the queue once no event enqueued them for QuietPeriod, and at most MaxDelay after the
+// first event of the burst. Unlike the deduplication of the queue, which only coalesces the events received while the
+// request waits to be reconciled, this leaves time for rapidly changing objects, such as EndpointSlices, to settle.
+//
+// Example:
+//
+//	err := ctrl.NewControllerManagedBy(mgr).
+//	    For(&v1alpha1.Operand{}).
+//	    Watches(&discoveryv1.EndpointSlice{}, &handlers.Debounced{
+//	        Handler:     handler.EnqueueRequestsFromMapFunc(operandsOfEndpointSlice),
+//	        QuietPeriod: 2 * time.Second,
+//	        MaxDelay:    30 * time.Second,
+//	    }).
+//	    Complete(r)
+type Debounced struct {
+	// Handler enqueues the requests of the events.
+	Handler handler.EventHandler
+
+	// QuietPeriod is how long no event must enqueue a request before it is added to the queue.
+	// Defaults to DefaultQuietPeriod.
+	QuietPeriod time.Duration
+
+	// MaxDelay bounds how long a request is held after the first event of a burst, so that objects that never settle
+	// are still reconciled. Unbounded when zero.
+	MaxDelay time.Duration
+
+	mu      sync.Mutex
+	pending map[reconcile.Request]*pendingRequest
+}
+
+// pendingRequest is a request held until its burst of events ends.
+type pendingRequest struct {
+	timer *time.Timer
+	first time.Time
+	queue workqueue.TypedRateLimitingInterface[reconcile.Request]
+}
+
+// Create implements handler.EventHandler.
+func (d *Debounced) Create(
+	ctx context.Context, e event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request],
+) {
+	d.Handler.Create(ctx, e, &debouncingQueue{TypedRateLimitingInterface: q, debounced: d})
+}
+
+// Update implements handler.EventHandler.
+func (d *Debounced) Update(
+	ctx context.Context, e event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request],
+) {
+	d.Handler.Update(ctx, e, &debouncingQueue{TypedRateLimitingInterface: q, debounced: d})
+}
+
+// Delete implements handler.EventHandler.
+func (d *Debounced) Delete(
+	ctx context.Context, e event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request],
+) {
+	d.Handler.Delete(ctx, e, &debouncingQueue{TypedRateLimitingInterface: q, debounced: d})
+}
+
+// Generic implements handler.EventHandler.
+func (d *Debounced) Generic(
+	ctx context.Context, e event.GenericEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request],
+) {
+	d.Handler.Generic(ctx, e, &debouncingQueue{TypedRateLimitingInterface: q, debounced: d})
+}
+
+// hold holds the request until no event enqueued it for the quiet period, or the maximum delay elapsed.
+func (d *Debounced) hold(request reconcile.Request, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	quietPeriod := d.QuietPeriod
+	if quietPeriod <= 0 {
+		quietPeriod = DefaultQuietPeriod
+	}
+
+	now := time.Now()
+
+	if pending, ok := d.pending[request]; ok {
+		delay := quietPeriod
+		if d.MaxDelay > 0 {
+			delay = min(delay, pending.first.Add(d.MaxDelay).Sub(now))
+		}
+
+		pending.queue = q
+		pending.timer.Reset(max(delay, 0))
+
+		return
+	}
+
+	if d.pending == nil {
+		d.pending = map[reconcile.Request]*pendingRequest{}
+	}
+
+	pending := &pendingRequest{first: now, queue: q}
+	pending.timer = time.AfterFunc(quietPeriod, func() {
+		d.release(request, pending)
+	})
+
+	d.pending[request] = pending
+}
+
+// release adds the held request to the queue.
+func (d *Debounced) release(request reconcile.Request, pending *pendingRequest) {
+	d.mu.Lock()
+
+	// A timer reset while firing fires again after the request was released, possibly while another one is held.
+	if d.pending[request] != pending {
+		d.mu.Unlock()
+
+		return
+	}
+
+	delete(d.pending, request)
+	queue := pending.queue
+	d.mu.Unlock()
+
+	queue.Add(request)
+}
+
+// debouncingQueue is the queue given to the wrapped handler, holding the requests it adds.
+type debouncingQueue struct {
+	workqueue.TypedRateLimitingInterface[reconcile.Request]
+
+	debounced *Debounced
+}
+
+// Add holds the request rather than adding it to the queue.
+func (q *debouncingQueue) Add(request reconcile.Request) {
+	q.debounced.hold(request, q.TypedRateLimitingInterface)
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("Debounced", func() {
+	update := func(name string) event.UpdateEvent {
+		obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name}}
+
+		return event.UpdateEvent{ObjectOld: obj, ObjectNew: obj}
+	}
+
+	request := func(name string) reconcile.Request {
+		return reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: name}}
+	}
+
+	It("should enqueue a burst of events once it is quiet", func() {
+		queue := newQueue()
+		d := &Debounced{Handler: &handler.EnqueueRequestForObject{}, QuietPeriod: 100 * time.Millisecond}
+
+		for range 5 {
+			d.Update(ctx, update("first"), queue)
+			time.Sleep(20 * time.Millisecond)
+		}
+
+		d.Update(ctx, update("second"), queue)
+
+		Consistently(queue.Len).WithTimeout(50 * time.Millisecond).Should(BeZero())
+		Eventually(queue.Len).Should(Equal(2))
+		Expect(drain(queue)).To(ConsistOf(request("first"), request("second")))
+		Consistently(queue.Len).WithTimeout(150 * time.Millisecond).Should(BeZero())
+	})
+
+	It("should enqueue events that never settle after the maximum delay", func() {
+		queue := newQueue()
+		d := &Debounced{
+			Handler:     &handler.EnqueueRequestForObject{},
+			QuietPeriod: 100 * time.Millisecond,
+			MaxDelay:    200 * time.Millisecond,
+		}
+
+		start := time.Now()
+		for queue.Len() == 0 && time.Since(start) < time.Second {
+			d.Update(ctx, update("first"), queue)
+			time.Sleep(20 * time.Millisecond)
+		}
+
+		Expect(queue.Len()).To(Equal(1))
+		Expect(time.Since(start)).To(BeNumerically("<", 500*time.Millisecond))
+	})
+})
@@ -0,0 +1,141 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package handlers provides event handlers mapping the events of watched objects to reconcile requests.
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// SelectorOption configures EnqueueRequestForLabelSelector.
+type SelectorOption func(*selectorOptions)
+
+// selectorOptions are the options of EnqueueRequestForLabelSelector.
+type selectorOptions struct {
+	namespaceSelector labels.Selector
+	namespace         string
+}
+
+// InNamespacesSelectedBy restricts the enqueued objects, of a namespaced kind, to the namespaces whose labels match the
+// selector.
+func InNamespacesSelectedBy(selector labels.Selector) SelectorOption {
+	return func(o *selectorOptions) {
+		o.namespaceSelector = selector
+	}
+}
+
+// InNamespace restricts the enqueued objects to the given namespace.
+func InNamespace(namespace string) SelectorOption {
+	return func(o *selectorOptions) {
+		o.namespace = namespace
+	}
+}
+
+// EnqueueRequestForLabelSelector returns an event handler enqueuing, for any event, requests for all the objects of the
+// kind of list whose labels match the selector, e.g. all the operands when a Node changes.
+//
+// The objects are listed from reader, which should be the manager's cache so that events do not hit the API server;
+// the cache then watches the listed kind, and Namespaces with InNamespacesSelectedBy. Failures to list are logged and
+// enqueue nothing.
+//
+// Example:
+//
+//	err := ctrl.NewControllerManagedBy(mgr).
+//	    For(&v1alpha1.Operand{}).
+//	    Watches(&corev1.Node{}, handlers.EnqueueRequestForLabelSelector(mgr.GetCache(), &v1alpha1.OperandList{},
+//	        labels.Everything(), handlers.InNamespacesSelectedBy(labels.SelectorFromSet(labels.Set{"example.com/managed": "true"})),
+//	    )).
+//	    Complete(r)
+func EnqueueRequestForLabelSelector(
+	reader client.Reader, list client.ObjectList, selector labels.Selector, opts ...SelectorOption,
+) handler.EventHandler {
+	o := selectorOptions{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, _ client.Object) []reconcile.Request {
+		requests, err := selectedRequests(ctx, reader, list, selector, o)
+		if err != nil {
+			log.FromContext(ctx).Error(err, "Failed to list the objects selected by labels")
+
+			return nil
+		}
+
+		return requests
+	})
+}
+
+// selectedRequests returns the requests for the objects of the kind of list matching the selector and options.
+func selectedRequests(
+	ctx context.Context, reader client.Reader, list client.ObjectList, selector labels.Selector, o selectorOptions,
+) ([]reconcile.Request, error) {
+	namespaces := []string{o.namespace}
+
+	if o.namespaceSelector != nil {
+		namespaceList := &corev1.NamespaceList{}
+		if err := reader.List(ctx, namespaceList, client.MatchingLabelsSelector{Selector: o.namespaceSelector}); err != nil {
+			return nil, fmt.Errorf("failed to list namespaces: %w", err)
+		}
+
+		namespaces = namespaces[:0]
+
+		for _, namespace := range namespaceList.Items {
+			if o.namespace == "" || o.namespace == namespace.Name {
+				namespaces = append(namespaces, namespace.Name)
+			}
+		}
+	}
+
+	var requests []reconcile.Request
+
+	for _, namespace := range namespaces {
+		items, ok := list.DeepCopyObject().(client.ObjectList)
+		if !ok {
+			return nil, fmt.Errorf("unexpected copy of %T", list)
+		}
+
+		if err := reader.List(ctx, items, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return nil, fmt.Errorf("failed to list %T: %w", list, err)
+		}
+
+		if err := meta.EachListItem(items, func(item runtime.Object) error {
+			obj, ok := item.(client.Object)
+			if !ok {
+				return fmt.Errorf("unexpected item %T", item)
+			}
+
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(obj)})
+
+			return nil
+		}); err != nil {
+			return nil, fmt.Errorf("failed to iterate over %T: %w", list, err)
+		}
+	}
+
+	return requests, nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("EnqueueRequestForLabelSelector", func() {
+	var c client.Client
+
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+
+	configMap := func(namespace, name string, labels map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: labels}}
+	}
+
+	request := func(namespace, name string) reconcile.Request {
+		return reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}}
+	}
+
+	BeforeEach(func() {
+		c = fake.NewClientBuilder().WithObjects(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "selected", Labels: map[string]string{"team": "a"}}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "other"}},
+			configMap("selected", "first", map[string]string{"app": "operand"}),
+			configMap("selected", "unlabeled", nil),
+			configMap("other", "second", map[string]string{"app": "operand"}),
+		).Build()
+	})
+
+	It("should enqueue all the objects matching the selector", func() {
+		queue := newQueue()
+
+		selector := labels.SelectorFromSet(labels.Set{"app": "operand"})
+		EnqueueRequestForLabelSelector(c, &corev1.ConfigMapList{}, selector).Create(ctx, event.CreateEvent{Object: node}, queue)
+
+		Expect(drain(queue)).To(ConsistOf(request("selected", "first"), request("other", "second")))
+	})
+
+	It("should only enqueue objects in the selected namespaces", func() {
+		queue := newQueue()
+
+		h := EnqueueRequestForLabelSelector(c, &corev1.ConfigMapList{}, labels.Everything(),
+			InNamespacesSelectedBy(labels.SelectorFromSet(labels.Set{"team": "a"})))
+		h.Update(ctx, event.UpdateEvent{ObjectOld: node, ObjectNew: node}, queue)
+
+		Expect(drain(queue)).To(ConsistOf(request("selected", "first"), request("selected", "unlabeled")))
+	})
+
+	It("should only enqueue objects in the given namespace", func() {
+		queue := newQueue()
+
+		h := EnqueueRequestForLabelSelector(c, &corev1.ConfigMapList{}, labels.Everything(), InNamespace("other"))
+		h.Delete(ctx, event.DeleteEvent{Object: node}, queue)
+
+		Expect(drain(queue)).To(ConsistOf(request("other", "second")))
+	})
+})
@@ -0,0 +1,182 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// LevelKey is the key of the ConfigMap holding the log level.
+	LevelKey = "logLevel"
+
+	// ReasonLogLevelChanged is the reason of the event recorded when the log level changes.
+	ReasonLogLevelChanged = "LogLevelChanged"
+	// ReasonInvalidLogLevel is the reason of the event recorded when the log level is invalid.
+	ReasonInvalidLogLevel = "InvalidLogLevel"
+)
+
+// ConfigMapLevel returns the LevelKey of a ConfigMap.
+func ConfigMapLevel(obj client.Object) string {
+	configMap, ok := obj.(*corev1.ConfigMap)
+	if !ok {
+		return ""
+	}
+
+	return configMap.Data[LevelKey]
+}
+
+// VerbosityController changes the log level live when the level held by an object changes, e.g. the LevelKey of a
+// ConfigMap or the operatorLogLevel of the custom resource of the operator. The level goes back to its initial value
+// when the object or its level is removed, and invalid levels are ignored.
+//
+// Example:
+//
+//	verbosity := &logging.VerbosityController{
+//	    Client:   mgr.GetClient(),
+//	    Level:    level,
+//	    Object:   &operatorv1.Console{ObjectMeta: metav1.ObjectMeta{Name: "cluster"}},
+//	    LevelOf:  func(obj client.Object) string { return string(obj.(*operatorv1.Console).Spec.OperatorLogLevel) },
+//	    Recorder: mgr.GetEventRecorder("console-operator"),
+//	}
+//	if err := verbosity.SetupWithManager(mgr); err != nil {
+//	    return err
+//	}
+type VerbosityController struct {
+	client.Client
+
+	// Level is the level changed, typically returned by Setup.
+	Level *Level
+
+	// Object is the object holding the log level, with its name and namespace set.
+	Object client.Object
+
+	// LevelOf returns the log level held by the object, empty when it is not set. Defaults to ConfigMapLevel.
+	LevelOf func(obj client.Object) string
+
+	// Recorder optionally records an event on the object when its log level is applied or rejected.
+	Recorder events.EventRecorder
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *VerbosityController) SetupWithManager(mgr ctrl.Manager) error {
+	key := client.ObjectKeyFromObject(r.Object)
+
+	// Every event results in the same request, which reloads the log level.
+	enqueueSync := handler.EnqueueRequestsFromMapFunc(func(context.Context, client.Object) []reconcile.Request {
+		return []reconcile.Request{{NamespacedName: key}}
+	})
+
+	isObject := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return client.ObjectKeyFromObject(obj) == key
+	})
+
+	if err := ctrl.NewControllerManagedBy(mgr).
+		Named("verbosity").
+		WithOptions(controller.Options{NeedLeaderElection: ptr.To(false)}).
+		Watches(r.Object, enqueueSync, builder.WithPredicates(isObject)).
+		// Override the default log constructor as it makes the logs very chatty.
+		WithLogConstructor(func(_ *reconcile.Request) logr.Logger {
+			return mgr.GetLogger().WithValues(
+				"controller", "verbosity",
+			)
+		}).
+		Complete(r); err != nil {
+		return fmt.Errorf("could not set up controller for log verbosity: %w", err)
+	}
+
+	return nil
+}
+
+// Reconcile applies the log level held by the object.
+func (r *VerbosityController) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	obj, ok := r.Object.DeepCopyObject().(client.Object)
+	if !ok {
+		return ctrl.Result{}, fmt.Errorf("unexpected copy of %T", r.Object)
+	}
+
+	found := true
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to get %s: %w", req.NamespacedName.String(), err)
+		}
+
+		found = false
+	}
+
+	var level string
+	if found {
+		level = r.levelOf(obj)
+	}
+
+	changed, err := r.Level.setOrReset(level, level != "")
+	switch {
+	case errors.Is(err, ErrInvalidLevel):
+		// Retrying does not help until the object is fixed, which triggers another reconciliation.
+		logger.Error(err, "Ignoring invalid log level", "level", level)
+		r.record(obj, corev1.EventTypeWarning, ReasonInvalidLogLevel, "Ignoring invalid log level %q", level)
+
+		return ctrl.Result{}, nil
+	case err != nil:
+		return ctrl.Result{}, err
+	case !changed:
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("Log level changed", "level", r.Level.String())
+
+	if found {
+		r.record(obj, corev1.EventTypeNormal, ReasonLogLevelChanged, "Log level changed to %s", r.Level.String())
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// levelOf returns the log level held by obj.
+func (r *VerbosityController) levelOf(obj client.Object) string {
+	if r.LevelOf != nil {
+		return r.LevelOf(obj)
+	}
+
+	return ConfigMapLevel(obj)
+}
+
+// record records an event on obj when a recorder is set.
+func (r *VerbosityController) record(obj client.Object, eventType, reason, note string, args ...any) {
+	if r.Recorder == nil {
+		return
+	}
+
+	r.Recorder.Eventf(obj, nil, eventType, reason, "ChangeLogLevel", note, args...)
+}
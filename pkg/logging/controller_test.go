@@ -0,0 +1,105 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("VerbosityController", func() {
+	var (
+		ctx        context.Context
+		fakeClient client.Client
+		recorder   *events.FakeRecorder
+		configMap  *corev1.ConfigMap
+		level      *Level
+		controller *VerbosityController
+		req        ctrl.Request
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-example-operator", Name: "example-operator-config"},
+			Data:       map[string]string{LevelKey: "Debug"},
+		}
+		fakeClient = fake.NewClientBuilder().WithObjects(configMap).Build()
+		recorder = events.NewFakeRecorder(10)
+
+		var err error
+		level, err = NewLevel("")
+		Expect(err).NotTo(HaveOccurred())
+
+		controller = &VerbosityController{
+			Client:   fakeClient,
+			Level:    level,
+			Object:   &corev1.ConfigMap{ObjectMeta: configMap.ObjectMeta},
+			Recorder: recorder,
+		}
+		req = ctrl.Request{NamespacedName: client.ObjectKeyFromObject(configMap)}
+	})
+
+	It("should apply the log level of the ConfigMap", func() {
+		Expect(controller.Reconcile(ctx, req)).To(Equal(ctrl.Result{}))
+		Expect(level.String()).To(Equal("Debug"))
+		Expect(recorder.Events).To(Receive(Equal("Normal LogLevelChanged Log level changed to Debug")))
+
+		Expect(controller.Reconcile(ctx, req)).To(Equal(ctrl.Result{}))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should ignore invalid log levels", func() {
+		configMap.Data[LevelKey] = "loud"
+		Expect(fakeClient.Update(ctx, configMap)).To(Succeed())
+
+		Expect(controller.Reconcile(ctx, req)).To(Equal(ctrl.Result{}))
+		Expect(level.String()).To(Equal("Normal"))
+		Expect(recorder.Events).To(Receive(Equal(`Warning InvalidLogLevel Ignoring invalid log level "loud"`)))
+	})
+
+	It("should restore the initial log level when the ConfigMap is removed", func() {
+		Expect(controller.Reconcile(ctx, req)).To(Equal(ctrl.Result{}))
+		Expect(level.String()).To(Equal("Debug"))
+
+		Expect(fakeClient.Delete(ctx, configMap)).To(Succeed())
+
+		Expect(controller.Reconcile(ctx, req)).To(Equal(ctrl.Result{}))
+		Expect(level.String()).To(Equal("Normal"))
+	})
+
+	It("should read the log level with LevelOf", func() {
+		controller.LevelOf = func(obj client.Object) string {
+			return obj.GetAnnotations()[LevelAnnotation]
+		}
+
+		configMap.Annotations = map[string]string{LevelAnnotation: "Trace"}
+		Expect(fakeClient.Update(ctx, configMap)).To(Succeed())
+
+		Expect(controller.Reconcile(ctx, req)).To(Equal(ctrl.Result{}))
+		Expect(level.String()).To(Equal("Trace"))
+	})
+})
@@ -0,0 +1,231 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package logging sets up the logger of controller-runtime and klog the way OpenShift operators log: structured logs
+// with RFC3339 timestamps, at a level set from a flag or the environment, and changed at runtime.
+package logging
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+const (
+	// LevelEnv is the environment variable holding the log level, unless set with the flag.
+	LevelEnv = "LOG_LEVEL"
+	// LevelFlag is the name of the flag holding the log level.
+	LevelFlag = "log-level"
+	// DevelopmentFlag is the name of the flag enabling the development logger.
+	DevelopmentFlag = "log-development"
+
+	// LevelAnnotation is the annotation changing the log level at runtime when set on an object, typically the
+	// custom resource of the operator.
+	LevelAnnotation = "operator.openshift.io/log-level"
+)
+
+// ErrInvalidLevel is returned when a log level is neither a verbosity nor a known level name.
+var ErrInvalidLevel = errors.New("invalid log level")
+
+// verbosities are the verbosities of the operator log levels. Normal only logs V(0) messages, as controller-runtime
+// operators log debug messages at V(1) and above.
+var verbosities = map[operatorv1.LogLevel]int{
+	operatorv1.Normal:   0,
+	operatorv1.Debug:    2,
+	operatorv1.Trace:    4,
+	operatorv1.TraceAll: 8,
+}
+
+// Options configure the logger.
+type Options struct {
+	// Level is the log level, either an operator log level such as "Debug", a zap level such as "info", or a
+	// verbosity such as "4". Defaults to LevelEnv, then to Normal.
+	Level string
+
+	// Development enables the development logger, logging human-readable messages with stack traces on warnings.
+	Development bool
+
+	// Writer is where logs are written to. Defaults to standard error.
+	Writer io.Writer
+}
+
+// BindFlags binds the options to the flags of fs, defaulting the level to LevelEnv.
+func (o *Options) BindFlags(fs *flag.FlagSet) {
+	fs.StringVar(&o.Level, LevelFlag, os.Getenv(LevelEnv),
+		"Log level: Normal, Debug, Trace, TraceAll, or a verbosity. Defaults to $"+LevelEnv+", then Normal.")
+	fs.BoolVar(&o.Development, DevelopmentFlag, o.Development, "Log human-readable messages for development.")
+}
+
+// Setup sets the logger of controller-runtime and klog, and returns its level to change it at runtime.
+//
+// Example:
+//
+//	logOptions := logging.Options{}
+//	logOptions.BindFlags(flag.CommandLine)
+//	flag.Parse()
+//
+//	level, err := logging.Setup(logOptions)
+//	if err != nil {
+//	    return err
+//	}
+func Setup(opts Options) (*Level, error) {
+	name := opts.Level
+	if name == "" {
+		name = os.Getenv(LevelEnv)
+	}
+
+	level, err := NewLevel(name)
+	if err != nil {
+		return nil, err
+	}
+
+	writer := opts.Writer
+	if writer == nil {
+		writer = os.Stderr
+	}
+
+	logger := crzap.New(
+		crzap.Level(level.atomic),
+		crzap.UseDevMode(opts.Development),
+		crzap.WriteTo(writer),
+		func(o *crzap.Options) {
+			o.TimeEncoder = zapcore.RFC3339TimeEncoder
+		},
+	)
+
+	ctrl.SetLogger(logger)
+	klog.SetLogger(logger)
+
+	return level, nil
+}
+
+// Level is a log level changed at runtime.
+type Level struct {
+	atomic zap.AtomicLevel
+	// initial is the verbosity the level was created with, restored when the annotation is removed.
+	initial int
+}
+
+// NewLevel returns a level at the given log level, Normal when empty.
+func NewLevel(level string) (*Level, error) {
+	verbosity, err := ParseLevel(level)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Level{atomic: zap.NewAtomicLevelAt(zapcore.Level(-verbosity)), initial: verbosity}, nil
+}
+
+// Verbosity returns the verbosity of the level, V(n) messages being logged up to n.
+func (l *Level) Verbosity() int {
+	return -int(l.atomic.Level())
+}
+
+// String returns the name of the level, or its verbosity when it is not one of the operator log levels.
+func (l *Level) String() string {
+	return FormatLevel(l.Verbosity())
+}
+
+// Set sets the log level, Normal when empty, and reports whether it changed.
+func (l *Level) Set(level string) (bool, error) {
+	verbosity, err := ParseLevel(level)
+	if err != nil {
+		return false, err
+	}
+
+	return l.SetVerbosity(verbosity), nil
+}
+
+// SetVerbosity sets the verbosity of the level and reports whether it changed.
+func (l *Level) SetVerbosity(verbosity int) bool {
+	if l.Verbosity() == verbosity {
+		return false
+	}
+
+	l.atomic.SetLevel(zapcore.Level(-verbosity))
+
+	return true
+}
+
+// SetFromAnnotation sets the log level from the LevelAnnotation of obj, or back to its initial level when obj is not
+// annotated, and reports whether it changed. It is intended to be called when reconciling the object.
+func (l *Level) SetFromAnnotation(obj client.Object) (bool, error) {
+	level, ok := obj.GetAnnotations()[LevelAnnotation]
+
+	changed, err := l.setOrReset(level, ok)
+	if err != nil {
+		return false, fmt.Errorf("failed to set log level from annotation %s: %w", LevelAnnotation, err)
+	}
+
+	return changed, nil
+}
+
+// setOrReset sets the log level when set, or back to the initial level otherwise, and reports whether it changed.
+func (l *Level) setOrReset(level string, set bool) (bool, error) {
+	if !set {
+		return l.SetVerbosity(l.initial), nil
+	}
+
+	return l.Set(level)
+}
+
+// ParseLevel returns the verbosity of a log level, either an operator log level, a zap level or a verbosity.
+// Empty levels are Normal.
+func ParseLevel(level string) (int, error) {
+	level = strings.TrimSpace(level)
+	if level == "" {
+		return verbosities[operatorv1.Normal], nil
+	}
+
+	for name, verbosity := range verbosities {
+		if strings.EqualFold(level, string(name)) {
+			return verbosity, nil
+		}
+	}
+
+	if verbosity, err := strconv.Atoi(level); err == nil && verbosity >= 0 {
+		return verbosity, nil
+	}
+
+	if zapLevel, err := zapcore.ParseLevel(level); err == nil && zapLevel <= zapcore.InfoLevel {
+		return -int(zapLevel), nil
+	}
+
+	return 0, fmt.Errorf("%w: %q", ErrInvalidLevel, level)
+}
+
+// FormatLevel returns the name of the operator log level of a verbosity, or the verbosity when it has none.
+func FormatLevel(verbosity int) string {
+	for name, v := range verbosities {
+		if v == verbosity {
+			return string(name)
+		}
+	}
+
+	return strconv.Itoa(verbosity)
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	"flag"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("ParseLevel", func() {
+	DescribeTable("should parse the log levels",
+		func(level string, verbosity int) {
+			Expect(ParseLevel(level)).To(Equal(verbosity))
+		},
+		Entry("empty", "", 0),
+		Entry("Normal", "Normal", 0),
+		Entry("Debug", "Debug", 2),
+		Entry("Trace", "trace", 4),
+		Entry("TraceAll", " TraceAll ", 8),
+		Entry("verbosity", "3", 3),
+		Entry("zap info", "info", 0),
+		Entry("Debug in lower case", "debug", 2),
+	)
+
+	DescribeTable("should reject invalid log levels",
+		func(level string) {
+			_, err := ParseLevel(level)
+			Expect(err).To(MatchError(ErrInvalidLevel))
+		},
+		Entry("unknown name", "verbose"),
+		Entry("negative verbosity", "-1"),
+		Entry("zap error", "error"),
+	)
+
+	It("should format the log levels", func() {
+		Expect(FormatLevel(0)).To(Equal("Normal"))
+		Expect(FormatLevel(8)).To(Equal("TraceAll"))
+		Expect(FormatLevel(3)).To(Equal("3"))
+	})
+})
+
+var _ = Describe("Level", func() {
+	It("should change the level at runtime", func() {
+		level, err := NewLevel("Debug")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(level.String()).To(Equal("Debug"))
+		Expect(level.atomic.Enabled(-2)).To(BeTrue())
+		Expect(level.atomic.Enabled(-3)).To(BeFalse())
+
+		Expect(level.Set("Trace")).To(BeTrue())
+		Expect(level.Verbosity()).To(Equal(4))
+		Expect(level.Set("4")).To(BeFalse())
+
+		_, err = level.Set("verbose")
+		Expect(err).To(MatchError(ErrInvalidLevel))
+		Expect(level.Verbosity()).To(Equal(4))
+	})
+
+	It("should follow the annotation", func() {
+		level, err := NewLevel("")
+		Expect(err).NotTo(HaveOccurred())
+
+		obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{LevelAnnotation: "TraceAll"},
+		}}
+		Expect(level.SetFromAnnotation(obj)).To(BeTrue())
+		Expect(level.String()).To(Equal("TraceAll"))
+
+		obj.Annotations[LevelAnnotation] = "loud"
+		_, err = level.SetFromAnnotation(obj)
+		Expect(err).To(MatchError(ErrInvalidLevel))
+
+		delete(obj.Annotations, LevelAnnotation)
+		Expect(level.SetFromAnnotation(obj)).To(BeTrue())
+		Expect(level.String()).To(Equal("Normal"))
+		Expect(level.SetFromAnnotation(obj)).To(BeFalse())
+	})
+})
+
+var _ = Describe("Setup", func() {
+	It("should default the level to the environment", func() {
+		GinkgoT().Setenv(LevelEnv, "Debug")
+
+		options := Options{Writer: GinkgoWriter}
+		options.BindFlags(flag.NewFlagSet("test", flag.ContinueOnError))
+		Expect(options.Level).To(Equal("Debug"))
+
+		level, err := Setup(Options{Writer: GinkgoWriter})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(level.String()).To(Equal("Debug"))
+	})
+
+	It("should reject invalid levels", func() {
+		_, err := Setup(Options{Level: "loud"})
+		Expect(err).To(MatchError(ErrInvalidLevel))
+	})
+})
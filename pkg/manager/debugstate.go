@@ -0,0 +1,156 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// DebugStatePath is the path the internal state is served under, next to the pprof endpoints.
+	DebugStatePath = "/debug/state"
+
+	// workqueueDepthMetric is the controller-runtime metric holding the depth of the workqueues of the controllers.
+	workqueueDepthMetric = "workqueue_depth"
+)
+
+// StateFunc returns a part of the internal state of the operator, marshaled to JSON by DebugState.
+type StateFunc func(ctx context.Context) (any, error)
+
+// DebugState dumps the internal state of the operator registered with it, e.g. the cached TLS profile, the providers
+// of the desired state resyncer or the depths of the workqueues, as a JSON object with one field per registered name.
+// It is served on DebugStatePath by the PprofServer it is set on, behind the same authentication and ConfigMap toggle
+// as pprof, so that must-gather can collect it when debugging operators in the field.
+//
+// Example:
+//
+//	state := &manager.DebugState{}
+//	state.Register("workqueues", manager.WorkqueueDepths(metrics.Registry))
+//	state.Register("tlsProfile", func(context.Context) (any, error) {
+//	    return tlsWatcher.InitialTLSProfileSpec, nil
+//	})
+//	state.Register("resyncProviders", func(context.Context) (any, error) {
+//	    return resyncer.Providers(), nil
+//	})
+//
+//	mgr, err := manager.NewOpenShiftManager(cfg, manager.Options{
+//	    Name:  "cluster-example-operator",
+//	    Pprof: &manager.PprofOptions{Authenticated: true, BindAddress: ":6060", State: state},
+//	})
+type DebugState struct {
+	mu      sync.RWMutex
+	sources map[string]StateFunc
+}
+
+// Register registers the state returned by fn under the given name, replacing the state registered under that name.
+func (d *DebugState) Register(name string, fn StateFunc) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.sources == nil {
+		d.sources = map[string]StateFunc{}
+	}
+
+	d.sources[name] = fn
+}
+
+// Unregister removes the state registered under the given name.
+func (d *DebugState) Unregister(name string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.sources, name)
+}
+
+// Dump returns the registered state by name. The state that fails to be returned is replaced with its error, so that
+// one failing source does not hide the others.
+func (d *DebugState) Dump(ctx context.Context) map[string]any {
+	d.mu.RLock()
+	sources := maps.Clone(d.sources)
+	d.mu.RUnlock()
+
+	dump := make(map[string]any, len(sources))
+
+	for _, name := range slices.Sorted(maps.Keys(sources)) {
+		state, err := sources[name](ctx)
+		if err != nil {
+			dump[name] = map[string]string{"error": err.Error()}
+
+			continue
+		}
+
+		dump[name] = state
+	}
+
+	return dump
+}
+
+// ServeHTTP serves the dump of the registered state as JSON.
+func (d *DebugState) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	data, err := json.MarshalIndent(d.Dump(r.Context()), "", "  ")
+	if err != nil {
+		log.FromContext(r.Context()).Error(err, "Failed to marshal the debug state")
+		http.Error(w, fmt.Sprintf("failed to marshal the debug state: %v", err), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if _, err := w.Write(data); err != nil {
+		log.FromContext(r.Context()).Error(err, "Failed to write the debug state")
+	}
+}
+
+// WorkqueueDepths returns the state of the depths of the workqueues of the controllers by controller name, gathered
+// from the controller-runtime metrics, e.g. metrics.Registry.
+func WorkqueueDepths(gatherer prometheus.Gatherer) StateFunc {
+	return func(context.Context) (any, error) {
+		families, err := gatherer.Gather()
+		if err != nil {
+			return nil, fmt.Errorf("failed to gather metrics: %w", err)
+		}
+
+		depths := map[string]float64{}
+
+		for _, family := range families {
+			if family.GetName() != workqueueDepthMetric {
+				continue
+			}
+
+			for _, metric := range family.GetMetric() {
+				for _, label := range metric.GetLabel() {
+					if label.GetName() == "controller" {
+						// The depth of priority queues is split by priority.
+						depths[label.GetValue()] += metric.GetGauge().GetValue()
+					}
+				}
+			}
+		}
+
+		return depths, nil
+	}
+}
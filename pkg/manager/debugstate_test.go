@@ -0,0 +1,90 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var _ = Describe("DebugState", func() {
+	It("should dump the registered state", func() {
+		state := &DebugState{}
+		state.Register("profile", func(context.Context) (any, error) {
+			return map[string]string{"type": "Intermediate"}, nil
+		})
+		state.Register("failing", func(context.Context) (any, error) {
+			return nil, errors.New("boom")
+		})
+		state.Register("removed", func(context.Context) (any, error) {
+			return "removed", nil
+		})
+		state.Unregister("removed")
+
+		recorder := httptest.NewRecorder()
+		state.ServeHTTP(recorder, httptest.NewRequestWithContext(context.Background(), http.MethodGet, DebugStatePath, nil))
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Header().Get("Content-Type")).To(Equal("application/json"))
+
+		var dump map[string]map[string]string
+		Expect(json.Unmarshal(recorder.Body.Bytes(), &dump)).To(Succeed())
+		Expect(dump).To(Equal(map[string]map[string]string{
+			"profile": {"type": "Intermediate"},
+			"failing": {"error": "boom"},
+		}))
+	})
+
+	It("should serve the state along with pprof", func() {
+		state := &DebugState{}
+		state.Register("answer", func(context.Context) (any, error) {
+			return 42, nil
+		})
+
+		p := &PprofServer{Options: PprofOptions{State: state}}
+		Expect(p.setup(nil)).To(Succeed())
+
+		recorder := httptest.NewRecorder()
+		p.handler.ServeHTTP(recorder, httptest.NewRequestWithContext(context.Background(), http.MethodGet, DebugStatePath, nil))
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(MatchJSON(`{"answer": 42}`))
+	})
+})
+
+var _ = Describe("WorkqueueDepths", func() {
+	It("should sum the depths of the workqueues by controller", func() {
+		depth := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: workqueueDepthMetric}, []string{"name", "controller", "priority"})
+		depth.WithLabelValues("operand", "operand", "0").Set(3)
+		depth.WithLabelValues("operand", "operand", "10").Set(2)
+		depth.WithLabelValues("pprof", "pprof", "").Set(0)
+
+		registry := prometheus.NewRegistry()
+		Expect(registry.Register(depth)).To(Succeed())
+
+		depths, err := WorkqueueDepths(registry)(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(depths).To(Equal(map[string]float64{"operand": 5, "pprof": 0}))
+	})
+})
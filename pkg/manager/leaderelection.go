@@ -0,0 +1,88 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"context"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/controller-runtime-common/pkg/clusterconfig"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// SingleNodeLeaseDuration is the lease duration on single-node clusters, relaxed so that the operator keeps its
+	// leadership through the API server disruptions of a single control plane node.
+	SingleNodeLeaseDuration = 270 * time.Second
+	// SingleNodeRenewDeadline is the renew deadline on single-node clusters.
+	SingleNodeRenewDeadline = 240 * time.Second
+	// SingleNodeRetryPeriod is the retry period on single-node clusters.
+	SingleNodeRetryPeriod = 60 * time.Second
+)
+
+// LeaderElectionTimings are the durations tuning leader election.
+type LeaderElectionTimings struct {
+	LeaseDuration time.Duration
+	RenewDeadline time.Duration
+	RetryPeriod   time.Duration
+}
+
+// LeaderElectionTimingsFor returns the leader election timings recommended for the control plane topology:
+// relaxed on single-node clusters, which only have one API server, and the defaults otherwise.
+func LeaderElectionTimingsFor(topology configv1.TopologyMode) LeaderElectionTimings {
+	if topology == configv1.SingleReplicaTopologyMode {
+		return LeaderElectionTimings{
+			LeaseDuration: SingleNodeLeaseDuration,
+			RenewDeadline: SingleNodeRenewDeadline,
+			RetryPeriod:   SingleNodeRetryPeriod,
+		}
+	}
+
+	return LeaderElectionTimings{
+		LeaseDuration: DefaultLeaseDuration,
+		RenewDeadline: DefaultRenewDeadline,
+		RetryPeriod:   DefaultRetryPeriod,
+	}
+}
+
+// FetchLeaderElectionTimings returns the leader election timings recommended for the control plane topology of the
+// cluster, read from the Infrastructure object. It is intended to be called with a client created before the manager.
+//
+// Example:
+//
+//	timings, err := manager.FetchLeaderElectionTimings(ctx, setupClient)
+//	if err != nil {
+//	    return err
+//	}
+//	options := manager.Options{Name: "cluster-example-operator"}
+//	timings.Apply(&options)
+func FetchLeaderElectionTimings(ctx context.Context, reader client.Reader) (LeaderElectionTimings, error) {
+	infrastructure, err := clusterconfig.FetchInfrastructure(ctx, reader)
+	if err != nil {
+		return LeaderElectionTimings{}, err
+	}
+
+	return LeaderElectionTimingsFor(infrastructure.Status.ControlPlaneTopology), nil
+}
+
+// Apply sets the timings in the options.
+func (t LeaderElectionTimings) Apply(opts *Options) {
+	opts.LeaseDuration = t.LeaseDuration
+	opts.RenewDeadline = t.RenewDeadline
+	opts.RetryPeriod = t.RetryPeriod
+}
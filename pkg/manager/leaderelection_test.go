@@ -0,0 +1,68 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("LeaderElectionTimings", func() {
+	It("should relax the timings on single-node clusters", func() {
+		Expect(LeaderElectionTimingsFor(configv1.SingleReplicaTopologyMode)).To(Equal(LeaderElectionTimings{
+			LeaseDuration: SingleNodeLeaseDuration,
+			RenewDeadline: SingleNodeRenewDeadline,
+			RetryPeriod:   SingleNodeRetryPeriod,
+		}))
+
+		for _, topology := range []configv1.TopologyMode{configv1.HighlyAvailableTopologyMode, configv1.ExternalTopologyMode, ""} {
+			Expect(LeaderElectionTimingsFor(topology).LeaseDuration).To(Equal(DefaultLeaseDuration), string(topology))
+		}
+	})
+
+	It("should fetch the timings of the cluster topology", func() {
+		scheme := runtime.NewScheme()
+		Expect(configv1.AddToScheme(scheme)).To(Succeed())
+
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&configv1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+			Status:     configv1.InfrastructureStatus{ControlPlaneTopology: configv1.SingleReplicaTopologyMode},
+		}).WithStatusSubresource(&configv1.Infrastructure{}).Build()
+
+		timings, err := FetchLeaderElectionTimings(context.Background(), c)
+		Expect(err).NotTo(HaveOccurred())
+
+		options := Options{Name: "example-operator", Namespace: "example"}
+		timings.Apply(&options)
+
+		managerOptions, err := ManagerOptions(options)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*managerOptions.LeaseDuration).To(Equal(SingleNodeLeaseDuration))
+		Expect(*managerOptions.RenewDeadline).To(Equal(SingleNodeRenewDeadline))
+		Expect(*managerOptions.RetryPeriod).To(Equal(SingleNodeRetryPeriod))
+
+		_, err = FetchLeaderElectionTimings(context.Background(), fake.NewClientBuilder().WithScheme(scheme).Build())
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,251 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package manager builds controller-runtime managers with the defaults recommended for OpenShift operators, so that
+// leader election, probes, metrics and shutdown behave the same across operators.
+package manager
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/openshift/controller-runtime-common/pkg/cachetuning"
+	"github.com/openshift/controller-runtime-common/pkg/namespacescope"
+	"github.com/openshift/controller-runtime-common/pkg/restmapping"
+	"github.com/openshift/controller-runtime-common/pkg/securemetrics"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+)
+
+const (
+	// DefaultLeaseDuration is the default duration non-leader candidates wait before acquiring leadership,
+	// the value recommended for OpenShift components to tolerate API server rollouts.
+	DefaultLeaseDuration = 137 * time.Second
+	// DefaultRenewDeadline is the default duration the leader retries refreshing leadership before giving it up.
+	DefaultRenewDeadline = 107 * time.Second
+	// DefaultRetryPeriod is the default duration candidates wait between attempts to acquire or renew leadership.
+	DefaultRetryPeriod = 26 * time.Second
+
+	// DefaultHealthProbeBindAddress is the default address the healthz and readyz endpoints are served on.
+	DefaultHealthProbeBindAddress = ":8081"
+	// DefaultGracefulShutdownTimeout is the default time runnables are given to stop.
+	DefaultGracefulShutdownTimeout = 30 * time.Second
+
+	// PodNamespaceEnv is the environment variable holding the namespace of the operator pod, set with the downward API.
+	PodNamespaceEnv = "POD_NAMESPACE"
+
+	// leaderElectionIDSuffix is appended to the name of the operator to name its leader election lease.
+	leaderElectionIDSuffix = "-lock"
+)
+
+var (
+	// ErrNoName is returned when the name of the operator is not set.
+	ErrNoName = errors.New("the name of the operator is required")
+	// ErrNoNamespace is returned when leader election is enabled and the namespace of the operator is unknown.
+	ErrNoNamespace = errors.New("the namespace of the operator is required for leader election, set " + PodNamespaceEnv)
+)
+
+// Options configure an OpenShift manager. Only Name is required.
+type Options struct {
+	// Name is the name of the operator, e.g. "cluster-example-operator". It names the leader election lease
+	// "<Name>-lock", and is the default field owner.
+	Name string
+
+	// Namespace is the namespace of the operator, holding the leader election lease.
+	// Defaults to the PodNamespaceEnv environment variable.
+	Namespace string
+
+	// Scheme is the scheme of the manager. Defaults to the client-go scheme.
+	Scheme *runtime.Scheme
+
+	// DisableLeaderElection disables leader election, e.g. when running locally.
+	DisableLeaderElection bool
+
+	// LeaseDuration, RenewDeadline and RetryPeriod tune leader election.
+	// They default to DefaultLeaseDuration, DefaultRenewDeadline and DefaultRetryPeriod.
+	LeaseDuration time.Duration
+	RenewDeadline time.Duration
+	RetryPeriod   time.Duration
+
+	// HealthProbeBindAddress is the address of the healthz and readyz endpoints.
+	// Defaults to DefaultHealthProbeBindAddress, and "0" disables them.
+	HealthProbeBindAddress string
+
+	// Metrics configure the metrics server, served securely with the cluster TLS profile.
+	Metrics securemetrics.Options
+
+	// Pprof serves pprof when set, by default on a loopback address only, enabled and disabled at runtime with the
+	// ConfigMap of the options. Authenticated pprof defaults to the certificate and TLS profile of Metrics.
+	// The internal state of the operator is served along with pprof when the State of the options is set.
+	Pprof *PprofOptions
+
+	// GracefulShutdownTimeout is the time runnables are given to stop. Defaults to DefaultGracefulShutdownTimeout.
+	GracefulShutdownTimeout time.Duration
+
+	// FieldOwner is the field manager of the writes of the manager's client. Defaults to Name.
+	FieldOwner string
+
+	// Namespaces restricts the cache to the given namespaces, e.g. from namespacescope.WatchNamespaces.
+	// All namespaces are cached when empty.
+	Namespaces []string
+
+	// LabelSelector restricts the cache to the objects matching it, e.g. the objects labeled as managed by the
+	// operator. All objects are cached when nil.
+	LabelSelector labels.Selector
+
+	// CacheTuning reduces the memory footprint of the cache when set, with per-kind selectors and transform funcs and
+	// the stripping of the managed fields and last-applied-configuration annotation of the cached objects.
+	CacheTuning *cachetuning.Options
+
+	// NoKindMatchRetry retries the requests of the manager's client failing with "no matches for kind" errors when
+	// set, resetting the RESTMapper, e.g. when the operator starts before its CRDs are established.
+	NoKindMatchRetry *restmapping.RetryOptions
+}
+
+// NewOpenShiftManager returns a manager configured with the options, serving healthz and readyz ping checks, and
+// pprof when enabled.
+//
+// Example:
+//
+//	mgr, err := manager.NewOpenShiftManager(ctrl.GetConfigOrDie(), manager.Options{
+//	    Name:       "cluster-example-operator",
+//	    Scheme:     scheme,
+//	    Namespaces: namespacescope.WatchNamespaces(),
+//	})
+func NewOpenShiftManager(cfg *rest.Config, opts Options) (ctrl.Manager, error) {
+	managerOptions, err := ManagerOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	mgr, err := ctrl.NewManager(cfg, managerOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create manager: %w", err)
+	}
+
+	if err := mgr.AddHealthzCheck("ping", healthz.Ping); err != nil {
+		return nil, fmt.Errorf("failed to add healthz check: %w", err)
+	}
+
+	if err := mgr.AddReadyzCheck("ping", healthz.Ping); err != nil {
+		return nil, fmt.Errorf("failed to add readyz check: %w", err)
+	}
+
+	if opts.Pprof != nil {
+		pprofServer := &PprofServer{Client: mgr.GetClient(), Options: *opts.Pprof}
+
+		if pprofServer.Options.CertDir == "" {
+			pprofServer.Options.CertDir = opts.Metrics.CertDir
+		}
+
+		if pprofServer.Options.TLSProfile == nil {
+			pprofServer.Options.TLSProfile = opts.Metrics.TLSProfile
+		}
+
+		if err := pprofServer.SetupWithManager(mgr); err != nil {
+			return nil, err
+		}
+	}
+
+	return mgr, nil
+}
+
+// ManagerOptions returns the controller-runtime manager options NewOpenShiftManager creates the manager with,
+// for callers that need to adjust them further.
+func ManagerOptions(opts Options) (ctrl.Options, error) {
+	if opts.Name == "" {
+		return ctrl.Options{}, ErrNoName
+	}
+
+	namespace := opts.Namespace
+	if namespace == "" {
+		namespace = os.Getenv(PodNamespaceEnv)
+	}
+
+	if namespace == "" && !opts.DisableLeaderElection {
+		return ctrl.Options{}, ErrNoNamespace
+	}
+
+	metricsOptions, unsupportedCiphers := securemetrics.ServerOptions(opts.Metrics)
+	if len(unsupportedCiphers) > 0 {
+		ctrl.Log.WithName("manager").Info("Ignoring the ciphers of the TLS profile that are not supported",
+			"ciphers", unsupportedCiphers)
+	}
+
+	cacheOptions := namespacescope.CacheOptions(opts.Namespaces)
+	cacheOptions.DefaultLabelSelector = opts.LabelSelector
+
+	if opts.CacheTuning != nil {
+		cacheOptions = cachetuning.CacheOptions(cacheOptions, *opts.CacheTuning)
+	}
+
+	managerOptions := ctrl.Options{
+		Scheme:                        opts.Scheme,
+		Cache:                         cacheOptions,
+		LeaderElection:                !opts.DisableLeaderElection,
+		LeaderElectionResourceLock:    resourcelock.LeasesResourceLock,
+		LeaderElectionNamespace:       namespace,
+		LeaderElectionID:              opts.Name + leaderElectionIDSuffix,
+		LeaderElectionReleaseOnCancel: true,
+		LeaseDuration:                 durationOrDefault(opts.LeaseDuration, DefaultLeaseDuration),
+		RenewDeadline:                 durationOrDefault(opts.RenewDeadline, DefaultRenewDeadline),
+		RetryPeriod:                   durationOrDefault(opts.RetryPeriod, DefaultRetryPeriod),
+		Metrics:                       metricsOptions,
+		HealthProbeBindAddress:        opts.HealthProbeBindAddress,
+		GracefulShutdownTimeout:       durationOrDefault(opts.GracefulShutdownTimeout, DefaultGracefulShutdownTimeout),
+	}
+
+	managerOptions.Client.FieldOwner = opts.FieldOwner
+	if managerOptions.Client.FieldOwner == "" {
+		managerOptions.Client.FieldOwner = opts.Name
+	}
+
+	if managerOptions.HealthProbeBindAddress == "" {
+		managerOptions.HealthProbeBindAddress = DefaultHealthProbeBindAddress
+	}
+
+	if opts.NoKindMatchRetry != nil {
+		retryOptions := *opts.NoKindMatchRetry
+
+		managerOptions.MapperProvider = restmapping.NewResettableMapper
+		managerOptions.NewClient = func(cfg *rest.Config, clientOptions client.Options) (client.Client, error) {
+			c, err := client.New(cfg, clientOptions)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create client: %w", err)
+			}
+
+			return restmapping.WithNoKindMatchRetry(c, retryOptions), nil
+		}
+	}
+
+	return managerOptions, nil
+}
+
+// durationOrDefault returns a pointer to duration, or to the default when it is zero.
+func durationOrDefault(duration, defaultDuration time.Duration) *time.Duration {
+	if duration <= 0 {
+		duration = defaultDuration
+	}
+
+	return &duration
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/controller-runtime-common/pkg/cachetuning"
+	"github.com/openshift/controller-runtime-common/pkg/restmapping"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+)
+
+var _ = Describe("ManagerOptions", func() {
+	It("should require the name of the operator", func() {
+		_, err := ManagerOptions(Options{})
+		Expect(err).To(MatchError(ErrNoName))
+	})
+
+	It("should require the namespace for leader election", func() {
+		GinkgoT().Setenv(PodNamespaceEnv, "")
+
+		_, err := ManagerOptions(Options{Name: "example-operator"})
+		Expect(err).To(MatchError(ErrNoNamespace))
+
+		_, err = ManagerOptions(Options{Name: "example-operator", DisableLeaderElection: true})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should apply the defaults", func() {
+		GinkgoT().Setenv(PodNamespaceEnv, "openshift-example-operator")
+
+		options, err := ManagerOptions(Options{Name: "example-operator"})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(options.LeaderElection).To(BeTrue())
+		Expect(options.LeaderElectionResourceLock).To(Equal(resourcelock.LeasesResourceLock))
+		Expect(options.LeaderElectionNamespace).To(Equal("openshift-example-operator"))
+		Expect(options.LeaderElectionID).To(Equal("example-operator-lock"))
+		Expect(options.LeaseDuration).To(Equal(ptr.To(DefaultLeaseDuration)))
+		Expect(options.RenewDeadline).To(Equal(ptr.To(DefaultRenewDeadline)))
+		Expect(options.RetryPeriod).To(Equal(ptr.To(DefaultRetryPeriod)))
+		Expect(options.HealthProbeBindAddress).To(Equal(DefaultHealthProbeBindAddress))
+		Expect(options.GracefulShutdownTimeout).To(Equal(ptr.To(DefaultGracefulShutdownTimeout)))
+		Expect(options.Client.FieldOwner).To(Equal("example-operator"))
+		Expect(options.Metrics.SecureServing).To(BeTrue())
+		Expect(options.Cache.DefaultNamespaces).To(BeEmpty())
+		Expect(options.Cache.DefaultLabelSelector).To(BeNil())
+		Expect(options.Cache.DefaultTransform).To(BeNil())
+		Expect(options.MapperProvider).To(BeNil())
+		Expect(options.NewClient).To(BeNil())
+	})
+
+	It("should honor the options", func() {
+		selector := labels.SelectorFromSet(labels.Set{"app.kubernetes.io/managed-by": "example-operator"})
+
+		options, err := ManagerOptions(Options{
+			Name:                    "example-operator",
+			Namespace:               "example",
+			LeaseDuration:           time.Minute,
+			HealthProbeBindAddress:  "0",
+			GracefulShutdownTimeout: time.Second,
+			FieldOwner:              "example",
+			Namespaces:              []string{"first"},
+			LabelSelector:           selector,
+			CacheTuning:             &cachetuning.Options{},
+			NoKindMatchRetry:        &restmapping.RetryOptions{},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(options.LeaderElectionNamespace).To(Equal("example"))
+		Expect(options.LeaseDuration).To(Equal(ptr.To(time.Minute)))
+		Expect(options.HealthProbeBindAddress).To(Equal("0"))
+		Expect(options.GracefulShutdownTimeout).To(Equal(ptr.To(time.Second)))
+		Expect(options.Client.FieldOwner).To(Equal("example"))
+		Expect(options.Cache.DefaultNamespaces).To(Equal(map[string]cache.Config{"first": {}}))
+		Expect(options.Cache.DefaultLabelSelector).To(Equal(selector))
+		Expect(options.Cache.DefaultTransform).NotTo(BeNil())
+		Expect(options.MapperProvider).NotTo(BeNil())
+		Expect(options.NewClient).NotTo(BeNil())
+	})
+})
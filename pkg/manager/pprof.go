@@ -0,0 +1,387 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/controller-runtime-common/pkg/certs"
+	"github.com/openshift/controller-runtime-common/pkg/securemetrics"
+	crtls "github.com/openshift/controller-runtime-common/pkg/tls"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// DefaultPprofBindAddress is the default address pprof is served on, only reachable from within the pod,
+	// e.g. with oc port-forward.
+	DefaultPprofBindAddress = "127.0.0.1:6060"
+	// PprofPath is the path the pprof endpoints are served under.
+	PprofPath = "/debug/pprof/"
+	// PprofEnabledKey is the key of the pprof ConfigMap enabling or disabling pprof, set to "true" or "false".
+	PprofEnabledKey = "enabled"
+
+	// pprofReadHeaderTimeout bounds the time clients are given to send the request headers.
+	pprofReadHeaderTimeout = 10 * time.Second
+	// pprofShutdownTimeout bounds the time in-flight profiles are given to complete when pprof is disabled.
+	pprofShutdownTimeout = 5 * time.Second
+)
+
+// ErrPprofNotLoopback is returned when pprof would be served without authentication on a non-loopback address.
+var ErrPprofNotLoopback = errors.New("pprof can only be served without authentication on a loopback address")
+
+// PprofOptions configure the pprof endpoints.
+type PprofOptions struct {
+	// BindAddress is the address pprof is served on. Defaults to DefaultPprofBindAddress. Addresses that are not
+	// loopback addresses require Authenticated.
+	BindAddress string
+
+	// Enabled serves pprof from the start. It is overridden by the PprofEnabledKey of ConfigMap when set.
+	Enabled bool
+
+	// ConfigMap is the ConfigMap whose PprofEnabledKey enables or disables pprof at runtime, so that operators can be
+	// profiled without being rebuilt or redeployed.
+	ConfigMap types.NamespacedName
+
+	// Authenticated serves pprof over TLS, authenticating callers with TokenReviews and authorizing them with
+	// SubjectAccessReviews for the get verb on PprofPath, and DebugStatePath with State, like metrics scrapers.
+	Authenticated bool
+
+	// CertDir is the directory holding the serving certificate and key when Authenticated.
+	// Defaults to securemetrics.DefaultCertDir.
+	CertDir string
+
+	// TLSProfile is the TLS profile to serve with when Authenticated. Defaults to the default profile.
+	TLSProfile *configv1.TLSProfileSpec
+
+	// State is served on DebugStatePath along with pprof when set, to dump the internal state of the operator.
+	State *DebugState
+}
+
+// PprofServer serves the pprof endpoints while enabled, and closes its port while disabled. It is enabled and disabled
+// at runtime with a ConfigMap, which the operator needs RBAC to get, list and watch.
+//
+// Example:
+//
+//	pprofServer := &manager.PprofServer{
+//	    Client: mgr.GetClient(),
+//	    Options: manager.PprofOptions{
+//	        ConfigMap: types.NamespacedName{Namespace: "openshift-example-operator", Name: "example-operator-debug"},
+//	    },
+//	}
+//	if err := pprofServer.SetupWithManager(mgr); err != nil {
+//	    return err
+//	}
+type PprofServer struct {
+	client.Client
+
+	// Options configure the pprof endpoints.
+	Options PprofOptions
+
+	handler   http.Handler
+	tlsConfig *tls.Config
+
+	mu       sync.Mutex
+	running  bool
+	override *bool
+	server   *http.Server
+}
+
+// SetupWithManager adds the PprofServer to the manager, with the controller watching the ConfigMap when set.
+func (p *PprofServer) SetupWithManager(mgr ctrl.Manager) error {
+	if err := p.setup(mgr); err != nil {
+		return err
+	}
+
+	if err := mgr.Add(p); err != nil {
+		return fmt.Errorf("failed to add pprof server to manager: %w", err)
+	}
+
+	if p.Options.ConfigMap.Name == "" {
+		return nil
+	}
+
+	// Every event results in the same request, which reloads the ConfigMap.
+	enqueueSync := handler.EnqueueRequestsFromMapFunc(func(context.Context, client.Object) []reconcile.Request {
+		return []reconcile.Request{{NamespacedName: p.Options.ConfigMap}}
+	})
+
+	isConfigMap := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return client.ObjectKeyFromObject(obj) == p.Options.ConfigMap
+	})
+
+	if err := ctrl.NewControllerManagedBy(mgr).
+		Named("pprof").
+		WithOptions(controller.Options{NeedLeaderElection: ptr.To(false)}).
+		Watches(&corev1.ConfigMap{}, enqueueSync, builder.WithPredicates(isConfigMap)).
+		// Override the default log constructor as it makes the logs very chatty.
+		WithLogConstructor(func(_ *reconcile.Request) logr.Logger {
+			return mgr.GetLogger().WithValues(
+				"controller", "pprof",
+			)
+		}).
+		Complete(p); err != nil {
+		return fmt.Errorf("could not set up controller for pprof: %w", err)
+	}
+
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+// Every replica can be profiled, so the server runs regardless of leadership.
+func (p *PprofServer) NeedLeaderElection() bool {
+	return false
+}
+
+// Start serves pprof while it is enabled, until the context is done.
+func (p *PprofServer) Start(ctx context.Context) error {
+	ctx = log.IntoContext(ctx, log.FromContext(ctx).WithName("pprof"))
+
+	p.mu.Lock()
+	p.running = true
+	err := p.apply(ctx)
+	p.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	<-ctx.Done()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.running = false
+
+	return p.stop(context.WithoutCancel(ctx))
+}
+
+// Enabled reports whether pprof is enabled.
+func (p *PprofServer) Enabled() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.enabled()
+}
+
+// Reconcile enables or disables pprof according to the ConfigMap.
+func (p *PprofServer) Reconcile(ctx context.Context, _ ctrl.Request) (ctrl.Result, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := p.Get(ctx, p.Options.ConfigMap, configMap); client.IgnoreNotFound(err) != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to get ConfigMap %s: %w", p.Options.ConfigMap.String(), err)
+	}
+
+	var override *bool
+
+	if value, ok := configMap.Data[PprofEnabledKey]; ok {
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to parse the %s of ConfigMap %s: %w",
+				PprofEnabledKey, p.Options.ConfigMap.String(), err)
+		}
+
+		override = &enabled
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.override = override
+
+	return ctrl.Result{}, p.apply(ctx)
+}
+
+// setup validates the options and builds the handler and the TLS configuration serving pprof.
+func (p *PprofServer) setup(mgr ctrl.Manager) error {
+	if p.Options.BindAddress == "" {
+		p.Options.BindAddress = DefaultPprofBindAddress
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(PprofPath, pprof.Index)
+	mux.HandleFunc(PprofPath+"cmdline", pprof.Cmdline)
+	mux.HandleFunc(PprofPath+"profile", pprof.Profile)
+	mux.HandleFunc(PprofPath+"symbol", pprof.Symbol)
+	mux.HandleFunc(PprofPath+"trace", pprof.Trace)
+
+	if p.Options.State != nil {
+		mux.Handle(DebugStatePath, p.Options.State)
+	}
+	p.handler = mux
+
+	if !p.Options.Authenticated {
+		if !isLoopback(p.Options.BindAddress) {
+			return fmt.Errorf("%w: %s", ErrPprofNotLoopback, p.Options.BindAddress)
+		}
+
+		return nil
+	}
+
+	filter, err := filters.WithAuthenticationAndAuthorization(mgr.GetConfig(), mgr.GetHTTPClient())
+	if err != nil {
+		return fmt.Errorf("failed to create pprof authentication filter: %w", err)
+	}
+
+	p.handler, err = filter(mgr.GetLogger().WithName("pprof"), mux)
+	if err != nil {
+		return fmt.Errorf("failed to create pprof authentication filter: %w", err)
+	}
+
+	certDir := p.Options.CertDir
+	if certDir == "" {
+		certDir = securemetrics.DefaultCertDir
+	}
+
+	certWatcher, err := certs.NewFileCertWatcher(
+		filepath.Join(certDir, securemetrics.CertName),
+		filepath.Join(certDir, securemetrics.KeyName),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to load pprof serving certificate: %w", err)
+	}
+
+	if err := mgr.Add(certWatcher); err != nil {
+		return fmt.Errorf("failed to add pprof serving certificate watcher to manager: %w", err)
+	}
+
+	profile := p.Options.TLSProfile
+	if profile == nil {
+		defaultProfile, _ := crtls.GetTLSProfileSpec(nil)
+		profile = &defaultProfile
+	}
+
+	tlsConfig, _ := crtls.NewTLSConfigFromProfile(*profile)
+
+	p.tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	tlsConfig(p.tlsConfig)
+	certs.DynamicTLSConfig(certWatcher)(p.tlsConfig)
+
+	return nil
+}
+
+// enabled reports whether pprof is enabled, and must be called with the lock held.
+func (p *PprofServer) enabled() bool {
+	if p.override != nil {
+		return *p.override
+	}
+
+	return p.Options.Enabled
+}
+
+// apply starts or stops serving pprof according to whether it is enabled, once the server is running.
+// It must be called with the lock held.
+func (p *PprofServer) apply(ctx context.Context) error {
+	switch {
+	case !p.running:
+		return nil
+	case p.enabled() && p.server == nil:
+		return p.serve(ctx)
+	case !p.enabled() && p.server != nil:
+		return p.stop(ctx)
+	default:
+		return nil
+	}
+}
+
+// serve starts serving pprof. It must be called with the lock held.
+func (p *PprofServer) serve(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+
+	listener, err := (&net.ListenConfig{}).Listen(ctx, "tcp", p.Options.BindAddress)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s to serve pprof: %w", p.Options.BindAddress, err)
+	}
+
+	if p.tlsConfig != nil {
+		listener = tls.NewListener(listener, p.tlsConfig)
+	}
+
+	server := &http.Server{
+		Handler:           p.handler,
+		ReadHeaderTimeout: pprofReadHeaderTimeout,
+	}
+	p.server = server
+
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error(err, "Failed to serve pprof")
+		}
+	}()
+
+	logger.Info("Serving pprof", "address", listener.Addr().String(), "authenticated", p.Options.Authenticated)
+
+	return nil
+}
+
+// stop stops serving pprof, giving in-flight profiles some time to complete. It must be called with the lock held.
+func (p *PprofServer) stop(ctx context.Context) error {
+	if p.server == nil {
+		return nil
+	}
+
+	server := p.server
+	p.server = nil
+
+	ctx, cancel := context.WithTimeout(ctx, pprofShutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(ctx); err != nil {
+		return fmt.Errorf("failed to stop serving pprof: %w", err)
+	}
+
+	log.FromContext(ctx).Info("Stopped serving pprof")
+
+	return nil
+}
+
+// isLoopback reports whether the address only listens on a loopback interface.
+func isLoopback(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return false
+	}
+
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("PprofServer", func() {
+	var (
+		ctx       context.Context
+		c         client.Client
+		configMap *corev1.ConfigMap
+		address   string
+	)
+
+	BeforeEach(func() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(context.Background())
+		DeferCleanup(cancel)
+
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-example-operator", Name: "example-operator-debug"},
+			Data:       map[string]string{PprofEnabledKey: "true"},
+		}
+		c = fake.NewClientBuilder().WithObjects(configMap).Build()
+
+		// Reserve a free port for the server to listen on.
+		listener, err := (&net.ListenConfig{}).Listen(ctx, "tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		address = listener.Addr().String()
+		Expect(listener.Close()).To(Succeed())
+	})
+
+	get := func() (int, error) {
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+address+PprofPath, nil)
+		if err != nil {
+			return 0, err
+		}
+
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			return 0, err
+		}
+		defer response.Body.Close()
+
+		return response.StatusCode, nil
+	}
+
+	It("should refuse to serve without authentication on other addresses than loopback ones", func() {
+		p := &PprofServer{Options: PprofOptions{BindAddress: ":6060"}}
+		Expect(p.setup(nil)).To(MatchError(ErrPprofNotLoopback))
+
+		p = &PprofServer{}
+		Expect(p.setup(nil)).To(Succeed())
+		Expect(p.Options.BindAddress).To(Equal(DefaultPprofBindAddress))
+	})
+
+	It("should be enabled and disabled with the ConfigMap", func() {
+		p := &PprofServer{
+			Client:  c,
+			Options: PprofOptions{BindAddress: address, ConfigMap: client.ObjectKeyFromObject(configMap)},
+		}
+		Expect(p.setup(nil)).To(Succeed())
+		Expect(p.Enabled()).To(BeFalse())
+
+		startCtx, stop := context.WithCancel(ctx)
+		done := make(chan error)
+		go func() {
+			done <- p.Start(startCtx)
+		}()
+
+		Expect(p.Reconcile(ctx, ctrl.Request{})).To(Equal(ctrl.Result{}))
+		Expect(p.Enabled()).To(BeTrue())
+		Eventually(get).Should(Equal(http.StatusOK))
+
+		configMap.Data[PprofEnabledKey] = "false"
+		Expect(c.Update(ctx, configMap)).To(Succeed())
+
+		Expect(p.Reconcile(ctx, ctrl.Request{})).To(Equal(ctrl.Result{}))
+		Expect(p.Enabled()).To(BeFalse())
+		Eventually(func() error {
+			_, err := get()
+			return err
+		}).Should(HaveOccurred())
+
+		Expect(c.Delete(ctx, configMap)).To(Succeed())
+		Expect(p.Reconcile(ctx, ctrl.Request{})).To(Equal(ctrl.Result{}))
+		Expect(p.Enabled()).To(BeFalse())
+
+		p.Options.Enabled = true
+		Expect(p.Reconcile(ctx, ctrl.Request{})).To(Equal(ctrl.Result{}))
+		Eventually(get).Should(Equal(http.StatusOK))
+
+		stop()
+		Eventually(done).Should(Receive(BeNil()))
+		_, err := get()
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject invalid values", func() {
+		configMap.Data[PprofEnabledKey] = "maybe"
+		Expect(c.Update(ctx, configMap)).To(Succeed())
+
+		p := &PprofServer{Client: c, Options: PprofOptions{ConfigMap: client.ObjectKeyFromObject(configMap)}}
+		_, err := p.Reconcile(ctx, ctrl.Request{})
+		Expect(err).To(MatchError(ContainSubstring("failed to parse the enabled of ConfigMap")))
+	})
+})
+
+var _ = Describe("isLoopback", func() {
+	DescribeTable("should only accept loopback addresses",
+		func(address string, loopback bool) {
+			Expect(isLoopback(address)).To(Equal(loopback))
+		},
+		Entry("IPv4 loopback", "127.0.0.1:6060", true),
+		Entry("IPv6 loopback", "[::1]:6060", true),
+		Entry("localhost", "localhost:6060", true),
+		Entry("all interfaces", ":6060", false),
+		Entry("pod address", "10.128.0.12:6060", false),
+		Entry("invalid address", "6060", false),
+	)
+})
@@ -0,0 +1,136 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/openshift/controller-runtime-common/pkg/metricsregistry"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// DefaultShutdownTimeout is the default deadline of all the shutdown hooks.
+const DefaultShutdownTimeout = 30 * time.Second
+
+// ErrShutdownDeadlineExceeded is returned for the shutdown hooks that were not run before the deadline.
+var ErrShutdownDeadlineExceeded = errors.New("shutdown deadline exceeded")
+
+var (
+	shutdownHookDuration = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "shutdown_hook_duration_seconds",
+		Help: "Duration of the last run of the shutdown hooks in seconds, by hook.",
+	}, []string{"hook"})
+
+	shutdownHookErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "shutdown_hook_errors_total",
+		Help: "Number of shutdown hooks that failed or were not run before the deadline, by hook.",
+	}, []string{"hook"})
+)
+
+func init() {
+	metricsregistry.Add(shutdownHookDuration, shutdownHookErrors)
+}
+
+// ShutdownHook is a function run when the operator shuts down, e.g. to flush status, release locks or drain servers.
+// Its context is done when the deadline of the shutdown hooks is reached.
+type ShutdownHook func(ctx context.Context) error
+
+// namedShutdownHook is a registered shutdown hook.
+type namedShutdownHook struct {
+	name string
+	hook ShutdownHook
+}
+
+// ShutdownHooks runs registered hooks in order when the operator shuts down, after the manager stopped because its
+// context was cancelled, e.g. on SIGTERM, or because it lost leader election. The duration of the hooks is recorded in
+// the shutdown_hook_duration_seconds metric and their failures in shutdown_hook_errors_total. It is safe for
+// concurrent use.
+//
+// Example:
+//
+//	hooks := &manager.ShutdownHooks{Timeout: 20 * time.Second}
+//	hooks.Register("flush-status", statusWriter.Flush)
+//	hooks.Register("drain-server", server.Shutdown)
+//	if err := manager.Start(ctrl.SetupSignalHandler(), mgr, manager.StartOptions{ShutdownHooks: hooks}); err != nil {
+//	    os.Exit(1)
+//	}
+type ShutdownHooks struct {
+	// Timeout is the deadline of all the hooks. Defaults to DefaultShutdownTimeout.
+	Timeout time.Duration
+
+	mu    sync.Mutex
+	hooks []namedShutdownHook
+}
+
+// Register registers a hook, run after the hooks registered before it.
+func (h *ShutdownHooks) Register(name string, hook ShutdownHook) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.hooks = append(h.hooks, namedShutdownHook{name: name, hook: hook})
+}
+
+// Run runs the hooks in order until the deadline, and returns their errors joined.
+// The hooks that were not run before the deadline fail with ErrShutdownDeadlineExceeded.
+func (h *ShutdownHooks) Run(ctx context.Context) error {
+	h.mu.Lock()
+	hooks := h.hooks
+	h.mu.Unlock()
+
+	timeout := h.Timeout
+	if timeout <= 0 {
+		timeout = DefaultShutdownTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	logger := log.FromContext(ctx)
+
+	var errs []error
+
+	for _, hook := range hooks {
+		if ctx.Err() != nil {
+			shutdownHookErrors.WithLabelValues(hook.name).Inc()
+			errs = append(errs, fmt.Errorf("shutdown hook %s: %w", hook.name, ErrShutdownDeadlineExceeded))
+
+			continue
+		}
+
+		start := time.Now()
+		err := hook.hook(ctx)
+		duration := time.Since(start)
+
+		shutdownHookDuration.WithLabelValues(hook.name).Set(duration.Seconds())
+
+		if err != nil {
+			shutdownHookErrors.WithLabelValues(hook.name).Inc()
+			errs = append(errs, fmt.Errorf("shutdown hook %s failed: %w", hook.name, err))
+
+			continue
+		}
+
+		logger.V(1).Info("Ran shutdown hook", "hook", hook.name, "duration", duration.String())
+	}
+
+	return errors.Join(errs...)
+}
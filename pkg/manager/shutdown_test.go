@@ -0,0 +1,64 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var _ = Describe("ShutdownHooks", func() {
+	It("should run the hooks in order", func() {
+		var calls []string
+
+		hooks := &ShutdownHooks{}
+		hooks.Register("first", func(context.Context) error {
+			calls = append(calls, "first")
+			return errors.New("failed")
+		})
+		hooks.Register("second", func(context.Context) error {
+			calls = append(calls, "second")
+			return nil
+		})
+
+		errorsBefore := testutil.ToFloat64(shutdownHookErrors.WithLabelValues("first"))
+
+		err := hooks.Run(context.Background())
+		Expect(err).To(MatchError(ContainSubstring("shutdown hook first failed: failed")))
+		Expect(calls).To(Equal([]string{"first", "second"}))
+		Expect(testutil.ToFloat64(shutdownHookErrors.WithLabelValues("first"))).To(Equal(errorsBefore + 1))
+	})
+
+	It("should skip the hooks after the deadline", func() {
+		hooks := &ShutdownHooks{Timeout: 10 * time.Millisecond}
+		hooks.Register("slow", func(ctx context.Context) error {
+			<-ctx.Done()
+			return nil
+		})
+		hooks.Register("skipped", func(context.Context) error {
+			Fail("the hook should not run after the deadline")
+			return nil
+		})
+
+		Expect(hooks.Run(context.Background())).To(MatchError(ErrShutdownDeadlineExceeded))
+	})
+})
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// DefaultTerminationLogPath is the default path of the termination log, whose content the kubelet reports in the
+	// status of the terminated container.
+	DefaultTerminationLogPath = "/dev/termination-log"
+
+	// ExitReasonSignal is the exit reason when the context of the manager was cancelled, typically on SIGTERM.
+	ExitReasonSignal = "Signal"
+	// ExitReasonLeaderElectionLost is the exit reason when the manager lost leader election.
+	ExitReasonLeaderElectionLost = "LeaderElectionLost"
+	// ExitReasonError is the exit reason when the manager failed.
+	ExitReasonError = "Error"
+
+	// maxTerminationMessageLength is the maximum length of a termination message kept by the kubelet.
+	maxTerminationMessageLength = 4096
+)
+
+// StartOptions configure Start.
+type StartOptions struct {
+	// ShutdownHooks are run once the manager stopped. None are run when nil.
+	ShutdownHooks *ShutdownHooks
+
+	// TerminationLogPath is the path the exit reason is written to. Defaults to DefaultTerminationLogPath.
+	TerminationLogPath string
+
+	// DisableTerminationLog disables writing the exit reason to the termination log, e.g. when running locally.
+	DisableTerminationLog bool
+}
+
+// Start starts the manager and blocks until it stops, then runs the shutdown hooks with a context independent of ctx,
+// which is done by then. It reports why the manager stopped, one of the ExitReason constants, in a final log line and
+// in the termination log, so that crash loops can be triaged from the status of the pod. It returns the errors of the
+// manager and of the hooks joined.
+//
+// Example:
+//
+//	if err := manager.Start(ctrl.SetupSignalHandler(), mgr, manager.StartOptions{}); err != nil {
+//	    os.Exit(1)
+//	}
+func Start(ctx context.Context, mgr ctrl.Manager, opts StartOptions) error {
+	var errs []error
+
+	startErr := mgr.Start(ctx)
+	if startErr != nil {
+		errs = append(errs, fmt.Errorf("manager stopped: %w", startErr))
+	}
+
+	shutdownCtx := log.IntoContext(context.WithoutCancel(ctx), mgr.GetLogger().WithName("shutdown"))
+
+	if opts.ShutdownHooks != nil {
+		if err := opts.ShutdownHooks.Run(shutdownCtx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	reason := ExitReasonOf(startErr)
+	err := errors.Join(errs...)
+
+	logger := log.FromContext(shutdownCtx)
+	if err != nil {
+		logger.Error(err, "Exiting", "reason", reason)
+	} else {
+		logger.Info("Exiting", "reason", reason)
+	}
+
+	if !opts.DisableTerminationLog {
+		path := opts.TerminationLogPath
+		if path == "" {
+			path = DefaultTerminationLogPath
+		}
+
+		if writeErr := WriteTerminationMessage(path, reason, err); writeErr != nil {
+			logger.Error(writeErr, "Failed to write the termination log")
+		}
+	}
+
+	return err
+}
+
+// ExitReasonOf returns the exit reason of a manager that stopped with err.
+func ExitReasonOf(err error) string {
+	switch {
+	case err == nil:
+		return ExitReasonSignal
+	case strings.Contains(err.Error(), "leader election lost"):
+		// controller-runtime does not export the error returned when leader election is lost.
+		return ExitReasonLeaderElectionLost
+	default:
+		return ExitReasonError
+	}
+}
+
+// WriteTerminationMessage writes the exit reason and err, which may be nil, to the termination log at path,
+// truncated to the length kept by the kubelet.
+func WriteTerminationMessage(path, reason string, err error) error {
+	message := reason
+	if err != nil {
+		message += ": " + err.Error()
+	}
+
+	if len(message) > maxTerminationMessageLength {
+		message = message[:maxTerminationMessageLength]
+	}
+
+	if err := os.WriteFile(path, []byte(message), 0o600); err != nil {
+		return fmt.Errorf("failed to write termination log %s: %w", path, err)
+	}
+
+	return nil
+}
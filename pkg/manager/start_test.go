@@ -0,0 +1,96 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// stubManager is a manager whose Start returns err once its context is done.
+type stubManager struct {
+	ctrl.Manager
+
+	err error
+}
+
+func (m *stubManager) Start(ctx context.Context) error {
+	<-ctx.Done()
+	return m.err
+}
+
+func (m *stubManager) GetLogger() logr.Logger {
+	return logr.Discard()
+}
+
+var _ = Describe("Start", func() {
+	var (
+		ctx  context.Context
+		path string
+	)
+
+	BeforeEach(func() {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(context.Background())
+		cancel()
+
+		path = filepath.Join(GinkgoT().TempDir(), "termination-log")
+	})
+
+	It("should run the hooks once the manager stopped", func() {
+		ran := false
+		hooks := &ShutdownHooks{}
+		hooks.Register("hook", func(ctx context.Context) error {
+			Expect(ctx.Err()).NotTo(HaveOccurred())
+
+			ran = true
+
+			return nil
+		})
+
+		Expect(Start(ctx, &stubManager{}, StartOptions{ShutdownHooks: hooks, TerminationLogPath: path})).To(Succeed())
+		Expect(ran).To(BeTrue())
+		Expect(os.ReadFile(path)).To(BeEquivalentTo(ExitReasonSignal))
+	})
+
+	It("should report the exit reason", func() {
+		leaderElectionLost := errors.New("leader election lost")
+		Expect(Start(ctx, &stubManager{err: leaderElectionLost}, StartOptions{TerminationLogPath: path})).
+			To(MatchError(leaderElectionLost))
+		Expect(os.ReadFile(path)).To(BeEquivalentTo("LeaderElectionLost: manager stopped: leader election lost"))
+
+		Expect(Start(ctx, &stubManager{err: errors.New("failed")}, StartOptions{TerminationLogPath: path})).NotTo(Succeed())
+		Expect(os.ReadFile(path)).To(BeEquivalentTo("Error: manager stopped: failed"))
+	})
+
+	It("should not write the termination log when disabled", func() {
+		Expect(Start(ctx, &stubManager{}, StartOptions{TerminationLogPath: path, DisableTerminationLog: true})).To(Succeed())
+		Expect(path).NotTo(BeAnExistingFile())
+	})
+
+	It("should truncate long termination messages", func() {
+		Expect(WriteTerminationMessage(path, ExitReasonError, errors.New(string(make([]byte, 5000))))).To(Succeed())
+		Expect(os.ReadFile(path)).To(HaveLen(4096))
+	})
+})
@@ -0,0 +1,136 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package merge
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// newWidget returns a third-party custom resource with the given spec.
+func newWidget(spec map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "example.com/v1",
+		"kind":       "Widget",
+		"metadata":   map[string]any{"name": "widget", "namespace": "ns"},
+		"spec":       spec,
+	}}
+}
+
+var _ = Describe("ApplyLastApplied", func() {
+	It("should remove fields that are no longer desired and keep user fields", func() {
+		current := newWidget(map[string]any{"size": int64(1), "user": "field"})
+
+		changed, err := ApplyLastApplied(current, newWidget(map[string]any{"size": int64(2), "color": "red"}), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(current.Object["spec"]).To(Equal(map[string]any{"size": int64(2), "color": "red", "user": "field"}))
+		Expect(current.GetAnnotations()).To(HaveKey(LastAppliedAnnotation))
+
+		changed, err = ApplyLastApplied(current, newWidget(map[string]any{"size": int64(2), "color": "red"}), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+
+		changed, err = ApplyLastApplied(current, newWidget(map[string]any{"size": int64(2)}), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(current.Object["spec"]).To(Equal(map[string]any{"size": int64(2), "user": "field"}))
+	})
+
+	It("should fail on an invalid annotation", func() {
+		current := newWidget(nil)
+		current.SetAnnotations(map[string]string{LastAppliedAnnotation: "{"})
+
+		_, err := ApplyLastApplied(current, newWidget(nil), nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("ApplyOwnedFields", func() {
+	It("should use the fields owned by the field manager as the original state", func() {
+		current := newWidget(map[string]any{
+			"size":  int64(1),
+			"color": "red",
+			"user":  "field",
+			"ports": []any{
+				map[string]any{"port": int64(80), "name": "http"},
+				map[string]any{"port": int64(9090), "name": "user"},
+			},
+		})
+		current.SetManagedFields([]metav1.ManagedFieldsEntry{
+			{
+				Manager:    "my-operator",
+				Operation:  metav1.ManagedFieldsOperationUpdate,
+				FieldsType: "FieldsV1",
+				FieldsV1: &metav1.FieldsV1{Raw: []byte(
+					`{"f:spec":{"f:size":{},"f:color":{},"f:ports":{"k:{\"port\":80}":{".":{},"f:name":{}}}}}`)},
+			},
+			{
+				Manager:    "someone-else",
+				Operation:  metav1.ManagedFieldsOperationUpdate,
+				FieldsType: "FieldsV1",
+				FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:user":{},"f:ports":{"k:{\"port\":9090}":{}}}}`)},
+			},
+		})
+
+		owned, err := OwnedFields(current, "my-operator")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(owned).To(Equal(map[string]any{"spec": map[string]any{
+			"size":  int64(1),
+			"color": "red",
+			"ports": []any{map[string]any{"port": int64(80), "name": "http"}},
+		}}))
+
+		changed, err := ApplyOwnedFields(current, newWidget(map[string]any{"size": int64(2)}), "my-operator",
+			ListMergeKeys{"spec.ports": "port"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(current.Object["spec"]).To(Equal(map[string]any{
+			"size":  int64(2),
+			"user":  "field",
+			"ports": []any{map[string]any{"port": int64(9090), "name": "user"}},
+		}))
+	})
+	It("should not claim the fields of a map it only owns the existence of", func() {
+		current := newWidget(map[string]any{"selector": map[string]any{"app": "widget", "user": "label"}})
+		current.SetManagedFields([]metav1.ManagedFieldsEntry{
+			{
+				Manager:    "my-operator",
+				Operation:  metav1.ManagedFieldsOperationUpdate,
+				FieldsType: "FieldsV1",
+				FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:selector":{".":{},"f:app":{}}}}`)},
+			},
+			{
+				Manager:    "someone-else",
+				Operation:  metav1.ManagedFieldsOperationUpdate,
+				FieldsType: "FieldsV1",
+				FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:selector":{"f:user":{}}}}`)},
+			},
+		})
+
+		owned, err := OwnedFields(current, "my-operator")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(owned).To(Equal(map[string]any{"spec": map[string]any{"selector": map[string]any{"app": "widget"}}}))
+
+		changed, err := ApplyOwnedFields(current, newWidget(map[string]any{}), "my-operator", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(current.Object["spec"]).To(Equal(map[string]any{"selector": map[string]any{"user": "label"}}))
+	})
+})
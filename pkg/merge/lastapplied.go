@@ -0,0 +1,78 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package merge
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// LastAppliedAnnotation is the annotation holding the state last applied by ApplyLastApplied, as JSON.
+const LastAppliedAnnotation = "operator.openshift.io/last-applied"
+
+// ApplyLastApplied merges desired into current with a three-way merge, using the state recorded in the
+// LastAppliedAnnotation of current as the original state, and records desired in the annotation.
+// It reports whether current changed.
+//
+// Example:
+//
+//	changed, err := merge.ApplyLastApplied(current, desired, merge.ListMergeKeys{"spec.endpoints": "port"})
+//	if err == nil && changed {
+//	    err = c.Update(ctx, current)
+//	}
+func ApplyLastApplied(current, desired *unstructured.Unstructured, keys ListMergeKeys) (bool, error) {
+	var original map[string]any
+
+	if lastApplied, ok := current.GetAnnotations()[LastAppliedAnnotation]; ok {
+		if err := json.Unmarshal([]byte(lastApplied), &original); err != nil {
+			return false, fmt.Errorf("failed to parse the %s annotation: %w", LastAppliedAnnotation, err)
+		}
+	}
+
+	modified := desired.DeepCopy()
+	unstructured.RemoveNestedField(modified.Object, "metadata", "annotations", LastAppliedAnnotation)
+
+	if len(modified.GetAnnotations()) == 0 {
+		unstructured.RemoveNestedField(modified.Object, "metadata", "annotations")
+	}
+
+	lastApplied, err := json.Marshal(modified.Object)
+	if err != nil {
+		return false, fmt.Errorf("failed to serialize the %s annotation: %w", LastAppliedAnnotation, err)
+	}
+
+	merged := &unstructured.Unstructured{Object: ThreeWay(original, modified.Object, current.Object, keys)}
+
+	annotations := merged.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	annotations[LastAppliedAnnotation] = string(lastApplied)
+	merged.SetAnnotations(annotations)
+
+	if equality.Semantic.DeepEqual(current.Object, merged.Object) {
+		return false, nil
+	}
+
+	current.Object = merged.Object
+
+	return true, nil
+}
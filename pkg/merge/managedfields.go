@@ -0,0 +1,226 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package merge
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// OwnedFields returns the fields of obj owned by the given field manager through updates and applies, as recorded
+// in the managed fields of obj, or nil when the manager owns no field.
+func OwnedFields(obj *unstructured.Unstructured, fieldManager string) (map[string]any, error) {
+	var owned map[string]any
+
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Manager != fieldManager || entry.Subresource != "" || entry.FieldsV1 == nil {
+			continue
+		}
+
+		var fields map[string]any
+		if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err != nil {
+			return nil, fmt.Errorf("failed to parse the managed fields of %s: %w", fieldManager, err)
+		}
+
+		extracted, err := extractMap(fields, obj.Object)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract the fields of %s: %w", fieldManager, err)
+		}
+
+		owned = ThreeWay(nil, extracted, owned, nil)
+	}
+
+	return owned, nil
+}
+
+// ApplyOwnedFields merges desired into current with a three-way merge, using the fields of current owned by the
+// given field manager as the original state, and reports whether current changed. It is meant for objects updated
+// with that field manager, e.g. with client.FieldOwner, so that the fields it no longer desires are removed.
+func ApplyOwnedFields(current, desired *unstructured.Unstructured, fieldManager string, keys ListMergeKeys) (bool, error) {
+	original, err := OwnedFields(current, fieldManager)
+	if err != nil {
+		return false, err
+	}
+
+	merged := ThreeWay(original, desired.Object, current.Object, keys)
+	if equality.Semantic.DeepEqual(current.Object, merged) {
+		return false, nil
+	}
+
+	current.Object = merged
+
+	return true, nil
+}
+
+// extractMap returns the values of obj selected by the fields, a FieldsV1 set: "f:<name>" selects a field,
+// and its nested set the fields or items to select in its value, all of it when empty.
+func extractMap(fields map[string]any, obj map[string]any) (map[string]any, error) {
+	result := map[string]any{}
+
+	for selector, nested := range fields {
+		name, ok := strings.CutPrefix(selector, "f:")
+		if !ok {
+			continue
+		}
+
+		value, exists := obj[name]
+		if !exists {
+			continue
+		}
+
+		extracted, err := extractValue(nested, value)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", name, err)
+		}
+
+		result[name] = extracted
+	}
+
+	return result, nil
+}
+
+// extractValue returns the part of value selected by the nested set of its field. An empty set selects all of value,
+// while "." only selects the map or list itself, not its fields or items that may be owned by other managers.
+func extractValue(nested any, value any) (any, error) {
+	nestedFields, _ := nested.(map[string]any)
+	if len(nestedFields) == 0 {
+		return runtime.DeepCopyJSONValue(value), nil
+	}
+
+	switch value := value.(type) {
+	case map[string]any:
+		return extractMap(nestedFields, value)
+	case []any:
+		return extractList(nestedFields, value)
+	default:
+		return runtime.DeepCopyJSONValue(value), nil
+	}
+}
+
+// extractList returns the items of list selected by the fields: "k:<json>" selects the items with the given keys,
+// "v:<json>" the items with the given value and "i:<index>" the item at the given index.
+func extractList(fields map[string]any, list []any) ([]any, error) {
+	var result []any
+
+	for i, item := range list {
+		for selector, nested := range fields {
+			selected, err := selects(selector, i, item)
+			if err != nil {
+				return nil, err
+			}
+
+			if !selected {
+				continue
+			}
+
+			extracted, err := extractValue(nested, item)
+			if err != nil {
+				return nil, fmt.Errorf("item %d: %w", i, err)
+			}
+
+			if extractedMap, ok := extracted.(map[string]any); ok && strings.HasPrefix(selector, "k:") {
+				// The keys identify the item, they are part of it even when not listed as owned.
+				keys, err := parseKeys(selector)
+				if err != nil {
+					return nil, err
+				}
+
+				itemMap, _ := item.(map[string]any)
+				for key := range keys {
+					extractedMap[key] = runtime.DeepCopyJSONValue(itemMap[key])
+				}
+			}
+
+			result = append(result, extracted)
+
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// selects reports whether the list item selector selects the item at the given index.
+func selects(selector string, index int, item any) (bool, error) {
+	switch {
+	case strings.HasPrefix(selector, "k:"):
+		keys, err := parseKeys(selector)
+		if err != nil {
+			return false, err
+		}
+
+		itemMap, ok := item.(map[string]any)
+		if !ok {
+			return false, nil
+		}
+
+		for key, value := range keys {
+			if !equality.Semantic.DeepEqual(normalize(itemMap[key]), normalize(value)) {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	case strings.HasPrefix(selector, "v:"):
+		var value any
+		if err := json.Unmarshal([]byte(strings.TrimPrefix(selector, "v:")), &value); err != nil {
+			return false, fmt.Errorf("invalid value %s: %w", selector, err)
+		}
+
+		return equality.Semantic.DeepEqual(normalize(item), normalize(value)), nil
+	case strings.HasPrefix(selector, "i:"):
+		i, err := strconv.Atoi(strings.TrimPrefix(selector, "i:"))
+		if err != nil {
+			return false, fmt.Errorf("invalid index %s: %w", selector, err)
+		}
+
+		return i == index, nil
+	default:
+		return false, nil
+	}
+}
+
+// parseKeys returns the keys of a "k:<json>" list item selector.
+func parseKeys(selector string) (map[string]any, error) {
+	var keys map[string]any
+	if err := json.Unmarshal([]byte(strings.TrimPrefix(selector, "k:")), &keys); err != nil {
+		return nil, fmt.Errorf("invalid key %s: %w", selector, err)
+	}
+
+	return keys, nil
+}
+
+// normalize returns value with its numbers as float64, as decoded by encoding/json, so that values decoded from
+// selectors compare equal to the int64 values of unstructured objects.
+func normalize(value any) any {
+	switch value := value.(type) {
+	case int64:
+		return float64(value)
+	case int:
+		return float64(value)
+	case int32:
+		return float64(value)
+	default:
+		return value
+	}
+}
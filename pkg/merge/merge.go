@@ -0,0 +1,234 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package merge provides three-way merges of objects, to update objects that are also changed by others, such as
+// third-party custom resources, without clobbering the fields set by others.
+//
+// A three-way merge takes the original state previously applied by the operator, the modified state it now desires,
+// and the current state of the object. The fields of the modified state are set on the current state, the fields of
+// the original state that are no longer desired are removed, and all the other fields are left as they are.
+// Lists are replaced as a whole unless a merge key is given for them in ListMergeKeys, the equivalent of the
+// patchMergeKey of built-in types, in which case their items are merged by key.
+//
+// The original state is either recorded in the LastAppliedAnnotation of the object, see ApplyLastApplied, or
+// extracted from the fields owned by a field manager in the managed fields of the object, see ApplyOwnedFields.
+package merge
+
+import (
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ListMergeKeys maps the paths of lists to the field identifying their items, e.g. "spec.containers" to "name".
+// Paths are the field names from the root of the object joined by dots, lists included, so that the ports of the
+// containers above are at "spec.containers.ports". Lists that are not listed are replaced as a whole.
+type ListMergeKeys map[string]string
+
+// ThreeWay returns current with the changes from original to modified applied, see the package documentation.
+// It does not modify its arguments. Any of them may be nil.
+func ThreeWay(original, modified, current map[string]any, keys ListMergeKeys) map[string]any {
+	return mergeMaps(original, modified, current, keys, "")
+}
+
+// ThreeWayObjects is ThreeWay for typed objects. The result is stored in current.
+func ThreeWayObjects(original, modified, current runtime.Object, keys ListMergeKeys) error {
+	objects := make([]map[string]any, 0, 3)
+
+	for _, obj := range []runtime.Object{original, modified, current} {
+		if obj == nil || reflect.ValueOf(obj).IsNil() {
+			objects = append(objects, nil)
+			continue
+		}
+
+		m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return fmt.Errorf("failed to convert %T to unstructured: %w", obj, err)
+		}
+
+		objects = append(objects, m)
+	}
+
+	merged := ThreeWay(objects[0], objects[1], objects[2], keys)
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(merged, current); err != nil {
+		return fmt.Errorf("failed to convert merged object to %T: %w", current, err)
+	}
+
+	return nil
+}
+
+// mergeMaps merges the maps found at the given path.
+func mergeMaps(original, modified, current map[string]any, keys ListMergeKeys, path string) map[string]any {
+	result := make(map[string]any, len(current))
+	for key, value := range current {
+		result[key] = runtime.DeepCopyJSONValue(value)
+	}
+
+	for key, originalValue := range original {
+		if _, ok := modified[key]; ok {
+			continue
+		}
+
+		if remaining, ok := removeValue(originalValue, result[key], keys, joinPath(path, key)); ok {
+			result[key] = remaining
+			continue
+		}
+
+		delete(result, key)
+	}
+
+	for key, modifiedValue := range modified {
+		fieldPath := joinPath(path, key)
+
+		switch modifiedValue := modifiedValue.(type) {
+		case map[string]any:
+			if currentValue, ok := result[key].(map[string]any); ok {
+				originalValue, _ := original[key].(map[string]any)
+				result[key] = mergeMaps(originalValue, modifiedValue, currentValue, keys, fieldPath)
+
+				continue
+			}
+		case []any:
+			currentValue, ok := result[key].([]any)
+			if mergeKey, merged := keys[fieldPath]; merged && ok {
+				originalValue, _ := original[key].([]any)
+				result[key] = mergeLists(originalValue, modifiedValue, currentValue, keys, fieldPath, mergeKey)
+
+				continue
+			}
+		}
+
+		result[key] = runtime.DeepCopyJSONValue(modifiedValue)
+	}
+
+	return result
+}
+
+// removeValue returns current without the parts of original, a value that is no longer desired, and false when
+// nothing remains. Maps, and lists with a merge key, keep the fields and items that were not in original.
+func removeValue(original, current any, keys ListMergeKeys, path string) (any, bool) {
+	var remaining any
+
+	switch original := original.(type) {
+	case map[string]any:
+		if currentMap, ok := current.(map[string]any); ok {
+			if remainingMap := mergeMaps(original, nil, currentMap, keys, path); len(remainingMap) > 0 {
+				remaining = remainingMap
+			}
+		}
+	case []any:
+		currentList, ok := current.([]any)
+		if mergeKey, merged := keys[path]; merged && ok {
+			if remainingList := mergeLists(original, nil, currentList, keys, path, mergeKey); len(remainingList) > 0 {
+				remaining = remainingList
+			}
+		}
+	}
+
+	return remaining, remaining != nil
+}
+
+// mergeLists merges the lists found at the given path by the given key. Items of current that were neither in
+// original nor in modified are kept, items of original that are no longer in modified are removed, and items of
+// modified are merged into the items of current with the same key, or appended.
+func mergeLists(original, modified, current []any, keys ListMergeKeys, path, mergeKey string) []any {
+	originalItems := itemsByKey(original, mergeKey)
+	modifiedItems := itemsByKey(modified, mergeKey)
+	merged := map[string]bool{}
+
+	result := make([]any, 0, len(current)+len(modified))
+
+	for _, item := range current {
+		itemMap, key, ok := keyedItem(item, mergeKey)
+		if !ok {
+			result = append(result, runtime.DeepCopyJSONValue(item))
+			continue
+		}
+
+		modifiedItem, desired := modifiedItems[key]
+		if !desired {
+			if _, applied := originalItems[key]; !applied {
+				result = append(result, runtime.DeepCopyJSONValue(item))
+			}
+
+			continue
+		}
+
+		if merged[key] {
+			continue
+		}
+
+		merged[key] = true
+		result = append(result, mergeMaps(originalItems[key], modifiedItem, itemMap, keys, path))
+	}
+
+	for _, item := range modified {
+		itemMap, key, ok := keyedItem(item, mergeKey)
+		if ok && merged[key] {
+			continue
+		}
+
+		if ok {
+			merged[key] = true
+			result = append(result, runtime.DeepCopyJSONValue(itemMap))
+
+			continue
+		}
+
+		result = append(result, runtime.DeepCopyJSONValue(item))
+	}
+
+	return result
+}
+
+// itemsByKey returns the items of list that are maps with the merge key, by key.
+func itemsByKey(list []any, mergeKey string) map[string]map[string]any {
+	items := make(map[string]map[string]any, len(list))
+
+	for _, item := range list {
+		if itemMap, key, ok := keyedItem(item, mergeKey); ok {
+			items[key] = itemMap
+		}
+	}
+
+	return items
+}
+
+// keyedItem returns item as a map and its merge key, and false when it is not a map with the merge key.
+func keyedItem(item any, mergeKey string) (map[string]any, string, bool) {
+	itemMap, ok := item.(map[string]any)
+	if !ok {
+		return nil, "", false
+	}
+
+	key, ok := itemMap[mergeKey]
+	if !ok {
+		return nil, "", false
+	}
+
+	return itemMap, fmt.Sprint(key), true
+}
+
+// joinPath returns the path of the given field under path.
+func joinPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+
+	return path + "." + field
+}
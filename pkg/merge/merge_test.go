@@ -0,0 +1,88 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package merge
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("ThreeWay", func() {
+	It("should set desired fields, remove fields no longer desired and keep the others", func() {
+		original := map[string]any{"spec": map[string]any{"replicas": int64(1), "debug": true}}
+		modified := map[string]any{"spec": map[string]any{"replicas": int64(2)}}
+		current := map[string]any{"spec": map[string]any{"replicas": int64(1), "debug": true, "paused": true}}
+
+		Expect(ThreeWay(original, modified, current, nil)).To(Equal(map[string]any{
+			"spec": map[string]any{"replicas": int64(2), "paused": true},
+		}))
+		Expect(current).To(HaveKeyWithValue("spec", HaveKeyWithValue("debug", true)))
+	})
+
+	It("should replace lists without a merge key", func() {
+		modified := map[string]any{"args": []any{"--b"}}
+		current := map[string]any{"args": []any{"--a", "--user"}}
+
+		Expect(ThreeWay(nil, modified, current, nil)).To(Equal(map[string]any{"args": []any{"--b"}}))
+	})
+
+	It("should merge lists by merge key", func() {
+		keys := ListMergeKeys{"spec.containers": "name", "spec.containers.ports": "containerPort"}
+		original := map[string]any{"spec": map[string]any{"containers": []any{
+			map[string]any{"name": "operand", "image": "v1"},
+			map[string]any{"name": "removed", "image": "v1"},
+		}}}
+		modified := map[string]any{"spec": map[string]any{"containers": []any{
+			map[string]any{"name": "operand", "image": "v2", "ports": []any{map[string]any{"containerPort": int64(8443)}}},
+			map[string]any{"name": "added", "image": "v1"},
+		}}}
+		current := map[string]any{"spec": map[string]any{"containers": []any{
+			map[string]any{"name": "sidecar", "image": "user"},
+			map[string]any{"name": "operand", "image": "v1", "ports": []any{
+				map[string]any{"containerPort": int64(9090), "name": "user-metrics"},
+			}},
+			map[string]any{"name": "removed", "image": "v1"},
+		}}}
+
+		Expect(ThreeWay(original, modified, current, keys)).To(Equal(map[string]any{"spec": map[string]any{"containers": []any{
+			map[string]any{"name": "sidecar", "image": "user"},
+			map[string]any{"name": "operand", "image": "v2", "ports": []any{
+				map[string]any{"containerPort": int64(9090), "name": "user-metrics"},
+				map[string]any{"containerPort": int64(8443)},
+			}},
+			map[string]any{"name": "added", "image": "v1"},
+		}}}))
+	})
+})
+
+var _ = Describe("ThreeWayObjects", func() {
+	It("should merge typed objects", func() {
+		original := &corev1.ConfigMap{Data: map[string]string{"a": "1", "b": "2"}}
+		modified := &corev1.ConfigMap{Data: map[string]string{"a": "3"}}
+		current := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "config", Labels: map[string]string{"user": "label"}},
+			Data:       map[string]string{"a": "1", "b": "2", "c": "user"},
+		}
+
+		Expect(ThreeWayObjects(original, modified, current, nil)).To(Succeed())
+		Expect(current.Name).To(Equal("config"))
+		Expect(current.Labels).To(HaveKeyWithValue("user", "label"))
+		Expect(current.Data).To(Equal(map[string]string{"a": "3", "c": "user"}))
+	})
+})
@@ -0,0 +1,169 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metadataonly watches and reads objects as PartialObjectMetadata, with metadata-only informers, for
+// controllers that only care about the existence or the labels of high-cardinality kinds such as Secrets or Pods.
+// Their informers hold the metadata of the objects only, rather than their whole content.
+package metadataonly
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// For returns an empty PartialObjectMetadata of the kind of obj, e.g. &corev1.Secret{}, to watch, get or list its
+// objects with metadata only.
+func For(obj client.Object, scheme *runtime.Scheme) (*metav1.PartialObjectMetadata, error) {
+	gvk, err := apiutil.GVKForObject(obj, scheme)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get GroupVersionKind of %T: %w", obj, err)
+	}
+
+	metadata := &metav1.PartialObjectMetadata{}
+	metadata.SetGroupVersionKind(gvk)
+
+	return metadata, nil
+}
+
+// Kind returns a source of the events of the objects of the kind of obj, watched with a metadata-only informer of c,
+// to watch them from a controller with WatchesRawSource. The handler and predicates, written for client.Object as
+// those of the handlers and predicates packages, receive PartialObjectMetadata objects.
+//
+// Builders watching objects of the manager's cluster can use WatchesMetadata instead, Kind suits other caches, such
+// as the cache of a secondary cluster.
+//
+// Example:
+//
+//	secrets, err := metadataonly.Kind(mgr.GetCache(), mgr.GetScheme(), &corev1.Secret{},
+//	    handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &v1alpha1.Operand{}),
+//	    predicates.LabelsChanged("example.com/rotate"))
+//	if err != nil {
+//	    return err
+//	}
+//
+//	err = ctrl.NewControllerManagedBy(mgr).
+//	    For(&v1alpha1.Operand{}).
+//	    WatchesRawSource(secrets).
+//	    Complete(r)
+func Kind(
+	c cache.Cache, scheme *runtime.Scheme, obj client.Object, h handler.EventHandler, predicates ...predicate.Predicate,
+) (source.Source, error) {
+	metadata, err := For(obj, scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	typedPredicates := make([]predicate.TypedPredicate[*metav1.PartialObjectMetadata], 0, len(predicates))
+	for _, p := range predicates {
+		typedPredicates = append(typedPredicates, TypedPredicate(p))
+	}
+
+	return source.Kind(c, metadata, TypedHandler(h), typedPredicates...), nil
+}
+
+// Exists reports whether the object of the kind of obj with the given key exists, reading its metadata only.
+// With the manager's client, this gets the metadata-only informer of the kind rather than caching whole objects.
+func Exists(ctx context.Context, reader client.Reader, scheme *runtime.Scheme, obj client.Object, key client.ObjectKey) (bool, error) {
+	metadata, err := For(obj, scheme)
+	if err != nil {
+		return false, err
+	}
+
+	if err := reader.Get(ctx, key, metadata); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return false, fmt.Errorf("failed to get %s %s: %w", metadata.Kind, key.String(), err)
+		}
+
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// TypedHandler adapts an event handler of client.Object to the events of PartialObjectMetadata.
+func TypedHandler(h handler.EventHandler) handler.TypedEventHandler[*metav1.PartialObjectMetadata, reconcile.Request] {
+	return typedHandler{handler: h}
+}
+
+// typedHandler forwards the events of PartialObjectMetadata to an event handler of client.Object.
+type typedHandler struct {
+	handler handler.EventHandler
+}
+
+// queue is the queue of the requests of a controller.
+type queue = workqueue.TypedRateLimitingInterface[reconcile.Request]
+
+// Create implements handler.TypedEventHandler.
+func (h typedHandler) Create(ctx context.Context, e event.TypedCreateEvent[*metav1.PartialObjectMetadata], q queue) {
+	h.handler.Create(ctx, event.CreateEvent{Object: e.Object, IsInInitialList: e.IsInInitialList}, q)
+}
+
+// Update implements handler.TypedEventHandler.
+func (h typedHandler) Update(ctx context.Context, e event.TypedUpdateEvent[*metav1.PartialObjectMetadata], q queue) {
+	h.handler.Update(ctx, event.UpdateEvent{ObjectOld: e.ObjectOld, ObjectNew: e.ObjectNew}, q)
+}
+
+// Delete implements handler.TypedEventHandler.
+func (h typedHandler) Delete(ctx context.Context, e event.TypedDeleteEvent[*metav1.PartialObjectMetadata], q queue) {
+	h.handler.Delete(ctx, event.DeleteEvent{Object: e.Object, DeleteStateUnknown: e.DeleteStateUnknown}, q)
+}
+
+// Generic implements handler.TypedEventHandler.
+func (h typedHandler) Generic(ctx context.Context, e event.TypedGenericEvent[*metav1.PartialObjectMetadata], q queue) {
+	h.handler.Generic(ctx, event.GenericEvent{Object: e.Object}, q)
+}
+
+// TypedPredicate adapts a predicate of client.Object to the events of PartialObjectMetadata.
+func TypedPredicate(p predicate.Predicate) predicate.TypedPredicate[*metav1.PartialObjectMetadata] {
+	return typedPredicate{predicate: p}
+}
+
+// typedPredicate forwards the events of PartialObjectMetadata to a predicate of client.Object.
+type typedPredicate struct {
+	predicate predicate.Predicate
+}
+
+// Create implements predicate.TypedPredicate.
+func (p typedPredicate) Create(e event.TypedCreateEvent[*metav1.PartialObjectMetadata]) bool {
+	return p.predicate.Create(event.CreateEvent{Object: e.Object, IsInInitialList: e.IsInInitialList})
+}
+
+// Update implements predicate.TypedPredicate.
+func (p typedPredicate) Update(e event.TypedUpdateEvent[*metav1.PartialObjectMetadata]) bool {
+	return p.predicate.Update(event.UpdateEvent{ObjectOld: e.ObjectOld, ObjectNew: e.ObjectNew})
+}
+
+// Delete implements predicate.TypedPredicate.
+func (p typedPredicate) Delete(e event.TypedDeleteEvent[*metav1.PartialObjectMetadata]) bool {
+	return p.predicate.Delete(event.DeleteEvent{Object: e.Object, DeleteStateUnknown: e.DeleteStateUnknown})
+}
+
+// Generic implements predicate.TypedPredicate.
+func (p typedPredicate) Generic(e event.TypedGenericEvent[*metav1.PartialObjectMetadata]) bool {
+	return p.predicate.Generic(event.GenericEvent{Object: e.Object})
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metadataonly
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/controller-runtime-common/pkg/predicates"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// newMetadata returns the metadata of a Secret.
+func newMetadata(name string, labels map[string]string) *metav1.PartialObjectMetadata {
+	metadata := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name, Labels: labels}}
+	metadata.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Secret"))
+
+	return metadata
+}
+
+var _ = Describe("For", func() {
+	It("should return the metadata of the kind of the object", func() {
+		metadata, err := For(&corev1.Secret{}, scheme.Scheme)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(metadata.GroupVersionKind()).To(Equal(schema.GroupVersionKind{Version: "v1", Kind: "Secret"}))
+	})
+
+	It("should fail for kinds missing from the scheme", func() {
+		_, err := For(&corev1.Secret{}, runtime.NewScheme())
+		Expect(err).To(MatchError(ContainSubstring("failed to get GroupVersionKind")))
+	})
+})
+
+var _ = Describe("Kind", func() {
+	It("should return a source of the kind of the object", func() {
+		src, err := Kind(&informertest.FakeInformers{}, scheme.Scheme, &corev1.Secret{}, &handler.EnqueueRequestForObject{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(src).NotTo(BeNil())
+	})
+
+	It("should fail for kinds missing from the scheme", func() {
+		_, err := Kind(&informertest.FakeInformers{}, runtime.NewScheme(), &corev1.Secret{}, &handler.EnqueueRequestForObject{})
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Exists", func() {
+	It("should report whether the object exists", func() {
+		reader := fake.NewClientBuilder().
+			WithObjects(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "present"}}).
+			Build()
+
+		exists, err := Exists(ctx, reader, scheme.Scheme, &corev1.Secret{}, client.ObjectKey{Namespace: "ns", Name: "present"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists).To(BeTrue())
+
+		exists, err = Exists(ctx, reader, scheme.Scheme, &corev1.Secret{}, client.ObjectKey{Namespace: "ns", Name: "missing"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists).To(BeFalse())
+	})
+})
+
+var _ = Describe("TypedHandler", func() {
+	It("should forward the events to the handler", func() {
+		queue := newQueue()
+		h := TypedHandler(&handler.EnqueueRequestForObject{})
+
+		h.Create(ctx, event.TypedCreateEvent[*metav1.PartialObjectMetadata]{Object: newMetadata("created", nil)}, queue)
+		h.Update(ctx, event.TypedUpdateEvent[*metav1.PartialObjectMetadata]{
+			ObjectOld: newMetadata("updated", nil),
+			ObjectNew: newMetadata("updated", nil),
+		}, queue)
+		h.Delete(ctx, event.TypedDeleteEvent[*metav1.PartialObjectMetadata]{Object: newMetadata("deleted", nil)}, queue)
+		h.Generic(ctx, event.TypedGenericEvent[*metav1.PartialObjectMetadata]{Object: newMetadata("generic", nil)}, queue)
+
+		Expect(drain(queue)).To(ConsistOf(
+			reconcile.Request{NamespacedName: client.ObjectKey{Namespace: "ns", Name: "created"}},
+			reconcile.Request{NamespacedName: client.ObjectKey{Namespace: "ns", Name: "updated"}},
+			reconcile.Request{NamespacedName: client.ObjectKey{Namespace: "ns", Name: "deleted"}},
+			reconcile.Request{NamespacedName: client.ObjectKey{Namespace: "ns", Name: "generic"}},
+		))
+	})
+})
+
+var _ = Describe("TypedPredicate", func() {
+	It("should forward the events to the predicate", func() {
+		p := TypedPredicate(predicates.LabelsChanged("rotate"))
+
+		Expect(p.Update(event.TypedUpdateEvent[*metav1.PartialObjectMetadata]{
+			ObjectOld: newMetadata("secret", nil),
+			ObjectNew: newMetadata("secret", map[string]string{"rotate": "true"}),
+		})).To(BeTrue())
+		Expect(p.Update(event.TypedUpdateEvent[*metav1.PartialObjectMetadata]{
+			ObjectOld: newMetadata("secret", map[string]string{"other": "true"}),
+			ObjectNew: newMetadata("secret", nil),
+		})).To(BeFalse())
+		Expect(p.Create(event.TypedCreateEvent[*metav1.PartialObjectMetadata]{Object: newMetadata("secret", nil)})).To(BeTrue())
+	})
+})
@@ -0,0 +1,132 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metricsregistry holds the metrics of the packages of this library, so that consumers can register them with
+// the Prometheus registerers of their choice, e.g. one per manager when embedding several managers in one process,
+// rather than only with the controller-runtime metrics registry.
+package metricsregistry
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// libraryCollector collects the metrics of the collectors added by the packages of this library.
+// It is unchecked, as it does not describe its metrics, so that collectors can be added after it is registered.
+type libraryCollector struct {
+	mu         sync.RWMutex
+	collectors []prometheus.Collector
+}
+
+// Describe implements prometheus.Collector.
+func (c *libraryCollector) Describe(chan<- *prometheus.Desc) {}
+
+// Collect implements prometheus.Collector.
+func (c *libraryCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, collector := range c.collectors {
+		collector.Collect(ch)
+	}
+}
+
+// registration collects the metrics of the library for one registerer, unless it is disabled. Unchecked collectors
+// cannot be unregistered, so registrations are disabled instead.
+type registration struct {
+	disabled atomic.Bool
+}
+
+// Describe implements prometheus.Collector.
+func (r *registration) Describe(chan<- *prometheus.Desc) {}
+
+// Collect implements prometheus.Collector.
+func (r *registration) Collect(ch chan<- prometheus.Metric) {
+	if !r.disabled.Load() {
+		collector.Collect(ch)
+	}
+}
+
+var (
+	collector = &libraryCollector{}
+
+	mu            sync.Mutex
+	registrations = map[prometheus.Registerer]*registration{}
+)
+
+func init() {
+	defaultRegistration := &registration{}
+	metrics.Registry.MustRegister(defaultRegistration)
+	registrations[metrics.Registry] = defaultRegistration
+}
+
+// Add adds collectors to the metrics of the library. It is called by the packages of this library when initialized,
+// instead of registering their collectors with a registerer.
+func Add(collectors ...prometheus.Collector) {
+	collector.mu.Lock()
+	defer collector.mu.Unlock()
+
+	collector.collectors = append(collector.collectors, collectors...)
+}
+
+// Collector returns the collector of the metrics of the library.
+func Collector() prometheus.Collector {
+	return collector
+}
+
+// Register registers the metrics of the library with registerer, in addition to the controller-runtime metrics
+// registry they are registered with by default. Registering them twice with the same registerer is a no-op.
+//
+// The collectors of the library must not be registered with the registerer individually, or the metrics would be
+// gathered twice.
+//
+// Example:
+//
+//	registry := prometheus.NewRegistry()
+//	if err := metricsregistry.Register(registry); err != nil {
+//	    return err
+//	}
+func Register(registerer prometheus.Registerer) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if r, ok := registrations[registerer]; ok {
+		r.disabled.Store(false)
+		return nil
+	}
+
+	r := &registration{}
+	if err := registerer.Register(r); err != nil {
+		return fmt.Errorf("failed to register the metrics of the library: %w", err)
+	}
+
+	registrations[registerer] = r
+
+	return nil
+}
+
+// UnregisterDefault stops serving the metrics of the library with the controller-runtime metrics registry, so that
+// they are only served with the registerers given to Register.
+func UnregisterDefault() {
+	mu.Lock()
+	defer mu.Unlock()
+
+	registrations[metrics.Registry].disabled.Store(true)
+}
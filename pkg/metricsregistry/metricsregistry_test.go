@@ -0,0 +1,64 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metricsregistry
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var _ = Describe("Registry", func() {
+	counter := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "metricsregistry_test_total",
+		Help: "Counter of the metrics registry tests.",
+	})
+
+	BeforeEach(func() {
+		Add(counter)
+		DeferCleanup(func() {
+			collector.mu.Lock()
+			defer collector.mu.Unlock()
+
+			collector.collectors = collector.collectors[:len(collector.collectors)-1]
+		})
+	})
+
+	It("should register the metrics with several registerers", func() {
+		counter.Inc()
+
+		first, second := prometheus.NewRegistry(), prometheus.NewRegistry()
+		Expect(Register(first)).To(Succeed())
+		Expect(Register(first)).To(Succeed())
+		Expect(Register(second)).To(Succeed())
+
+		Expect(testutil.GatherAndCount(first, "metricsregistry_test_total")).To(Equal(1))
+		Expect(testutil.GatherAndCount(second, "metricsregistry_test_total")).To(Equal(1))
+		Expect(testutil.GatherAndCount(metrics.Registry, "metricsregistry_test_total")).To(Equal(1))
+	})
+
+	It("should unregister the metrics from the controller-runtime metrics registry", func() {
+		UnregisterDefault()
+		DeferCleanup(func() {
+			Expect(Register(metrics.Registry)).To(Succeed())
+		})
+
+		Expect(testutil.GatherAndCount(metrics.Registry, "metricsregistry_test_total")).To(BeZero())
+	})
+})
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package middleware wraps reconcilers with composable middlewares handling cross-cutting concerns, such as logging,
+// panic recovery and timeouts, so that they are configured once per manager rather than in every controller.
+//
+// The tracing middlewares start spans with a Tracer adapting the tracing SDK of the operator. Exporting the spans,
+// e.g. with OTLP, is left to that SDK: this package does not provide an exporter.
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/go-logr/logr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// LogKeyController is the log key of the name of the controller.
+	LogKeyController = "controller"
+	// LogKeyNamespace is the log key of the namespace of the request.
+	LogKeyNamespace = "namespace"
+	// LogKeyName is the log key of the name of the request.
+	LogKeyName = "name"
+)
+
+// Middleware wraps the reconciler of the named controller.
+type Middleware func(controller string, next reconcile.Reconciler) reconcile.Reconciler
+
+// Chain returns a middleware applying the middlewares in order, the first one being the outermost.
+//
+// Example:
+//
+//	chain := middleware.Chain(
+//	    middleware.Logging(mgr.GetLogger()),
+//	    middleware.Recover(),
+//	    middleware.Timeout(5*time.Minute),
+//	)
+//	err := ctrl.NewControllerManagedBy(mgr).
+//	    Named("operand").
+//	    For(&v1alpha1.Operand{}).
+//	    Complete(chain("operand", r))
+func Chain(middlewares ...Middleware) Middleware {
+	return func(controller string, next reconcile.Reconciler) reconcile.Reconciler {
+		for i := len(middlewares) - 1; i >= 0; i-- {
+			next = middlewares[i](controller, next)
+		}
+
+		return next
+	}
+}
+
+// Logging returns a middleware setting a logger derived from logger, with the controller, namespace and name of the
+// request under the LogKey keys, in the context of the reconciliation, and logging its outcome at V(1).
+// Errors are not logged, as controller-runtime logs them.
+func Logging(logger logr.Logger) Middleware {
+	return func(controller string, next reconcile.Reconciler) reconcile.Reconciler {
+		return reconcile.Func(func(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+			reqLogger := logger.WithValues(LogKeyController, controller, LogKeyNamespace, req.Namespace, LogKeyName, req.Name)
+			ctx = log.IntoContext(ctx, reqLogger)
+
+			start := time.Now()
+			result, err := next.Reconcile(ctx, req)
+
+			reqLogger.V(1).Info("Reconciled",
+				"duration", time.Since(start).String(),
+				"requeueAfter", result.RequeueAfter.String(),
+				"failed", err != nil,
+			)
+
+			return result, err
+		})
+	}
+}
+
+// Recover returns a middleware converting the panics of the reconciliation into errors, so that the request is retried
+// with backoff rather than crashing the process, and counting them in the controller_reconcile_panics_total metric of
+// the library, see metricsregistry.
+func Recover() Middleware {
+	return func(controller string, next reconcile.Reconciler) reconcile.Reconciler {
+		return reconcile.Func(func(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					reconcilePanics.WithLabelValues(controller).Inc()
+
+					log.FromContext(ctx).Error(fmt.Errorf("%v", r), "Observed a panic", "stacktrace", string(debug.Stack()))
+
+					result, err = ctrl.Result{}, fmt.Errorf("panic in reconciliation of %s: %v", req.String(), r)
+				}
+			}()
+
+			return next.Reconcile(ctx, req)
+		})
+	}
+}
+
+// Timeout returns a middleware cancelling the context of the reconciliation after timeout, so that stuck calls are
+// interrupted and the request retried.
+func Timeout(timeout time.Duration) Middleware {
+	return func(_ string, next reconcile.Reconciler) reconcile.Reconciler {
+		return reconcile.Func(func(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			return next.Reconcile(ctx, req)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr/funcr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("Chain", func() {
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "operand"}}
+
+	It("should apply the middlewares in order", func() {
+		var calls []string
+
+		record := func(name string) Middleware {
+			return func(controller string, next reconcile.Reconciler) reconcile.Reconciler {
+				return reconcile.Func(func(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+					calls = append(calls, name+":"+controller)
+					return next.Reconcile(ctx, req)
+				})
+			}
+		}
+
+		r := Chain(record("outer"), record("inner"))("operand", reconcile.Func(func(context.Context, ctrl.Request) (ctrl.Result, error) {
+			calls = append(calls, "reconciler")
+			return ctrl.Result{RequeueAfter: time.Minute}, nil
+		}))
+
+		Expect(r.Reconcile(ctx, req)).To(Equal(ctrl.Result{RequeueAfter: time.Minute}))
+		Expect(calls).To(Equal([]string{"outer:operand", "inner:operand", "reconciler"}))
+	})
+
+	It("should log with consistent keys", func() {
+		var lines []string
+		logger := funcr.New(func(_, args string) { lines = append(lines, args) }, funcr.Options{Verbosity: 1})
+
+		r := Logging(logger)("operand", reconcile.Func(func(ctx context.Context, _ ctrl.Request) (ctrl.Result, error) {
+			log.FromContext(ctx).Info("Reconciling")
+			return ctrl.Result{}, nil
+		}))
+
+		Expect(r.Reconcile(ctx, req)).To(Equal(ctrl.Result{}))
+		Expect(lines).To(HaveLen(2))
+		Expect(lines[0]).To(ContainSubstring(`"msg"="Reconciling" "controller"="operand" "namespace"="ns" "name"="operand"`))
+		Expect(lines[1]).To(ContainSubstring(`"msg"="Reconciled"`))
+	})
+
+	It("should recover from panics", func() {
+		before := testutil.ToFloat64(reconcilePanics.WithLabelValues("panicking"))
+
+		r := Recover()("panicking", reconcile.Func(func(context.Context, ctrl.Request) (ctrl.Result, error) {
+			panic("boom")
+		}))
+
+		_, err := r.Reconcile(ctx, req)
+		Expect(err).To(MatchError(ContainSubstring("panic in reconciliation of ns/operand: boom")))
+		Expect(testutil.ToFloat64(reconcilePanics.WithLabelValues("panicking"))).To(Equal(before + 1))
+	})
+
+	It("should cancel reconciliations exceeding the timeout", func() {
+		r := Timeout(10*time.Millisecond)("operand", reconcile.Func(func(ctx context.Context, _ ctrl.Request) (ctrl.Result, error) {
+			<-ctx.Done()
+			return ctrl.Result{}, ctx.Err()
+		}))
+
+		_, err := r.Reconcile(ctx, req)
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+	})
+})
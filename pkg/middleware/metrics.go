@@ -0,0 +1,109 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/openshift/controller-runtime-common/pkg/metricsregistry"
+	"github.com/openshift/controller-runtime-common/pkg/reconcileerr"
+	"github.com/prometheus/client_golang/prometheus"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// ResultSuccess is the result of reconciliations succeeding without requeue.
+	ResultSuccess = "success"
+	// ResultRequeue is the result of reconciliations asking to be requeued with backoff.
+	ResultRequeue = "requeue"
+	// ResultRequeueAfter is the result of reconciliations asking to be requeued after a delay.
+	ResultRequeueAfter = "requeue_after"
+	// ResultError is the result of reconciliations failing with an error to retry.
+	ResultError = "error"
+	// ResultTerminalError is the result of reconciliations failing with a terminal error.
+	ResultTerminalError = "terminal_error"
+)
+
+var (
+	reconcilePanics = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "controller_reconcile_panics_total",
+		Help: "Number of reconciliations that panicked and were recovered, by controller.",
+	}, []string{"controller"})
+
+	reconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "controller_reconcile_duration_seconds",
+		Help:    "Duration of reconciliations in seconds, by controller.",
+		Buckets: []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"controller"})
+
+	reconcileResults = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "controller_reconcile_results_total",
+		Help: "Number of reconciliations, by controller and result: success, requeue, requeue_after, error or terminal_error.",
+	}, []string{"controller", "result"})
+
+	activeReconciles = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "controller_reconcile_active",
+		Help: "Number of reconciliations in progress, by controller.",
+	}, []string{"controller"})
+)
+
+func init() {
+	metricsregistry.Add(reconcilePanics, reconcileDuration, reconcileResults, activeReconciles)
+}
+
+// Metrics returns a middleware recording the outcome of reconciliations in the metrics of the library, see
+// metricsregistry: their duration in controller_reconcile_duration_seconds, their result in
+// controller_reconcile_results_total, and the reconciliations in progress in controller_reconcile_active.
+//
+// Example:
+//
+//	chain := middleware.Chain(middleware.Metrics(), middleware.Recover())
+func Metrics() Middleware {
+	return func(controller string, next reconcile.Reconciler) reconcile.Reconciler {
+		return reconcile.Func(func(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+			active := activeReconciles.WithLabelValues(controller)
+			active.Inc()
+			defer active.Dec()
+
+			start := time.Now()
+			result, err := next.Reconcile(ctx, req)
+
+			reconcileDuration.WithLabelValues(controller).Observe(time.Since(start).Seconds())
+			reconcileResults.WithLabelValues(controller, resultOf(result, err)).Inc()
+
+			return result, err
+		})
+	}
+}
+
+// resultOf returns the result label of the outcome of a reconciliation.
+func resultOf(result ctrl.Result, err error) string {
+	switch {
+	case reconcileerr.IsTerminal(err):
+		return ResultTerminalError
+	case err != nil:
+		return ResultError
+	case result.RequeueAfter > 0:
+		return ResultRequeueAfter
+	case result.Requeue: //nolint:staticcheck // Requeue is deprecated but still honored by controller-runtime.
+		return ResultRequeue
+	default:
+		return ResultSuccess
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/controller-runtime-common/pkg/metricsregistry"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("Metrics", func() {
+	It("should serve the metrics with the metrics of the library", func() {
+		registry := prometheus.NewRegistry()
+		Expect(metricsregistry.Register(registry)).To(Succeed())
+
+		activeReconciles.WithLabelValues("registered").Set(0)
+		Expect(testutil.GatherAndCount(registry, "controller_reconcile_active")).NotTo(BeZero())
+	})
+
+	It("should record the results of reconciliations", func() {
+		outcomes := []struct {
+			result ctrl.Result
+			err    error
+		}{
+			{},
+			{result: ctrl.Result{RequeueAfter: time.Minute}},
+			{err: errors.New("failed")},
+			{err: reconcile.TerminalError(errors.New("invalid"))},
+		}
+
+		for _, outcome := range outcomes {
+			r := Metrics()("metrics", reconcile.Func(func(context.Context, ctrl.Request) (ctrl.Result, error) {
+				Expect(testutil.ToFloat64(activeReconciles.WithLabelValues("metrics"))).To(Equal(1.0))
+				return outcome.result, outcome.err
+			}))
+
+			_, _ = r.Reconcile(ctx, ctrl.Request{})
+		}
+
+		for _, result := range []string{ResultSuccess, ResultRequeueAfter, ResultError, ResultTerminalError} {
+			Expect(testutil.ToFloat64(reconcileResults.WithLabelValues("metrics", result))).To(Equal(1.0), result)
+		}
+
+		Expect(testutil.ToFloat64(reconcileResults.WithLabelValues("metrics", ResultRequeue))).To(BeZero())
+		Expect(testutil.ToFloat64(activeReconciles.WithLabelValues("metrics"))).To(BeZero())
+		Expect(testutil.CollectAndCount(reconcileDuration, "controller_reconcile_duration_seconds")).To(Equal(1))
+	})
+})
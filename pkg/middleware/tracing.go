@@ -0,0 +1,308 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// AttributeController is the span attribute of the name of the controller.
+	AttributeController = "controller"
+	// AttributeGVK is the span attribute of the group, version and kind of an object.
+	AttributeGVK = "k8s.gvk"
+	// AttributeNamespace is the span attribute of the namespace of an object.
+	AttributeNamespace = "k8s.namespace"
+	// AttributeName is the span attribute of the name of an object.
+	AttributeName = "k8s.name"
+	// AttributeResult is the span attribute of the result of a reconciliation, one of the Result constants.
+	AttributeResult = "reconcile.result"
+	// AttributeVerb is the span attribute of the verb of an API call.
+	AttributeVerb = "k8s.verb"
+)
+
+// Attribute is an attribute of a span.
+type Attribute struct {
+	Key   string
+	Value string
+}
+
+// Span is an operation of a trace.
+type Span interface {
+	// SetAttributes sets attributes of the span.
+	SetAttributes(attributes ...Attribute)
+	// End ends the span, recording err when not nil.
+	End(err error)
+}
+
+// Tracer starts spans. It decouples the middlewares from the tracing SDK, which exports the spans; an OpenTelemetry
+// tracer is adapted by starting its spans with the attributes converted to attribute.String, and by recording the error
+// and setting an error status before ending them.
+type Tracer interface {
+	// Start starts a span, child of the span of ctx if any, and returns the context holding it.
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Tracing returns a middleware starting a span per reconciliation, with the controller, namespace and name of the
+// request and the result as attributes. kinds maps the names of the controllers to the kinds they reconcile, which are
+// set as the AttributeGVK attribute, and may be nil.
+func Tracing(tracer Tracer, kinds map[string]schema.GroupVersionKind) Middleware {
+	return func(controller string, next reconcile.Reconciler) reconcile.Reconciler {
+		return reconcile.Func(func(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+			ctx, span := tracer.Start(ctx, "Reconcile "+controller)
+
+			span.SetAttributes(
+				Attribute{Key: AttributeController, Value: controller},
+				Attribute{Key: AttributeNamespace, Value: req.Namespace},
+				Attribute{Key: AttributeName, Value: req.Name},
+			)
+
+			if gvk, ok := kinds[controller]; ok {
+				span.SetAttributes(Attribute{Key: AttributeGVK, Value: gvk.String()})
+			}
+
+			result, err := next.Reconcile(ctx, req)
+
+			span.SetAttributes(Attribute{Key: AttributeResult, Value: resultOf(result, err)})
+			span.End(err)
+
+			return result, err
+		})
+	}
+}
+
+// TracingClient returns a client starting a span per API call made with c, as a child of the span of the
+// reconciliation, with the verb, kind, namespace and name of the object as attributes.
+func TracingClient(c client.Client, tracer Tracer) client.Client {
+	return &tracingClient{Client: c, tracer: tracer}
+}
+
+// tracingClient is a client tracing its API calls.
+type tracingClient struct {
+	client.Client
+
+	tracer Tracer
+}
+
+// Get implements client.Client.
+func (c *tracingClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	ctx, span := c.start(ctx, "get", obj, key)
+	err := c.Client.Get(ctx, key, obj, opts...)
+	span.End(err)
+
+	return err
+}
+
+// List implements client.Client.
+func (c *tracingClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+
+	ctx, span := c.start(ctx, "list", list, client.ObjectKey{Namespace: listOpts.Namespace})
+	err := c.Client.List(ctx, list, opts...)
+	span.End(err)
+
+	return err
+}
+
+// Create implements client.Client.
+func (c *tracingClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	ctx, span := c.start(ctx, "create", obj, client.ObjectKeyFromObject(obj))
+	err := c.Client.Create(ctx, obj, opts...)
+	span.End(err)
+
+	return err
+}
+
+// Update implements client.Client.
+func (c *tracingClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	ctx, span := c.start(ctx, "update", obj, client.ObjectKeyFromObject(obj))
+	err := c.Client.Update(ctx, obj, opts...)
+	span.End(err)
+
+	return err
+}
+
+// Patch implements client.Client.
+func (c *tracingClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	ctx, span := c.start(ctx, "patch", obj, client.ObjectKeyFromObject(obj))
+	err := c.Client.Patch(ctx, obj, patch, opts...)
+	span.End(err)
+
+	return err
+}
+
+// Delete implements client.Client.
+func (c *tracingClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	ctx, span := c.start(ctx, "delete", obj, client.ObjectKeyFromObject(obj))
+	err := c.Client.Delete(ctx, obj, opts...)
+	span.End(err)
+
+	return err
+}
+
+// DeleteAllOf implements client.Client.
+func (c *tracingClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	deleteOpts := &client.DeleteAllOfOptions{}
+	deleteOpts.ApplyOptions(opts)
+
+	ctx, span := c.start(ctx, "deletecollection", obj, client.ObjectKey{Namespace: deleteOpts.Namespace})
+	err := c.Client.DeleteAllOf(ctx, obj, opts...)
+	span.End(err)
+
+	return err
+}
+
+// Apply implements client.Client.
+func (c *tracingClient) Apply(ctx context.Context, obj runtime.ApplyConfiguration, opts ...client.ApplyOption) error {
+	ctx, span := c.startApply(ctx, "apply", obj)
+	err := c.Client.Apply(ctx, obj, opts...)
+	span.End(err)
+
+	return err
+}
+
+// Status implements client.Client.
+func (c *tracingClient) Status() client.SubResourceWriter {
+	return c.SubResource("status")
+}
+
+// SubResource implements client.Client.
+func (c *tracingClient) SubResource(subResource string) client.SubResourceClient {
+	return &tracingSubResourceClient{SubResourceClient: c.Client.SubResource(subResource), client: c, subResource: subResource}
+}
+
+// start starts the span of an API call.
+func (c *tracingClient) start(ctx context.Context, verb string, obj runtime.Object, key client.ObjectKey) (context.Context, Span) {
+	kind := fmt.Sprintf("%T", obj)
+	if gvk, err := apiutil.GVKForObject(obj, c.Scheme()); err == nil {
+		kind = gvk.String()
+	}
+
+	return c.startKind(ctx, verb, kind, key)
+}
+
+// typedApplyConfiguration is implemented by the generated apply configurations of the typed objects.
+type typedApplyConfiguration interface {
+	GetAPIVersion() *string
+	GetKind() *string
+	GetNamespace() *string
+	GetName() *string
+}
+
+// startApply starts the span of a server-side apply call.
+func (c *tracingClient) startApply(ctx context.Context, verb string, obj runtime.ApplyConfiguration) (context.Context, Span) {
+	switch ac := obj.(type) {
+	case client.Object:
+		// Unstructured apply configurations, see client.ApplyConfigurationFromUnstructured.
+		return c.start(ctx, verb, ac, client.ObjectKeyFromObject(ac))
+	case typedApplyConfiguration:
+		kind := fmt.Sprintf("%T", obj)
+		if gv, err := schema.ParseGroupVersion(ptr.Deref(ac.GetAPIVersion(), "")); err == nil {
+			kind = gv.WithKind(ptr.Deref(ac.GetKind(), "")).String()
+		}
+
+		key := client.ObjectKey{Namespace: ptr.Deref(ac.GetNamespace(), ""), Name: ptr.Deref(ac.GetName(), "")}
+
+		return c.startKind(ctx, verb, kind, key)
+	default:
+		return c.startKind(ctx, verb, fmt.Sprintf("%T", obj), client.ObjectKey{})
+	}
+}
+
+// startKind starts the span of an API call on an object of kind.
+func (c *tracingClient) startKind(ctx context.Context, verb, kind string, key client.ObjectKey) (context.Context, Span) {
+	ctx, span := c.tracer.Start(ctx, verb+" "+kind)
+	span.SetAttributes(
+		Attribute{Key: AttributeVerb, Value: verb},
+		Attribute{Key: AttributeGVK, Value: kind},
+		Attribute{Key: AttributeNamespace, Value: key.Namespace},
+		Attribute{Key: AttributeName, Value: key.Name},
+	)
+
+	return ctx, span
+}
+
+// tracingSubResourceClient is a subresource client tracing its API calls.
+type tracingSubResourceClient struct {
+	client.SubResourceClient
+
+	client      *tracingClient
+	subResource string
+}
+
+// Get implements client.SubResourceClient.
+func (c *tracingSubResourceClient) Get(
+	ctx context.Context, obj, subResource client.Object, opts ...client.SubResourceGetOption,
+) error {
+	ctx, span := c.client.start(ctx, "get/"+c.subResource, obj, client.ObjectKeyFromObject(obj))
+	err := c.SubResourceClient.Get(ctx, obj, subResource, opts...)
+	span.End(err)
+
+	return err
+}
+
+// Create implements client.SubResourceClient.
+func (c *tracingSubResourceClient) Create(
+	ctx context.Context, obj, subResource client.Object, opts ...client.SubResourceCreateOption,
+) error {
+	ctx, span := c.client.start(ctx, "create/"+c.subResource, obj, client.ObjectKeyFromObject(obj))
+	err := c.SubResourceClient.Create(ctx, obj, subResource, opts...)
+	span.End(err)
+
+	return err
+}
+
+// Update implements client.SubResourceClient.
+func (c *tracingSubResourceClient) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	ctx, span := c.client.start(ctx, "update/"+c.subResource, obj, client.ObjectKeyFromObject(obj))
+	err := c.SubResourceClient.Update(ctx, obj, opts...)
+	span.End(err)
+
+	return err
+}
+
+// Patch implements client.SubResourceClient.
+func (c *tracingSubResourceClient) Patch(
+	ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption,
+) error {
+	ctx, span := c.client.start(ctx, "patch/"+c.subResource, obj, client.ObjectKeyFromObject(obj))
+	err := c.SubResourceClient.Patch(ctx, obj, patch, opts...)
+	span.End(err)
+
+	return err
+}
+
+// Apply implements client.SubResourceClient.
+func (c *tracingSubResourceClient) Apply(
+	ctx context.Context, obj runtime.ApplyConfiguration, opts ...client.SubResourceApplyOption,
+) error {
+	ctx, span := c.client.startApply(ctx, "apply/"+c.subResource, obj)
+	err := c.SubResourceClient.Apply(ctx, obj, opts...)
+	span.End(err)
+
+	return err
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	applycorev1 "k8s.io/client-go/applyconfigurations/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// spanKey is the context key of the current fakeSpan.
+type spanKey struct{}
+
+// fakeSpan is a span recorded by a fakeTracer.
+type fakeSpan struct {
+	name       string
+	parent     *fakeSpan
+	attributes map[string]string
+	ended      bool
+	err        error
+}
+
+func (s *fakeSpan) SetAttributes(attributes ...Attribute) {
+	for _, attribute := range attributes {
+		s.attributes[attribute.Key] = attribute.Value
+	}
+}
+
+func (s *fakeSpan) End(err error) {
+	s.ended, s.err = true, err
+}
+
+// fakeTracer records the spans it starts.
+type fakeTracer struct {
+	mu    sync.Mutex
+	spans []*fakeSpan
+}
+
+func (t *fakeTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	parent, _ := ctx.Value(spanKey{}).(*fakeSpan)
+	span := &fakeSpan{name: name, parent: parent, attributes: map[string]string{}}
+	t.spans = append(t.spans, span)
+
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+var _ = Describe("Tracing", func() {
+	It("should trace reconciliations and their API calls", func() {
+		tracer := &fakeTracer{}
+		c := TracingClient(fake.NewClientBuilder().Build(), tracer)
+		failure := errors.New("failed")
+
+		r := Tracing(tracer, map[string]schema.GroupVersionKind{"operand": {Group: "example.com", Version: "v1", Kind: "Operand"}})(
+			"operand",
+			reconcile.Func(func(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+				configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: req.Namespace, Name: "config"}}
+				Expect(c.Create(ctx, configMap)).To(Succeed())
+				Expect(c.List(ctx, &corev1.ConfigMapList{}, client.InNamespace(req.Namespace))).To(Succeed())
+
+				return ctrl.Result{}, failure
+			}),
+		)
+
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "operand"}})
+		Expect(err).To(MatchError(failure))
+
+		Expect(tracer.spans).To(HaveLen(3))
+		reconcileSpan, createSpan, listSpan := tracer.spans[0], tracer.spans[1], tracer.spans[2]
+
+		Expect(reconcileSpan.name).To(Equal("Reconcile operand"))
+		Expect(reconcileSpan.attributes).To(Equal(map[string]string{
+			AttributeController: "operand",
+			AttributeNamespace:  "ns",
+			AttributeName:       "operand",
+			AttributeGVK:        "example.com/v1, Kind=Operand",
+			AttributeResult:     ResultError,
+		}))
+		Expect(reconcileSpan.ended).To(BeTrue())
+		Expect(reconcileSpan.err).To(MatchError(failure))
+
+		Expect(createSpan.name).To(Equal("create /v1, Kind=ConfigMap"))
+		Expect(createSpan.parent).To(BeIdenticalTo(reconcileSpan))
+		Expect(createSpan.attributes).To(HaveKeyWithValue(AttributeName, "config"))
+		Expect(createSpan.ended).To(BeTrue())
+		Expect(createSpan.err).NotTo(HaveOccurred())
+
+		Expect(listSpan.attributes).To(HaveKeyWithValue(AttributeVerb, "list"))
+		Expect(listSpan.attributes).To(HaveKeyWithValue(AttributeNamespace, "ns"))
+	})
+
+	It("should trace the calls to subresources", func() {
+		tracer := &fakeTracer{}
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "pod"}}
+		c := TracingClient(fake.NewClientBuilder().WithObjects(pod).WithStatusSubresource(pod).Build(), tracer)
+
+		pod.Status.Phase = corev1.PodRunning
+		Expect(c.Status().Update(ctx, pod)).To(Succeed())
+		Expect(tracer.spans).To(HaveLen(1))
+		Expect(tracer.spans[0].attributes).To(HaveKeyWithValue(AttributeVerb, "update/status"))
+		Expect(tracer.spans[0].attributes).To(HaveKeyWithValue(AttributeName, "pod"))
+	})
+	It("should trace server-side apply calls", func() {
+		tracer := &fakeTracer{}
+		c := TracingClient(fake.NewClientBuilder().Build(), tracer)
+
+		config := applycorev1.ConfigMap("config", "ns").WithData(map[string]string{"key": "value"})
+		Expect(c.Apply(ctx, config, client.FieldOwner("operator"))).To(Succeed())
+		Expect(tracer.spans).To(HaveLen(1))
+		Expect(tracer.spans[0].name).To(Equal("apply /v1, Kind=ConfigMap"))
+		Expect(tracer.spans[0].attributes).To(HaveKeyWithValue(AttributeVerb, "apply"))
+		Expect(tracer.spans[0].attributes).To(HaveKeyWithValue(AttributeNamespace, "ns"))
+		Expect(tracer.spans[0].attributes).To(HaveKeyWithValue(AttributeName, "config"))
+	})
+})
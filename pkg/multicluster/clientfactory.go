@@ -0,0 +1,601 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multicluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/openshift/controller-runtime-common/pkg/metricsregistry"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// DefaultProbeInterval is the default interval at which the API server of the cluster is probed.
+	DefaultProbeInterval = 30 * time.Second
+)
+
+// ErrClientNotLoaded is returned by the client of a ClientFactory before its kubeconfig is loaded.
+var ErrClientNotLoaded = errors.New("the kubeconfig of the client is not loaded")
+
+var (
+	clientReloads = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "kubeconfig_client_reloads_total",
+		Help: "Number of times the client of a cluster was rebuilt from its rotated kubeconfig, by Secret.",
+	}, []string{"secret"})
+
+	clientReloadErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "kubeconfig_client_reload_errors_total",
+		Help: "Number of times the client of a cluster failed to be rebuilt from its kubeconfig, by Secret.",
+	}, []string{"secret"})
+
+	clientConnected = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kubeconfig_client_connected",
+		Help: "Whether the API server of the cluster of a kubeconfig was ready when last probed, by Secret.",
+	}, []string{"secret"})
+)
+
+func init() {
+	metricsregistry.Add(clientReloads, clientReloadErrors, clientConnected)
+}
+
+// ClientFactory builds a client of a cluster, e.g. an external or hosted cluster, from the kubeconfig held by a
+// Secret, and rebuilds it when the kubeconfig is rotated. The client returned by ClusterClient stays the same across
+// rotations: each call is made with the current kubeconfig, and the connections of the previous one are closed once
+// it is replaced. The readiness of the API server is probed periodically and exported in the
+// kubeconfig_client_connected metric.
+//
+// Call Load before starting the manager so that the client is usable immediately.
+//
+// Example:
+//
+//	factory := &multicluster.ClientFactory{
+//	    Client:  mgr.GetClient(),
+//	    Secret:  types.NamespacedName{Namespace: "clusters-example", Name: "admin-kubeconfig"},
+//	    Options: client.Options{Scheme: scheme},
+//	}
+//	if err := factory.Load(ctx, mgr.GetAPIReader()); err != nil {
+//	    return err
+//	}
+//	if err := factory.SetupWithManager(mgr); err != nil {
+//	    return err
+//	}
+//	hostedClient := factory.ClusterClient()
+type ClientFactory struct {
+	client.Client
+
+	// Secret is the Secret holding the kubeconfig.
+	Secret types.NamespacedName
+
+	// Key is the key of the Secret holding the kubeconfig. Defaults to DefaultKubeconfigKey.
+	Key string
+
+	// Options configure the clients built. Their HTTP client is built from the kubeconfig.
+	Options client.Options
+
+	// CacheOptions enable serving reads from a cache of the cluster, started with the manager, when set.
+	// Their HTTP client is built from the kubeconfig.
+	CacheOptions *cache.Options
+
+	// ProbeInterval is the interval at which the API server of the cluster is probed.
+	// Defaults to DefaultProbeInterval.
+	ProbeInterval time.Duration
+
+	mu       sync.RWMutex
+	current  *kubeconfigClient
+	reloaded chan *kubeconfigClient
+}
+
+// kubeconfigClient is a client built from a kubeconfig.
+type kubeconfigClient struct {
+	hash       string
+	httpClient *http.Client
+	client     client.Client
+	cache      cache.Cache
+	discovery  discovery.DiscoveryInterface
+
+	// stopCache stops the cache, once it is started.
+	stopCache context.CancelFunc
+}
+
+// ClusterClient returns the client of the cluster, making each call with the current kubeconfig.
+func (f *ClientFactory) ClusterClient() client.Client {
+	return &reloadingClient{factory: f}
+}
+
+// Load builds the client from the current kubeconfig using the given reader.
+// It is intended to be called with the manager's APIReader before the manager is started.
+func (f *ClientFactory) Load(ctx context.Context, reader client.Reader) error {
+	secret := &corev1.Secret{}
+	if err := reader.Get(ctx, f.Secret, secret); err != nil {
+		return fmt.Errorf("failed to get Secret %s: %w", f.Secret.String(), err)
+	}
+
+	next, err := f.build(secret)
+	if err != nil {
+		return err
+	}
+
+	f.swap(next)
+
+	return nil
+}
+
+// SetupWithManager adds the ClientFactory to the manager, with the controller rebuilding the client when the
+// kubeconfig is rotated.
+func (f *ClientFactory) SetupWithManager(mgr ctrl.Manager) error {
+	f.mu.Lock()
+	f.reloaded = make(chan *kubeconfigClient)
+	f.mu.Unlock()
+
+	if err := mgr.Add(f); err != nil {
+		return fmt.Errorf("failed to add kubeconfig client factory to manager: %w", err)
+	}
+
+	isSecret := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return client.ObjectKeyFromObject(obj) == f.Secret
+	})
+
+	name := "kubeconfigclient-" + f.Secret.Namespace + "-" + f.Secret.Name
+
+	if err := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{NeedLeaderElection: ptr.To(false)}).
+		For(&corev1.Secret{}, builder.WithPredicates(isSecret)).
+		// Override the default log constructor as it makes the logs very chatty.
+		WithLogConstructor(func(_ *reconcile.Request) logr.Logger {
+			return mgr.GetLogger().WithValues(
+				"controller", name,
+			)
+		}).
+		Complete(f); err != nil {
+		return fmt.Errorf("could not set up controller for kubeconfig client of Secret %s: %w", f.Secret.String(), err)
+	}
+
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+// Every replica uses the client, so the factory runs regardless of leadership.
+func (f *ClientFactory) NeedLeaderElection() bool {
+	return false
+}
+
+// Start starts the caches of the clients built and probes the API server of the cluster until the context is done.
+func (f *ClientFactory) Start(ctx context.Context) error {
+	ctx = log.IntoContext(ctx, log.FromContext(ctx).WithName("kubeconfig-client").WithValues("secret", f.Secret.String()))
+
+	f.mu.RLock()
+	current, reloaded := f.current, f.reloaded
+	f.mu.RUnlock()
+
+	if current != nil {
+		f.startCache(ctx, current)
+	}
+
+	interval := f.ProbeInterval
+	if interval <= 0 {
+		interval = DefaultProbeInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	f.probe(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case next := <-reloaded:
+			f.startCache(ctx, next)
+			f.swap(next)
+		case <-ticker.C:
+			f.probe(ctx)
+		}
+	}
+}
+
+// Reconcile rebuilds the client when the kubeconfig has been rotated.
+func (f *ClientFactory) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx, "secret", req.String())
+
+	secret := &corev1.Secret{}
+	if err := f.Get(ctx, req.NamespacedName, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Keep the current client, which keeps working until its credentials expire.
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get Secret %s: %w", req.NamespacedName.String(), err)
+	}
+
+	f.mu.RLock()
+	current, reloaded := f.current, f.reloaded
+	f.mu.RUnlock()
+
+	if current != nil && current.hash == kubeconfigHash(secret, f.Key) {
+		return ctrl.Result{}, nil
+	}
+
+	next, err := f.build(secret)
+	if err != nil {
+		clientReloadErrors.WithLabelValues(f.Secret.String()).Inc()
+		return ctrl.Result{}, err
+	}
+
+	if next.cache == nil {
+		f.swap(next)
+	} else {
+		// The cache is started by Start, which swaps the client once the cache is started.
+		select {
+		case reloaded <- next:
+		case <-ctx.Done():
+			return ctrl.Result{}, fmt.Errorf("failed to start the cache of the rebuilt client: %w", ctx.Err())
+		}
+	}
+
+	clientReloads.WithLabelValues(f.Secret.String()).Inc()
+	logger.Info("Rebuilt client from rotated kubeconfig")
+
+	return ctrl.Result{}, nil
+}
+
+// Check is a health check failing while the API server of the cluster is not ready.
+func (f *ClientFactory) Check(req *http.Request) error {
+	f.mu.RLock()
+	current := f.current
+	f.mu.RUnlock()
+
+	if current == nil {
+		return ErrClientNotLoaded
+	}
+
+	if err := current.discovery.RESTClient().Get().AbsPath("/readyz").Do(req.Context()).Error(); err != nil {
+		return fmt.Errorf("cluster API server is not ready: %w", err)
+	}
+
+	return nil
+}
+
+// build builds a client from the kubeconfig held by the Secret.
+func (f *ClientFactory) build(secret *corev1.Secret) (*kubeconfigClient, error) {
+	config, err := RESTConfigFromSecret(secret, f.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient, err := rest.HTTPClientFor(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP client for kubeconfig of Secret %s: %w", f.Secret.String(), err)
+	}
+
+	next := &kubeconfigClient{hash: kubeconfigHash(secret, f.Key), httpClient: httpClient}
+
+	options := f.Options
+	options.HTTPClient = httpClient
+
+	if f.CacheOptions != nil {
+		cacheOptions := *f.CacheOptions
+		cacheOptions.HTTPClient = httpClient
+
+		if cacheOptions.Scheme == nil {
+			cacheOptions.Scheme = options.Scheme
+		}
+
+		if next.cache, err = cache.New(config, cacheOptions); err != nil {
+			return nil, fmt.Errorf("failed to create cache for kubeconfig of Secret %s: %w", f.Secret.String(), err)
+		}
+
+		options.Cache = &client.CacheOptions{Reader: next.cache}
+	}
+
+	if next.client, err = client.New(config, options); err != nil {
+		return nil, fmt.Errorf("failed to create client for kubeconfig of Secret %s: %w", f.Secret.String(), err)
+	}
+
+	if next.discovery, err = discovery.NewDiscoveryClientForConfigAndClient(config, httpClient); err != nil {
+		return nil, fmt.Errorf("failed to create discovery client for kubeconfig of Secret %s: %w", f.Secret.String(), err)
+	}
+
+	return next, nil
+}
+
+// startCache starts the cache of the client, if any, and waits for it to sync.
+func (f *ClientFactory) startCache(ctx context.Context, next *kubeconfigClient) {
+	if next.cache == nil {
+		return
+	}
+
+	cacheCtx, cancel := context.WithCancel(ctx)
+	next.stopCache = cancel
+
+	go func() {
+		if err := next.cache.Start(cacheCtx); err != nil {
+			log.FromContext(ctx).Error(err, "Failed to start cache")
+		}
+	}()
+
+	next.cache.WaitForCacheSync(cacheCtx)
+}
+
+// swap replaces the current client, stopping the cache and closing the connections of the previous one.
+func (f *ClientFactory) swap(next *kubeconfigClient) {
+	f.mu.Lock()
+	previous := f.current
+	f.current = next
+	f.mu.Unlock()
+
+	if previous == nil {
+		return
+	}
+
+	if previous.stopCache != nil {
+		previous.stopCache()
+	}
+
+	// In-flight requests complete, only the idle connections are closed.
+	previous.httpClient.CloseIdleConnections()
+}
+
+// probe records whether the API server of the cluster is ready.
+func (f *ClientFactory) probe(ctx context.Context) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, "/readyz", nil)
+	if err != nil {
+		return
+	}
+
+	connected := 1.0
+	if err := f.Check(request); err != nil {
+		connected = 0
+		log.FromContext(ctx).V(1).Info("Cluster API server is not ready", "error", err.Error())
+	}
+
+	clientConnected.WithLabelValues(f.Secret.String()).Set(connected)
+}
+
+// currentClient returns the current client.
+func (f *ClientFactory) currentClient() (client.Client, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	if f.current == nil {
+		return nil, ErrClientNotLoaded
+	}
+
+	return f.current.client, nil
+}
+
+// reloadingClient is a client making each call with the current client of a factory.
+type reloadingClient struct {
+	factory *ClientFactory
+}
+
+// Get implements client.Client.
+func (c *reloadingClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	current, err := c.factory.currentClient()
+	if err != nil {
+		return err
+	}
+
+	return current.Get(ctx, key, obj, opts...)
+}
+
+// List implements client.Client.
+func (c *reloadingClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	current, err := c.factory.currentClient()
+	if err != nil {
+		return err
+	}
+
+	return current.List(ctx, list, opts...)
+}
+
+// Apply implements client.Client.
+func (c *reloadingClient) Apply(ctx context.Context, obj runtime.ApplyConfiguration, opts ...client.ApplyOption) error {
+	current, err := c.factory.currentClient()
+	if err != nil {
+		return err
+	}
+
+	return current.Apply(ctx, obj, opts...)
+}
+
+// Create implements client.Client.
+func (c *reloadingClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	current, err := c.factory.currentClient()
+	if err != nil {
+		return err
+	}
+
+	return current.Create(ctx, obj, opts...)
+}
+
+// Delete implements client.Client.
+func (c *reloadingClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	current, err := c.factory.currentClient()
+	if err != nil {
+		return err
+	}
+
+	return current.Delete(ctx, obj, opts...)
+}
+
+// Update implements client.Client.
+func (c *reloadingClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	current, err := c.factory.currentClient()
+	if err != nil {
+		return err
+	}
+
+	return current.Update(ctx, obj, opts...)
+}
+
+// Patch implements client.Client.
+func (c *reloadingClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	current, err := c.factory.currentClient()
+	if err != nil {
+		return err
+	}
+
+	return current.Patch(ctx, obj, patch, opts...)
+}
+
+// DeleteAllOf implements client.Client.
+func (c *reloadingClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	current, err := c.factory.currentClient()
+	if err != nil {
+		return err
+	}
+
+	return current.DeleteAllOf(ctx, obj, opts...)
+}
+
+// Status implements client.Client.
+func (c *reloadingClient) Status() client.SubResourceWriter {
+	return c.SubResource("status")
+}
+
+// SubResource implements client.Client.
+func (c *reloadingClient) SubResource(subResource string) client.SubResourceClient {
+	return &reloadingSubResourceClient{factory: c.factory, subResource: subResource}
+}
+
+// Scheme implements client.Client.
+func (c *reloadingClient) Scheme() *runtime.Scheme {
+	if current, err := c.factory.currentClient(); err == nil {
+		return current.Scheme()
+	}
+
+	if c.factory.Options.Scheme != nil {
+		return c.factory.Options.Scheme
+	}
+
+	return scheme.Scheme
+}
+
+// RESTMapper implements client.Client.
+func (c *reloadingClient) RESTMapper() meta.RESTMapper {
+	if current, err := c.factory.currentClient(); err == nil {
+		return current.RESTMapper()
+	}
+
+	return c.factory.Options.Mapper
+}
+
+// GroupVersionKindFor implements client.Client.
+func (c *reloadingClient) GroupVersionKindFor(obj runtime.Object) (schema.GroupVersionKind, error) {
+	return apiutil.GVKForObject(obj, c.Scheme())
+}
+
+// IsObjectNamespaced implements client.Client.
+func (c *reloadingClient) IsObjectNamespaced(obj runtime.Object) (bool, error) {
+	current, err := c.factory.currentClient()
+	if err != nil {
+		return false, err
+	}
+
+	return current.IsObjectNamespaced(obj)
+}
+
+// reloadingSubResourceClient is a subresource client making each call with the current client of a factory.
+type reloadingSubResourceClient struct {
+	factory     *ClientFactory
+	subResource string
+}
+
+// Get implements client.SubResourceClient.
+func (c *reloadingSubResourceClient) Get(
+	ctx context.Context, obj, subResource client.Object, opts ...client.SubResourceGetOption,
+) error {
+	current, err := c.factory.currentClient()
+	if err != nil {
+		return err
+	}
+
+	return current.SubResource(c.subResource).Get(ctx, obj, subResource, opts...)
+}
+
+// Create implements client.SubResourceClient.
+func (c *reloadingSubResourceClient) Create(
+	ctx context.Context, obj, subResource client.Object, opts ...client.SubResourceCreateOption,
+) error {
+	current, err := c.factory.currentClient()
+	if err != nil {
+		return err
+	}
+
+	return current.SubResource(c.subResource).Create(ctx, obj, subResource, opts...)
+}
+
+// Update implements client.SubResourceClient.
+func (c *reloadingSubResourceClient) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	current, err := c.factory.currentClient()
+	if err != nil {
+		return err
+	}
+
+	return current.SubResource(c.subResource).Update(ctx, obj, opts...)
+}
+
+// Patch implements client.SubResourceClient.
+func (c *reloadingSubResourceClient) Patch(
+	ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption,
+) error {
+	current, err := c.factory.currentClient()
+	if err != nil {
+		return err
+	}
+
+	return current.SubResource(c.subResource).Patch(ctx, obj, patch, opts...)
+}
+
+// Apply implements client.SubResourceClient.
+func (c *reloadingSubResourceClient) Apply(
+	ctx context.Context, obj runtime.ApplyConfiguration, opts ...client.SubResourceApplyOption,
+) error {
+	current, err := c.factory.currentClient()
+	if err != nil {
+		return err
+	}
+
+	return current.SubResource(c.subResource).Apply(ctx, obj, opts...)
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multicluster
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// newAPIServer returns the URL of an API server serving a ConfigMap holding its name.
+func newAPIServer(name string) string {
+	GinkgoHelper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/readyz":
+			_, _ = w.Write([]byte("ok"))
+		case "/api/v1/namespaces/default/configmaps/server":
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(&corev1.ConfigMap{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "server"},
+				Data:       map[string]string{"name": name},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	DeferCleanup(server.Close)
+
+	return server.URL
+}
+
+var _ = Describe("ClientFactory", func() {
+	var (
+		secret     *corev1.Secret
+		fakeClient client.Client
+		factory    *ClientFactory
+	)
+
+	// serverName returns the name of the API server the client of the factory talks to.
+	serverName := func() string {
+		GinkgoHelper()
+
+		configMap := &corev1.ConfigMap{}
+		Expect(factory.ClusterClient().Get(ctx, client.ObjectKey{Namespace: "default", Name: "server"}, configMap)).
+			To(Succeed())
+
+		return configMap.Data["name"]
+	}
+
+	BeforeEach(func() {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "clusters-example", Name: "admin-kubeconfig"},
+			Data:       map[string][]byte{DefaultKubeconfigKey: kubeconfigFor(newAPIServer("first"))},
+		}
+		fakeClient = fake.NewClientBuilder().WithObjects(secret).Build()
+
+		mapper := meta.NewDefaultRESTMapper(nil)
+		mapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+
+		factory = &ClientFactory{
+			Client:  fakeClient,
+			Secret:  client.ObjectKeyFromObject(secret),
+			Options: client.Options{Mapper: mapper},
+		}
+	})
+
+	It("should fail before the kubeconfig is loaded", func() {
+		Expect(factory.ClusterClient().Get(ctx, client.ObjectKey{Name: "server"}, &corev1.ConfigMap{})).
+			To(MatchError(ErrClientNotLoaded))
+		Expect(factory.Check(httptest.NewRequestWithContext(ctx, http.MethodGet, "/readyz", nil))).
+			To(MatchError(ErrClientNotLoaded))
+	})
+
+	It("should rebuild the client when the kubeconfig is rotated", func() {
+		Expect(factory.Load(ctx, fakeClient)).To(Succeed())
+		Expect(serverName()).To(Equal("first"))
+		Expect(factory.Check(httptest.NewRequestWithContext(ctx, http.MethodGet, "/readyz", nil))).To(Succeed())
+
+		reloads := testutil.ToFloat64(clientReloads.WithLabelValues(factory.Secret.String()))
+		req := ctrl.Request{NamespacedName: factory.Secret}
+
+		Expect(factory.Reconcile(ctx, req)).To(Equal(ctrl.Result{}))
+		Expect(testutil.ToFloat64(clientReloads.WithLabelValues(factory.Secret.String()))).To(Equal(reloads))
+
+		secret.Data[DefaultKubeconfigKey] = kubeconfigFor(newAPIServer("second"))
+		Expect(fakeClient.Update(ctx, secret)).To(Succeed())
+
+		Expect(factory.Reconcile(ctx, req)).To(Equal(ctrl.Result{}))
+		Expect(serverName()).To(Equal("second"))
+		Expect(testutil.ToFloat64(clientReloads.WithLabelValues(factory.Secret.String()))).To(Equal(reloads + 1))
+	})
+
+	It("should keep the client when the kubeconfig is invalid", func() {
+		Expect(factory.Load(ctx, fakeClient)).To(Succeed())
+
+		reloadErrors := testutil.ToFloat64(clientReloadErrors.WithLabelValues(factory.Secret.String()))
+
+		secret.Data[DefaultKubeconfigKey] = []byte("{")
+		Expect(fakeClient.Update(ctx, secret)).To(Succeed())
+
+		_, err := factory.Reconcile(ctx, ctrl.Request{NamespacedName: factory.Secret})
+		Expect(err).To(HaveOccurred())
+		Expect(serverName()).To(Equal("first"))
+		Expect(testutil.ToFloat64(clientReloadErrors.WithLabelValues(factory.Secret.String()))).To(Equal(reloadErrors + 1))
+	})
+
+	It("should record whether the API server is ready", func() {
+		secret.Data[DefaultKubeconfigKey] = kubeconfigFor("http://127.0.0.1:1")
+		Expect(fakeClient.Update(ctx, secret)).To(Succeed())
+		Expect(factory.Load(ctx, fakeClient)).To(Succeed())
+
+		factory.probe(ctx)
+		Expect(testutil.ToFloat64(clientConnected.WithLabelValues(factory.Secret.String()))).To(BeZero())
+	})
+})
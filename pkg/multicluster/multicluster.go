@@ -0,0 +1,127 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package multicluster helps operators reconciling objects of a secondary cluster, e.g. the hosted clusters of a
+// HyperShift management cluster: it loads the kubeconfig of the secondary cluster from a Secret, adds the cluster to
+// the manager with a readiness check, plumbs watches across clusters, and builds clients following the rotations of
+// the kubeconfig.
+package multicluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+)
+
+const (
+	// DefaultKubeconfigKey is the key of the Secret holding the kubeconfig of the secondary cluster.
+	DefaultKubeconfigKey = "kubeconfig"
+)
+
+// ErrNoKubeconfig is returned when the Secret does not hold a kubeconfig.
+var ErrNoKubeconfig = errors.New("no kubeconfig in Secret")
+
+// RESTConfigFromSecret returns the REST config of the kubeconfig held by the Secret under key, or under
+// DefaultKubeconfigKey when empty.
+func RESTConfigFromSecret(secret *corev1.Secret, key string) (*rest.Config, error) {
+	if key == "" {
+		key = DefaultKubeconfigKey
+	}
+
+	kubeconfig, ok := secret.Data[key]
+	if !ok || len(kubeconfig) == 0 {
+		return nil, fmt.Errorf("%w %s under key %s", ErrNoKubeconfig, client.ObjectKeyFromObject(secret).String(), key)
+	}
+
+	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load kubeconfig of Secret %s: %w", client.ObjectKeyFromObject(secret).String(), err)
+	}
+
+	return config, nil
+}
+
+// LoadRESTConfig returns the REST config of the kubeconfig held by the Secret under key, or under
+// DefaultKubeconfigKey when empty. It is intended to be called with the manager's APIReader before the manager is
+// started.
+func LoadRESTConfig(ctx context.Context, reader client.Reader, secretKey types.NamespacedName, key string) (*rest.Config, error) {
+	secret := &corev1.Secret{}
+	if err := reader.Get(ctx, secretKey, secret); err != nil {
+		return nil, fmt.Errorf("failed to get Secret %s: %w", secretKey.String(), err)
+	}
+
+	return RESTConfigFromSecret(secret, key)
+}
+
+// Add adds the secondary cluster to the manager, which starts its cache with the manager, and adds a readiness check
+// named after it, failing while its API server is unreachable.
+//
+// Example:
+//
+//	config, err := multicluster.LoadRESTConfig(ctx, mgr.GetAPIReader(), kubeconfigSecret, "")
+//	if err != nil {
+//	    return err
+//	}
+//	hostedCluster, err := cluster.New(config, func(o *cluster.Options) { o.Scheme = scheme })
+//	if err != nil {
+//	    return err
+//	}
+//	if err := multicluster.Add(mgr, "hosted-cluster", hostedCluster); err != nil {
+//	    return err
+//	}
+func Add(mgr ctrl.Manager, name string, cl cluster.Cluster) error {
+	if err := mgr.Add(cl); err != nil {
+		return fmt.Errorf("failed to add cluster %s to manager: %w", name, err)
+	}
+
+	check, err := HealthCheck(cl)
+	if err != nil {
+		return err
+	}
+
+	if err := mgr.AddReadyzCheck(name, check); err != nil {
+		return fmt.Errorf("failed to add readyz check of cluster %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// HealthCheck returns a check failing while the API server of the cluster is not ready.
+func HealthCheck(cl cluster.Cluster) (healthz.Checker, error) {
+	discoveryClient, err := discovery.NewDiscoveryClientForConfigAndClient(cl.GetConfig(), cl.GetHTTPClient())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create discovery client: %w", err)
+	}
+
+	return func(req *http.Request) error {
+		if err := discoveryClient.RESTClient().Get().AbsPath("/readyz").Do(req.Context()).Error(); err != nil {
+			return fmt.Errorf("cluster API server is not ready: %w", err)
+		}
+
+		return nil
+	}, nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multicluster
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+)
+
+// kubeconfigFor returns a kubeconfig of the API server at host.
+func kubeconfigFor(host string) []byte {
+	GinkgoHelper()
+
+	kubeconfig, err := clientcmd.Write(clientcmdapi.Config{
+		Clusters:       map[string]*clientcmdapi.Cluster{"hosted": {Server: host}},
+		AuthInfos:      map[string]*clientcmdapi.AuthInfo{"admin": {Token: "token"}},
+		Contexts:       map[string]*clientcmdapi.Context{"hosted": {Cluster: "hosted", AuthInfo: "admin"}},
+		CurrentContext: "hosted",
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	return kubeconfig
+}
+
+var _ = Describe("LoadRESTConfig", func() {
+	var secret *corev1.Secret
+
+	BeforeEach(func() {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "clusters-example", Name: "admin-kubeconfig"},
+			Data:       map[string][]byte{DefaultKubeconfigKey: kubeconfigFor("https://api.example.com:6443")},
+		}
+	})
+
+	It("should load the kubeconfig of the Secret", func() {
+		reader := fake.NewClientBuilder().WithObjects(secret).Build()
+
+		config, err := LoadRESTConfig(ctx, reader, client.ObjectKeyFromObject(secret), "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Host).To(Equal("https://api.example.com:6443"))
+		Expect(config.BearerToken).To(Equal("token"))
+	})
+
+	It("should fail without kubeconfig", func() {
+		_, err := RESTConfigFromSecret(secret, "value")
+		Expect(err).To(MatchError(ErrNoKubeconfig))
+
+		secret.Data[DefaultKubeconfigKey] = []byte("{")
+		_, err = RESTConfigFromSecret(secret, "")
+		Expect(err).To(MatchError(ContainSubstring("failed to load kubeconfig of Secret clusters-example/admin-kubeconfig")))
+	})
+
+	It("should fail without Secret", func() {
+		_, err := LoadRESTConfig(ctx, fake.NewClientBuilder().Build(), client.ObjectKeyFromObject(secret), "")
+		Expect(err).To(MatchError(ContainSubstring("failed to get Secret clusters-example/admin-kubeconfig")))
+	})
+})
+
+var _ = Describe("HealthCheck", func() {
+	It("should fail while the API server is not ready", func() {
+		ready := true
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/readyz" || !ready {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			_, _ = w.Write([]byte("ok"))
+		}))
+		DeferCleanup(server.Close)
+
+		cl, err := cluster.New(&rest.Config{Host: server.URL})
+		Expect(err).NotTo(HaveOccurred())
+
+		check, err := HealthCheck(cl)
+		Expect(err).NotTo(HaveOccurred())
+
+		request := httptest.NewRequestWithContext(ctx, http.MethodGet, "/readyz", nil)
+		Expect(check(request)).To(Succeed())
+
+		ready = false
+		Expect(check(request)).To(MatchError(ContainSubstring("cluster API server is not ready")))
+	})
+})
@@ -0,0 +1,149 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multicluster
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// KubeconfigWatcher watches the Secret holding the kubeconfig of a secondary cluster and invokes a callback when the
+// kubeconfig is rotated, typically to restart the operator so that the cluster is added again with the new
+// credentials.
+//
+// Call Load before starting the manager so that a rotation happening before the manager starts is not missed.
+// Without Load, the first kubeconfig observed is recorded without invoking the callback.
+type KubeconfigWatcher struct {
+	client.Client
+
+	// Secret is the Secret holding the kubeconfig.
+	Secret types.NamespacedName
+
+	// Key is the key of the Secret holding the kubeconfig. Defaults to DefaultKubeconfigKey.
+	Key string
+
+	// OnRotation is a function that will be called when the kubeconfig changes.
+	OnRotation func(ctx context.Context)
+
+	mu   sync.Mutex
+	hash string
+}
+
+// Load records the current kubeconfig using the given reader.
+// It is intended to be called with the manager's APIReader before the manager is started.
+func (r *KubeconfigWatcher) Load(ctx context.Context, reader client.Reader) error {
+	secret := &corev1.Secret{}
+	if err := reader.Get(ctx, r.Secret, secret); err != nil {
+		return fmt.Errorf("failed to get Secret %s: %w", r.Secret.String(), err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.hash = kubeconfigHash(secret, r.Key)
+
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager. The controller is named after the Secret, so that the
+// kubeconfigs of several clusters can be watched.
+func (r *KubeconfigWatcher) SetupWithManager(mgr ctrl.Manager) error {
+	isSecret := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return client.ObjectKeyFromObject(obj) == r.Secret
+	})
+
+	name := "kubeconfigwatcher-" + r.Secret.Namespace + "-" + r.Secret.Name
+
+	if err := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{NeedLeaderElection: ptr.To(false)}).
+		For(&corev1.Secret{}, builder.WithPredicates(isSecret)).
+		// Override the default log constructor as it makes the logs very chatty.
+		WithLogConstructor(func(_ *reconcile.Request) logr.Logger {
+			return mgr.GetLogger().WithValues(
+				"controller", name,
+			)
+		}).
+		Complete(r); err != nil {
+		return fmt.Errorf("could not set up controller for kubeconfig watcher of Secret %s: %w", r.Secret.String(), err)
+	}
+
+	return nil
+}
+
+// Reconcile records the current kubeconfig and invokes the callback when it has been rotated.
+func (r *KubeconfigWatcher) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx, "secret", req.String())
+
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, req.NamespacedName, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Keep the last observed kubeconfig, the clients built from it keep working until it expires.
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get Secret %s: %w", req.NamespacedName.String(), err)
+	}
+
+	hash := kubeconfigHash(secret, r.Key)
+
+	r.mu.Lock()
+	oldHash := r.hash
+	r.hash = hash
+	r.mu.Unlock()
+
+	// Without Load, the first observed kubeconfig is the current one rather than a rotation.
+	if oldHash == "" || oldHash == hash {
+		return ctrl.Result{}, nil
+	}
+
+	logger.Info("Kubeconfig rotated")
+
+	if r.OnRotation != nil {
+		r.OnRotation(ctx)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// kubeconfigHash returns the hash of the kubeconfig held by the Secret under key, or under DefaultKubeconfigKey
+// when empty.
+func kubeconfigHash(secret *corev1.Secret, key string) string {
+	if key == "" {
+		key = DefaultKubeconfigKey
+	}
+
+	sum := sha256.Sum256(secret.Data[key])
+
+	return hex.EncodeToString(sum[:])
+}
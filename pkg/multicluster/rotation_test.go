@@ -0,0 +1,92 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multicluster
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("KubeconfigWatcher", func() {
+	It("should invoke the callback when the kubeconfig is rotated", func() {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "clusters-example", Name: "admin-kubeconfig"},
+			Data:       map[string][]byte{DefaultKubeconfigKey: kubeconfigFor("https://api.example.com:6443")},
+		}
+		fakeClient := fake.NewClientBuilder().WithObjects(secret).Build()
+
+		rotations := 0
+		watcher := &KubeconfigWatcher{
+			Client: fakeClient,
+			Secret: client.ObjectKeyFromObject(secret),
+			OnRotation: func(context.Context) {
+				rotations++
+			},
+		}
+		Expect(watcher.Load(ctx, fakeClient)).To(Succeed())
+
+		req := ctrl.Request{NamespacedName: watcher.Secret}
+		Expect(watcher.Reconcile(ctx, req)).To(Equal(ctrl.Result{}))
+		Expect(rotations).To(BeZero())
+
+		secret.Labels = map[string]string{"rotated": "false"}
+		Expect(fakeClient.Update(ctx, secret)).To(Succeed())
+		Expect(watcher.Reconcile(ctx, req)).To(Equal(ctrl.Result{}))
+		Expect(rotations).To(BeZero())
+
+		secret.Data[DefaultKubeconfigKey] = kubeconfigFor("https://api.example.com:443")
+		Expect(fakeClient.Update(ctx, secret)).To(Succeed())
+		Expect(watcher.Reconcile(ctx, req)).To(Equal(ctrl.Result{}))
+		Expect(rotations).To(Equal(1))
+
+		Expect(fakeClient.Delete(ctx, secret)).To(Succeed())
+		Expect(watcher.Reconcile(ctx, req)).To(Equal(ctrl.Result{}))
+		Expect(rotations).To(Equal(1))
+	})
+	It("should not report the first kubeconfig observed without Load as a rotation", func() {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "clusters-example", Name: "admin-kubeconfig"},
+			Data:       map[string][]byte{DefaultKubeconfigKey: kubeconfigFor("https://api.example.com:6443")},
+		}
+		fakeClient := fake.NewClientBuilder().WithObjects(secret).Build()
+
+		rotations := 0
+		watcher := &KubeconfigWatcher{
+			Client: fakeClient,
+			Secret: client.ObjectKeyFromObject(secret),
+			OnRotation: func(context.Context) {
+				rotations++
+			},
+		}
+
+		req := ctrl.Request{NamespacedName: watcher.Secret}
+		Expect(watcher.Reconcile(ctx, req)).To(Equal(ctrl.Result{}))
+		Expect(rotations).To(BeZero())
+
+		secret.Data[DefaultKubeconfigKey] = kubeconfigFor("https://api.example.com:443")
+		Expect(fakeClient.Update(ctx, secret)).To(Succeed())
+		Expect(watcher.Reconcile(ctx, req)).To(Equal(ctrl.Result{}))
+		Expect(rotations).To(Equal(1))
+	})
+})
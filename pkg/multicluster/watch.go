@@ -0,0 +1,81 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multicluster
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	// OwnerNamespaceAnnotation is the annotation of objects of a secondary cluster holding the namespace of their owner
+	// in the cluster of the manager, as owner references cannot cross clusters.
+	OwnerNamespaceAnnotation = "multicluster.openshift.io/owner-namespace"
+	// OwnerNameAnnotation is the annotation of objects of a secondary cluster holding the name of their owner in the
+	// cluster of the manager.
+	OwnerNameAnnotation = "multicluster.openshift.io/owner-name"
+)
+
+// Watch returns a source of the events of objects of the secondary cluster, to watch them from a controller of the
+// manager with WatchesRawSource. The cluster has to be added to the manager, e.g. with Add, for its cache to start.
+//
+// Example:
+//
+//	err := ctrl.NewControllerManagedBy(mgr).
+//	    For(&hypershiftv1beta1.HostedControlPlane{}).
+//	    WatchesRawSource(multicluster.Watch(hostedCluster, &corev1.Node{}, multicluster.EnqueueRequestForAnnotatedOwner[*corev1.Node]())).
+//	    Complete(r)
+func Watch[T client.Object](cl cluster.Cluster, obj T, h handler.TypedEventHandler[T, reconcile.Request], predicates ...predicate.TypedPredicate[T]) source.Source {
+	return source.Kind(cl.GetCache(), obj, h, predicates...)
+}
+
+// SetOwnerAnnotations annotates obj, an object of a secondary cluster, with its owner in the cluster of the manager.
+func SetOwnerAnnotations(obj, owner client.Object) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	annotations[OwnerNamespaceAnnotation] = owner.GetNamespace()
+	annotations[OwnerNameAnnotation] = owner.GetName()
+	obj.SetAnnotations(annotations)
+}
+
+// EnqueueRequestForAnnotatedOwner returns a handler enqueuing the owner of objects of a secondary cluster, as
+// annotated by SetOwnerAnnotations. Objects without owner annotations are ignored.
+func EnqueueRequestForAnnotatedOwner[T client.Object]() handler.TypedEventHandler[T, reconcile.Request] {
+	return handler.TypedEnqueueRequestsFromMapFunc(func(_ context.Context, obj T) []reconcile.Request {
+		annotations := obj.GetAnnotations()
+
+		name, ok := annotations[OwnerNameAnnotation]
+		if !ok || name == "" {
+			return nil
+		}
+
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{
+			Namespace: annotations[OwnerNamespaceAnnotation],
+			Name:      name,
+		}}}
+	})
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multicluster
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("EnqueueRequestForAnnotatedOwner", func() {
+	It("should enqueue the annotated owner", func() {
+		owner := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "clusters-example", Name: "example"}}
+		node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-0"}}
+		SetOwnerAnnotations(node, owner)
+		Expect(node.Annotations).To(HaveKeyWithValue(OwnerNameAnnotation, "example"))
+
+		queue := newQueue()
+		h := EnqueueRequestForAnnotatedOwner[*corev1.Node]()
+		h.Create(ctx, event.TypedCreateEvent[*corev1.Node]{Object: node}, queue)
+		h.Create(ctx, event.TypedCreateEvent[*corev1.Node]{Object: &corev1.Node{}}, queue)
+
+		Expect(drain(queue)).To(ConsistOf(reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: "clusters-example", Name: "example"},
+		}))
+	})
+})
@@ -0,0 +1,286 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package namespacescope restricts the namespaces an operator watches, from the WATCH_NAMESPACE environment variable,
+// a namespace label selector or a designating ConfigMap, standardizing single, multi and all namespace deployments.
+package namespacescope
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// WatchNamespaceEnv is the environment variable listing the namespaces to watch, separated by commas.
+	// All namespaces are watched when it is empty or unset.
+	WatchNamespaceEnv = "WATCH_NAMESPACE"
+
+	// NamespacesKey is the key of the designating ConfigMap listing the namespaces to watch, separated by commas.
+	NamespacesKey = "namespaces"
+	// SelectorKey is the key of the designating ConfigMap holding the label selector of the namespaces to watch.
+	SelectorKey = "selector"
+)
+
+// WatchNamespaces returns the namespaces listed in WATCH_NAMESPACE, or nil to watch all namespaces.
+func WatchNamespaces() []string {
+	return parseNamespaces(os.Getenv(WatchNamespaceEnv))
+}
+
+// CacheOptions returns cache options restricting the cache to the namespaces, or watching all namespaces when none
+// is given. Watching only the namespaces in scope, rather than filtering events, needs fewer permissions and less
+// memory, but cannot follow the changes of a dynamic scope.
+//
+// Example:
+//
+//	mgr, err := ctrl.NewManager(cfg, ctrl.Options{Cache: namespacescope.CacheOptions(namespacescope.WatchNamespaces())})
+func CacheOptions(namespaces []string) cache.Options {
+	if len(namespaces) == 0 {
+		return cache.Options{}
+	}
+
+	defaultNamespaces := make(map[string]cache.Config, len(namespaces))
+	for _, namespace := range namespaces {
+		defaultNamespaces[namespace] = cache.Config{}
+	}
+
+	return cache.Options{DefaultNamespaces: defaultNamespaces}
+}
+
+// Scope is the set of namespaces an operator reconciles objects in. It is made of the static Namespaces and the
+// namespaces matching Selector, unless the designating ConfigMap lists namespaces or a selector, which then take
+// precedence. All namespaces are in scope when none of them is set.
+//
+// Scope is re-evaluated when the namespaces or the designating ConfigMap change, which requires the cache to watch all
+// namespaces. The operator needs RBAC to list and watch namespaces, and to get, list and watch the ConfigMap.
+//
+// Example:
+//
+//	scope := &namespacescope.Scope{
+//	    Client:     mgr.GetClient(),
+//	    Namespaces: namespacescope.WatchNamespaces(),
+//	    ConfigMap:  types.NamespacedName{Namespace: "openshift-example-operator", Name: "watched-namespaces"},
+//	}
+//	if err := scope.SetupWithManager(mgr); err != nil {
+//	    return err
+//	}
+//	err := ctrl.NewControllerManagedBy(mgr).
+//	    For(&v1alpha1.Operand{}, builder.WithPredicates(scope.Predicate())).
+//	    Complete(r)
+type Scope struct {
+	client.Client
+
+	// Namespaces are the namespaces in scope, e.g. from WatchNamespaces.
+	Namespaces []string
+
+	// Selector selects the namespaces in scope by their labels.
+	Selector labels.Selector
+
+	// ConfigMap is the designating ConfigMap, whose NamespacesKey and SelectorKey override Namespaces and Selector.
+	ConfigMap types.NamespacedName
+
+	// OnChange is a function that will be called after the namespaces in scope have changed,
+	// e.g. to reconcile the objects of the namespaces that entered the scope.
+	OnChange func(ctx context.Context)
+
+	mu         sync.RWMutex
+	loaded     bool
+	all        bool
+	namespaces []string
+}
+
+// Load evaluates the namespaces in scope using the given reader.
+// It is intended to be called with the manager's APIReader before the manager is started.
+func (s *Scope) Load(ctx context.Context, reader client.Reader) error {
+	_, err := s.load(ctx, reader)
+	return err
+}
+
+// Contains reports whether the namespace is in scope. Cluster-scoped objects, with an empty namespace, always are.
+// Before the scope is first evaluated, only the static Namespaces are in scope, or all namespaces when none is set.
+func (s *Scope) Contains(namespace string) bool {
+	if namespace == "" {
+		return true
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if !s.loaded {
+		return len(s.Namespaces) == 0 || slices.Contains(s.Namespaces, namespace)
+	}
+
+	return s.all || slices.Contains(s.namespaces, namespace)
+}
+
+// List returns the sorted namespaces in scope, and false when all namespaces are.
+func (s *Scope) List() ([]string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if !s.loaded {
+		return slices.Sorted(slices.Values(s.Namespaces)), len(s.Namespaces) > 0
+	}
+
+	return slices.Clone(s.namespaces), !s.all
+}
+
+// Predicate returns a predicate only passing the events of objects in scope.
+func (s *Scope) Predicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return s.Contains(obj.GetNamespace())
+	})
+}
+
+// SetupWithManager sets up the controller re-evaluating the scope with the Manager.
+func (s *Scope) SetupWithManager(mgr ctrl.Manager) error {
+	// Every event results in the same request, which re-evaluates the whole scope.
+	enqueueSync := handler.EnqueueRequestsFromMapFunc(func(context.Context, client.Object) []reconcile.Request {
+		return []reconcile.Request{{NamespacedName: client.ObjectKey{Name: "namespacescope"}}}
+	})
+
+	isConfigMap := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return client.ObjectKeyFromObject(obj) == s.ConfigMap
+	})
+
+	b := ctrl.NewControllerManagedBy(mgr).
+		Named("namespacescope").
+		WithOptions(controller.Options{NeedLeaderElection: ptr.To(false)}).
+		Watches(&corev1.Namespace{}, enqueueSync).
+		// Override the default log constructor as it makes the logs very chatty.
+		WithLogConstructor(func(_ *reconcile.Request) logr.Logger {
+			return mgr.GetLogger().WithValues(
+				"controller", "namespacescope",
+			)
+		})
+
+	if s.ConfigMap.Name != "" {
+		b = b.Watches(&corev1.ConfigMap{}, enqueueSync, builder.WithPredicates(isConfigMap))
+	}
+
+	if err := b.Complete(s); err != nil {
+		return fmt.Errorf("could not set up controller for namespace scope: %w", err)
+	}
+
+	return nil
+}
+
+// Reconcile re-evaluates the scope and invokes the callback when it has changed.
+func (s *Scope) Reconcile(ctx context.Context, _ ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	changed, err := s.load(ctx, s.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if !changed {
+		return ctrl.Result{}, nil
+	}
+
+	namespaces, restricted := s.List()
+	logger.Info("Namespace scope changed", "namespaces", namespaces, "restricted", restricted)
+
+	if s.OnChange != nil {
+		s.OnChange(ctx)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// load evaluates the namespaces in scope and reports whether they changed.
+func (s *Scope) load(ctx context.Context, reader client.Reader) (bool, error) {
+	namespaces, selector := s.Namespaces, s.Selector
+
+	if s.ConfigMap.Name != "" {
+		configMap := &corev1.ConfigMap{}
+		if err := reader.Get(ctx, s.ConfigMap, configMap); client.IgnoreNotFound(err) != nil {
+			return false, fmt.Errorf("failed to get ConfigMap %s: %w", s.ConfigMap.String(), err)
+		}
+
+		listed, hasNamespaces := configMap.Data[NamespacesKey]
+		selected, hasSelector := configMap.Data[SelectorKey]
+
+		if hasNamespaces || hasSelector {
+			namespaces, selector = parseNamespaces(listed), nil
+		}
+
+		if hasSelector {
+			parsed, err := labels.Parse(selected)
+			if err != nil {
+				return false, fmt.Errorf("failed to parse the %s of ConfigMap %s: %w", SelectorKey, s.ConfigMap.String(), err)
+			}
+
+			selector = parsed
+		}
+	}
+
+	all := len(namespaces) == 0 && selector == nil
+	inScope := slices.Clone(namespaces)
+
+	if selector != nil {
+		namespaceList := &corev1.NamespaceList{}
+		if err := reader.List(ctx, namespaceList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return false, fmt.Errorf("failed to list namespaces: %w", err)
+		}
+
+		for _, namespace := range namespaceList.Items {
+			inScope = append(inScope, namespace.Name)
+		}
+	}
+
+	slices.Sort(inScope)
+	inScope = slices.Compact(inScope)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	changed := !s.loaded || s.all != all || !slices.Equal(s.namespaces, inScope)
+	s.loaded, s.all, s.namespaces = true, all, inScope
+
+	return changed, nil
+}
+
+// parseNamespaces returns the namespaces of a comma-separated list, ignoring spaces and empty entries.
+func parseNamespaces(list string) []string {
+	var namespaces []string
+
+	for namespace := range strings.SplitSeq(list, ",") {
+		if namespace = strings.TrimSpace(namespace); namespace != "" {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+
+	return namespaces
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package namespacescope
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("WatchNamespaces", func() {
+	It("should parse the namespaces of the environment", func() {
+		GinkgoT().Setenv(WatchNamespaceEnv, " first, second,,")
+		Expect(WatchNamespaces()).To(Equal([]string{"first", "second"}))
+
+		GinkgoT().Setenv(WatchNamespaceEnv, "")
+		Expect(WatchNamespaces()).To(BeNil())
+	})
+
+	It("should restrict the cache to the namespaces", func() {
+		Expect(CacheOptions(nil)).To(Equal(cache.Options{}))
+		Expect(CacheOptions([]string{"first"}).DefaultNamespaces).To(Equal(map[string]cache.Config{"first": {}}))
+	})
+})
+
+var _ = Describe("Scope", func() {
+	var c client.Client
+
+	configMapKey := types.NamespacedName{Namespace: "operator", Name: "watched-namespaces"}
+
+	namespace := func(name string, labels map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	}
+
+	listed := func(s *Scope) []string {
+		namespaces, restricted := s.List()
+		Expect(restricted).To(BeTrue())
+
+		return namespaces
+	}
+
+	BeforeEach(func() {
+		c = fake.NewClientBuilder().WithObjects(
+			namespace("team-a", map[string]string{"team": "a"}),
+			namespace("team-b", map[string]string{"team": "b"}),
+		).Build()
+	})
+
+	It("should contain all namespaces when unrestricted", func() {
+		s := &Scope{Client: c}
+		Expect(s.Load(ctx, c)).To(Succeed())
+
+		Expect(s.Contains("anything")).To(BeTrue())
+
+		_, restricted := s.List()
+		Expect(restricted).To(BeFalse())
+	})
+
+	It("should contain the static and selected namespaces", func() {
+		s := &Scope{Client: c, Namespaces: []string{"static"}, Selector: labels.SelectorFromSet(labels.Set{"team": "a"})}
+		Expect(s.Contains("static")).To(BeTrue())
+		Expect(s.Contains("team-a")).To(BeFalse())
+
+		Expect(s.Load(ctx, c)).To(Succeed())
+		Expect(listed(s)).To(Equal([]string{"static", "team-a"}))
+		Expect(s.Contains("team-b")).To(BeFalse())
+		Expect(s.Contains("")).To(BeTrue())
+
+		p := s.Predicate()
+		Expect(p.Create(event.CreateEvent{Object: &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "team-a"}}})).To(BeTrue())
+		Expect(p.Create(event.CreateEvent{Object: &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "team-b"}}})).To(BeFalse())
+	})
+
+	It("should follow the designating ConfigMap", func() {
+		changes := 0
+		s := &Scope{Client: c, Namespaces: []string{"static"}, ConfigMap: configMapKey, OnChange: func(context.Context) { changes++ }}
+
+		_, err := s.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(listed(s)).To(Equal([]string{"static"}))
+		Expect(changes).To(Equal(1))
+
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: configMapKey.Namespace, Name: configMapKey.Name},
+			Data:       map[string]string{NamespacesKey: "listed", SelectorKey: "team=b"},
+		}
+		Expect(c.Create(ctx, configMap)).To(Succeed())
+
+		_, err = s.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(listed(s)).To(Equal([]string{"listed", "team-b"}))
+		Expect(changes).To(Equal(2))
+
+		_, err = s.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(Equal(2))
+
+		configMap.Data = map[string]string{SelectorKey: "invalid=("}
+		Expect(c.Update(ctx, configMap)).To(Succeed())
+
+		_, err = s.Reconcile(ctx, reconcile.Request{})
+		Expect(err).To(MatchError(ContainSubstring("failed to parse the selector")))
+	})
+})
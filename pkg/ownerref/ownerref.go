@@ -0,0 +1,241 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ownerref provides helpers to set, adopt, release and transfer the ownership of objects,
+// validating owner references against the scope of the objects so that the garbage collector never
+// deletes dependents because of an invalid owner reference.
+package ownerref
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+var (
+	// ErrInvalidOwner is returned when an owner reference would be ignored or misinterpreted by the garbage collector:
+	// namespaced owners can only own objects of their namespace.
+	ErrInvalidOwner = errors.New("invalid owner")
+
+	// ErrControlledByOther is returned when an object is already controlled by another owner.
+	ErrControlledByOther = errors.New("object is controlled by another owner")
+)
+
+// ValidateOwner returns ErrInvalidOwner when owner cannot own object, based on the scope of their kinds as known
+// to the client rather than on their namespace fields: a namespaced owner cannot own a cluster-scoped object, nor an
+// object in another namespace.
+func ValidateOwner(c client.Client, owner, object client.Object) error {
+	ownerNamespaced, err := c.IsObjectNamespaced(owner)
+	if err != nil {
+		return fmt.Errorf("failed to get the scope of owner %T: %w", owner, err)
+	}
+
+	if !ownerNamespaced {
+		return nil
+	}
+
+	objectNamespaced, err := c.IsObjectNamespaced(object)
+	if err != nil {
+		return fmt.Errorf("failed to get the scope of %T: %w", object, err)
+	}
+
+	switch {
+	case owner.GetNamespace() == "":
+		return fmt.Errorf("%w: namespaced owner %s has no namespace", ErrInvalidOwner, owner.GetName())
+	case !objectNamespaced:
+		return fmt.Errorf("%w: namespaced owner %s/%s cannot own cluster-scoped %s",
+			ErrInvalidOwner, owner.GetNamespace(), owner.GetName(), object.GetName())
+	case owner.GetNamespace() != object.GetNamespace():
+		return fmt.Errorf("%w: owner %s/%s cannot own %s/%s in another namespace",
+			ErrInvalidOwner, owner.GetNamespace(), owner.GetName(), object.GetNamespace(), object.GetName())
+	}
+
+	return nil
+}
+
+// SetControllerReference validates owner with ValidateOwner and sets it as the controller of object.
+// It does not update object. It returns ErrControlledByOther when another owner controls object.
+func SetControllerReference(c client.Client, owner, object client.Object) error {
+	if err := ValidateOwner(c, owner, object); err != nil {
+		return err
+	}
+
+	if err := controllerutil.SetControllerReference(owner, object, c.Scheme()); err != nil {
+		if errors.As(err, new(*controllerutil.AlreadyOwnedError)) {
+			return fmt.Errorf("%w: %w", ErrControlledByOther, err)
+		}
+
+		return fmt.Errorf("failed to set controller reference of %s: %w", object.GetName(), err)
+	}
+
+	return nil
+}
+
+// Adopt sets owner as the controller of the orphaned objects listed in list that match the selector, in the given
+// namespace, or in all namespaces when empty, and patches them. Objects that have a controller or are being deleted
+// are left alone. It returns the adopted objects.
+//
+// Example:
+//
+//	adopted, err := ownerref.Adopt(ctx, c, operand, &appsv1.DeploymentList{},
+//	    labels.SelectorFromSet(labels.Set{"app": "operand"}), operand.Namespace)
+func Adopt(ctx context.Context, c client.Client, owner client.Object, list client.ObjectList, selector labels.Selector, namespace string) ([]client.Object, error) {
+	objects, ok := list.DeepCopyObject().(client.ObjectList)
+	if !ok {
+		return nil, fmt.Errorf("unexpected copy of %T", list)
+	}
+
+	opts := []client.ListOption{client.MatchingLabelsSelector{Selector: selector}}
+	if namespace != "" {
+		opts = append(opts, client.InNamespace(namespace))
+	}
+
+	if err := c.List(ctx, objects, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list %T: %w", objects, err)
+	}
+
+	items, err := meta.ExtractList(objects)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract the items of %T: %w", objects, err)
+	}
+
+	var adopted []client.Object
+
+	for _, item := range items {
+		obj, ok := item.(client.Object)
+		if !ok || metav1.GetControllerOf(obj) != nil || !obj.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+
+		if err := patch(ctx, c, obj, func() error { return SetControllerReference(c, owner, obj) }); err != nil {
+			return adopted, fmt.Errorf("failed to adopt %s: %w", client.ObjectKeyFromObject(obj).String(), err)
+		}
+
+		adopted = append(adopted, obj)
+	}
+
+	return adopted, nil
+}
+
+// Release removes the owner references of owner from object, and patches it when it had any.
+func Release(ctx context.Context, c client.Client, owner, object client.Object) error {
+	references := object.GetOwnerReferences()
+	kept := make([]metav1.OwnerReference, 0, len(references))
+
+	for _, reference := range references {
+		if reference.UID != owner.GetUID() {
+			kept = append(kept, reference)
+		}
+	}
+
+	if len(kept) == len(references) {
+		return nil
+	}
+
+	if err := patch(ctx, c, object, func() error {
+		object.SetOwnerReferences(kept)
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to release %s: %w", client.ObjectKeyFromObject(object).String(), err)
+	}
+
+	return nil
+}
+
+// TransferController replaces the controller reference matching from with a controller reference to the new owner,
+// in a single patch, so that object is never orphaned nor deleted by the garbage collector in between. It is meant
+// for objects whose owner is renamed or replaced, e.g. when an operator renames its configuration resource.
+// from matches by UID when set, and otherwise by group, kind and name, as the previous owner may no longer exist.
+// Object is patched only when the controller reference matches from.
+func TransferController(ctx context.Context, c client.Client, from metav1.OwnerReference, to, object client.Object) error {
+	controller := metav1.GetControllerOf(object)
+	if controller == nil || !matches(*controller, from) {
+		return nil
+	}
+
+	if err := patch(ctx, c, object, func() error {
+		references := object.GetOwnerReferences()
+		for i := range references {
+			if references[i].UID == controller.UID {
+				references = append(references[:i], references[i+1:]...)
+				break
+			}
+		}
+
+		object.SetOwnerReferences(references)
+
+		return SetControllerReference(c, to, object)
+	}); err != nil {
+		return fmt.Errorf("failed to transfer %s: %w", client.ObjectKeyFromObject(object).String(), err)
+	}
+
+	return nil
+}
+
+// OwnerReferenceOf returns the owner reference to obj, e.g. to transfer the objects it controls.
+func OwnerReferenceOf(c client.Client, obj client.Object) (metav1.OwnerReference, error) {
+	gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+	if err != nil {
+		return metav1.OwnerReference{}, fmt.Errorf("failed to get the kind of %T: %w", obj, err)
+	}
+
+	return *metav1.NewControllerRef(obj, gvk), nil
+}
+
+// matches reports whether reference matches from, by UID when set, and by group, kind and name otherwise.
+func matches(reference, from metav1.OwnerReference) bool {
+	if from.UID != "" {
+		return reference.UID == from.UID
+	}
+
+	referenceGV, err := schema.ParseGroupVersion(reference.APIVersion)
+	if err != nil {
+		return false
+	}
+
+	fromGV, err := schema.ParseGroupVersion(from.APIVersion)
+	if err != nil {
+		return false
+	}
+
+	return referenceGV.Group == fromGV.Group && reference.Kind == from.Kind && reference.Name == from.Name
+}
+
+// patch applies mutate to obj and patches it with optimistic locking.
+func patch(ctx context.Context, c client.Client, obj client.Object, mutate func() error) error {
+	original, ok := obj.DeepCopyObject().(client.Object)
+	if !ok {
+		return fmt.Errorf("unexpected copy of %T", obj)
+	}
+
+	if err := mutate(); err != nil {
+		return err
+	}
+
+	if err := c.Patch(ctx, obj, client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{})); err != nil {
+		return fmt.Errorf("failed to patch: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ownerref
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Owner references", func() {
+	var (
+		fakeClient client.Client
+		owner      *corev1.ConfigMap
+	)
+
+	configMap := func(namespace, name string, uid types.UID) *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Name: name, Namespace: namespace, UID: uid, Labels: map[string]string{"app": "operand"},
+		}}
+	}
+
+	get := func(obj client.Object) client.Object {
+		GinkgoHelper()
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
+
+		return obj
+	}
+
+	BeforeEach(func() {
+		owner = configMap("ns", "owner", "owner-uid")
+		owner.Labels = nil
+		mapper := meta.NewDefaultRESTMapper(nil)
+		mapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+		mapper.Add(corev1.SchemeGroupVersion.WithKind("Namespace"), meta.RESTScopeRoot)
+
+		fakeClient = fake.NewClientBuilder().WithRESTMapper(mapper).WithObjects(owner).Build()
+	})
+
+	Describe("SetControllerReference", func() {
+		It("should set the controller of objects in the namespace of the owner", func() {
+			object := configMap("ns", "object", "object-uid")
+			Expect(SetControllerReference(fakeClient, owner, object)).To(Succeed())
+			Expect(metav1.IsControlledBy(object, owner)).To(BeTrue())
+		})
+
+		It("should reject invalid owners", func() {
+			Expect(SetControllerReference(fakeClient, owner, configMap("other", "object", "object-uid"))).
+				To(MatchError(ErrInvalidOwner))
+			Expect(SetControllerReference(fakeClient, owner, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns"}})).
+				To(MatchError(ErrInvalidOwner))
+			Expect(SetControllerReference(fakeClient, configMap("", "owner", "owner-uid"), configMap("ns", "object", "object-uid"))).
+				To(MatchError(ErrInvalidOwner))
+		})
+
+		It("should let cluster-scoped owners own namespaced objects", func() {
+			namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns", UID: "ns-uid"}}
+			Expect(SetControllerReference(fakeClient, namespace, configMap("ns", "object", "object-uid"))).To(Succeed())
+		})
+
+		It("should not steal objects controlled by another owner", func() {
+			object := configMap("ns", "object", "object-uid")
+			Expect(SetControllerReference(fakeClient, configMap("ns", "other", "other-uid"), object)).To(Succeed())
+			Expect(SetControllerReference(fakeClient, owner, object)).To(MatchError(ErrControlledByOther))
+		})
+	})
+
+	Describe("Adopt and Release", func() {
+		It("should adopt orphans matching the selector and release them", func() {
+			orphan := configMap("ns", "orphan", "orphan-uid")
+			controlled := configMap("ns", "controlled", "controlled-uid")
+			Expect(SetControllerReference(fakeClient, configMap("ns", "other", "other-uid"), controlled)).To(Succeed())
+			unrelated := configMap("ns", "unrelated", "unrelated-uid")
+			unrelated.Labels = nil
+
+			for _, obj := range []client.Object{orphan, controlled, unrelated} {
+				Expect(fakeClient.Create(ctx, obj)).To(Succeed())
+			}
+
+			adopted, err := Adopt(ctx, fakeClient, owner, &corev1.ConfigMapList{},
+				labels.SelectorFromSet(labels.Set{"app": "operand"}), "ns")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(adopted).To(ConsistOf(HaveField("ObjectMeta.Name", "orphan")))
+			Expect(metav1.IsControlledBy(get(orphan), owner)).To(BeTrue())
+			Expect(metav1.IsControlledBy(get(controlled), owner)).To(BeFalse())
+
+			Expect(Release(ctx, fakeClient, owner, orphan)).To(Succeed())
+			Expect(get(orphan).GetOwnerReferences()).To(BeEmpty())
+		})
+	})
+
+	Describe("TransferController", func() {
+		It("should replace the controller reference of the previous owner", func() {
+			previous := configMap("ns", "previous", "previous-uid")
+			object := configMap("ns", "object", "object-uid")
+			Expect(SetControllerReference(fakeClient, previous, object)).To(Succeed())
+			Expect(fakeClient.Create(ctx, object)).To(Succeed())
+
+			from, err := OwnerReferenceOf(fakeClient, previous)
+			Expect(err).NotTo(HaveOccurred())
+			from.UID = ""
+
+			Expect(TransferController(ctx, fakeClient, from, owner, object)).To(Succeed())
+			Expect(get(object).GetOwnerReferences()).To(ConsistOf(HaveField("UID", types.UID("owner-uid"))))
+			Expect(metav1.IsControlledBy(object, owner)).To(BeTrue())
+		})
+
+		It("should leave objects controlled by other owners", func() {
+			object := configMap("ns", "object", "object-uid")
+			Expect(SetControllerReference(fakeClient, configMap("ns", "other", "other-uid"), object)).To(Succeed())
+			Expect(fakeClient.Create(ctx, object)).To(Succeed())
+
+			Expect(TransferController(ctx, fakeClient, metav1.OwnerReference{UID: "previous-uid"}, owner, object)).To(Succeed())
+			Expect(metav1.IsControlledBy(get(object), owner)).To(BeFalse())
+		})
+	})
+})
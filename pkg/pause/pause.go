@@ -0,0 +1,125 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package pause lets administrators pause the reconciliation of resources with an annotation, e.g. while debugging,
+// and reports paused resources with a condition.
+package pause
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openshift/controller-runtime-common/pkg/conditions"
+	"github.com/openshift/controller-runtime-common/pkg/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// ConditionType is the type of the condition reporting that the reconciliation of a resource is paused.
+	ConditionType = "ReconciliationPaused"
+
+	// ReasonPaused is the reason of the condition while the reconciliation is paused.
+	ReasonPaused = "PausedByAnnotation"
+)
+
+// IsPaused reports whether obj has the pause annotation set to "true".
+func IsPaused(obj client.Object, annotation string) bool {
+	return obj.GetAnnotations()[annotation] == "true"
+}
+
+// IgnorePaused returns a predicate that filters out the updates of resources that stay paused. Updates pausing or
+// resuming a resource pass, so that the condition is updated and reconciliation resumes when the annotation is
+// removed, as do create, delete and generic events.
+func IgnorePaused(annotation string) predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return !IsPaused(e.ObjectOld, annotation) || !IsPaused(e.ObjectNew, annotation)
+		},
+	}
+}
+
+// Reconciler skips the reconciliation of the resources with the pause annotation set to "true", and otherwise calls
+// the wrapped reconciler. While a resource is paused, the ReconciliationPaused condition is set to True in its status.
+// The condition is removed when the resource is resumed, before the wrapped reconciler is called.
+// Resources being deleted are reconciled even when paused, so that their finalizers do not block their deletion.
+//
+// Example:
+//
+//	r := &pause.Reconciler[*v1alpha1.Operand]{
+//	    Client:     mgr.GetClient(),
+//	    NewObject:  func() *v1alpha1.Operand { return &v1alpha1.Operand{} },
+//	    Conditions: func(o *v1alpha1.Operand) *[]metav1.Condition { return &o.Status.Conditions },
+//	    Annotation: "operator.openshift.io/paused",
+//	    Reconciler: operandReconciler,
+//	}
+type Reconciler[T client.Object] struct {
+	Client client.Client
+
+	// NewObject returns an empty object of the reconciled kind.
+	NewObject func() T
+
+	// Conditions returns the conditions of the status of the object. The condition is not reported when nil.
+	Conditions func(obj T) *[]metav1.Condition
+
+	// Annotation is the pause annotation, e.g. operator.openshift.io/paused.
+	Annotation string
+
+	// Reconciler reconciles the resources that are not paused.
+	Reconciler reconcile.Reconciler
+}
+
+// Reconcile implements reconcile.Reconciler.
+func (r *Reconciler[T]) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	obj := r.NewObject()
+	if err := r.Client.Get(ctx, req.NamespacedName, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return r.Reconciler.Reconcile(ctx, req)
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get %T %s: %w", obj, req.NamespacedName.String(), err)
+	}
+
+	paused := IsPaused(obj, r.Annotation) && obj.GetDeletionTimestamp().IsZero()
+
+	if r.Conditions != nil {
+		if err := status.Update(ctx, r.Client, obj, func(obj T) error {
+			if paused {
+				conditions.MarkTrue(r.Conditions(obj), obj.GetGeneration(), ConditionType, ReasonPaused,
+					fmt.Sprintf("Reconciliation is paused by the %s annotation", r.Annotation))
+			} else {
+				conditions.Remove(r.Conditions(obj), ConditionType)
+			}
+
+			return nil
+		}); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if paused {
+		log.FromContext(ctx).V(1).Info("Reconciliation paused", "annotation", r.Annotation)
+		return ctrl.Result{}, nil
+	}
+
+	return r.Reconciler.Reconcile(ctx, req)
+}
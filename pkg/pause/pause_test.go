@@ -0,0 +1,126 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pause
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/controller-runtime-common/pkg/conditions"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const pauseAnnotation = "operator.openshift.io/paused"
+
+var _ = Describe("IgnorePaused", func() {
+	paused := &admissionregistrationv1.ValidatingAdmissionPolicy{ObjectMeta: metav1.ObjectMeta{
+		Annotations: map[string]string{pauseAnnotation: "true"},
+	}}
+	resumed := &admissionregistrationv1.ValidatingAdmissionPolicy{}
+
+	It("should only filter out updates of resources that stay paused", func() {
+		p := IgnorePaused(pauseAnnotation)
+		Expect(p.Update(event.UpdateEvent{ObjectOld: paused, ObjectNew: paused})).To(BeFalse())
+		Expect(p.Update(event.UpdateEvent{ObjectOld: resumed, ObjectNew: paused})).To(BeTrue())
+		Expect(p.Update(event.UpdateEvent{ObjectOld: paused, ObjectNew: resumed})).To(BeTrue())
+		Expect(p.Update(event.UpdateEvent{ObjectOld: resumed, ObjectNew: resumed})).To(BeTrue())
+		Expect(p.Create(event.CreateEvent{Object: paused})).To(BeTrue())
+	})
+})
+
+// ValidatingAdmissionPolicies are used as the reconciled resources, as their status holds metav1.Conditions.
+var _ = Describe("Reconciler", func() {
+	var (
+		fakeClient client.Client
+		reconciled int
+		reconciler *Reconciler[*admissionregistrationv1.ValidatingAdmissionPolicy]
+	)
+
+	key := client.ObjectKey{Name: "policy"}
+	req := ctrl.Request{NamespacedName: key}
+
+	get := func() *admissionregistrationv1.ValidatingAdmissionPolicy {
+		GinkgoHelper()
+
+		policy := &admissionregistrationv1.ValidatingAdmissionPolicy{}
+		Expect(fakeClient.Get(ctx, key, policy)).To(Succeed())
+
+		return policy
+	}
+
+	setPaused := func(paused bool) {
+		GinkgoHelper()
+
+		policy := get()
+		policy.Annotations = nil
+		if paused {
+			policy.Annotations = map[string]string{pauseAnnotation: "true"}
+		}
+
+		Expect(fakeClient.Update(ctx, policy)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		policy := &admissionregistrationv1.ValidatingAdmissionPolicy{ObjectMeta: metav1.ObjectMeta{Name: key.Name}}
+		fakeClient = fake.NewClientBuilder().WithObjects(policy).WithStatusSubresource(policy).Build()
+
+		reconciled = 0
+		reconciler = &Reconciler[*admissionregistrationv1.ValidatingAdmissionPolicy]{
+			Client: fakeClient,
+			NewObject: func() *admissionregistrationv1.ValidatingAdmissionPolicy {
+				return &admissionregistrationv1.ValidatingAdmissionPolicy{}
+			},
+			Conditions: func(p *admissionregistrationv1.ValidatingAdmissionPolicy) *[]metav1.Condition {
+				return &p.Status.Conditions
+			},
+			Annotation: pauseAnnotation,
+			Reconciler: reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+				reconciled++
+				return reconcile.Result{}, nil
+			}),
+		}
+	})
+
+	It("should skip paused resources and resume them", func() {
+		setPaused(true)
+
+		_, err := reconciler.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reconciled).To(BeZero())
+		Expect(conditions.IsTrue(get().Status.Conditions, ConditionType)).To(BeTrue())
+
+		setPaused(false)
+
+		_, err = reconciler.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reconciled).To(Equal(1))
+		Expect(conditions.Get(get().Status.Conditions, ConditionType)).To(BeNil())
+	})
+
+	It("should reconcile missing resources", func() {
+		_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKey{Name: "missing"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reconciled).To(Equal(1))
+	})
+})
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package predicates
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// ContentHash returns a hash of the content of a Secret or ConfigMap, their data and binary data, independent of
+// their metadata, and false for other objects.
+func ContentHash(obj client.Object) (string, bool) {
+	var content any
+
+	switch o := obj.(type) {
+	case *corev1.Secret:
+		content = []any{o.Data, o.StringData}
+	case *corev1.ConfigMap:
+		content = []any{o.Data, o.BinaryData}
+	default:
+		return "", false
+	}
+
+	// JSON sorts map keys and delimits keys and values, so that equal contents always hash the same,
+	// and distinct contents never hash the same input.
+	serialized, err := json.Marshal(content)
+	if err != nil {
+		return "", false
+	}
+
+	sum := sha256.Sum256(serialized)
+
+	return hex.EncodeToString(sum[:]), true
+}
+
+// ContentChanged returns a predicate that only passes updates of Secrets and ConfigMaps changing their content, as
+// hashed by ContentHash, ignoring metadata churn such as annotations updated by the kubelet. Updates of other
+// objects pass, as do create, delete and generic events.
+func ContentChanged() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldHash, oldOK := ContentHash(e.ObjectOld)
+			newHash, newOK := ContentHash(e.ObjectNew)
+
+			return !oldOK || !newOK || oldHash != newHash
+		},
+	}
+}
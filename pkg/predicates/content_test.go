@@ -0,0 +1,71 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package predicates
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+var _ = Describe("ContentChanged", func() {
+	It("should ignore metadata changes of Secrets", func() {
+		oldObj := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "tls"},
+			Data:       map[string][]byte{"tls.crt": []byte("cert")},
+		}
+		newObj := oldObj.DeepCopy()
+		newObj.Annotations = map[string]string{"kubelet.kubernetes.io/last-synced": "now"}
+		newObj.ResourceVersion = "2"
+
+		Expect(ContentChanged().Update(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: newObj})).To(BeFalse())
+
+		newObj.Data["tls.crt"] = []byte("renewed")
+		Expect(ContentChanged().Update(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: newObj})).To(BeTrue())
+	})
+
+	It("should pass data and binary data changes of ConfigMaps", func() {
+		oldObj := &corev1.ConfigMap{Data: map[string]string{"a": "b"}}
+
+		newObj := oldObj.DeepCopy()
+		newObj.Labels = map[string]string{"app": "operand"}
+		Expect(ContentChanged().Update(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: newObj})).To(BeFalse())
+
+		newObj.BinaryData = map[string][]byte{"a": []byte("b")}
+		Expect(ContentChanged().Update(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: newObj})).To(BeTrue())
+	})
+
+	It("should not confuse keys and values", func() {
+		first, ok := ContentHash(&corev1.ConfigMap{Data: map[string]string{"ab": "c"}})
+		Expect(ok).To(BeTrue())
+
+		second, ok := ContentHash(&corev1.ConfigMap{Data: map[string]string{"a": "bc"}})
+		Expect(ok).To(BeTrue())
+		Expect(first).NotTo(Equal(second))
+	})
+
+	It("should pass other objects and events", func() {
+		oldObj := &corev1.Pod{}
+		Expect(ContentChanged().Update(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: oldObj.DeepCopy()})).To(BeTrue())
+		Expect(ContentChanged().Create(event.CreateEvent{Object: &corev1.Secret{}})).To(BeTrue())
+
+		_, ok := ContentHash(oldObj)
+		Expect(ok).To(BeFalse())
+	})
+})
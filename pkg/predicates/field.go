@@ -0,0 +1,55 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package predicates provides controller-runtime predicates that filter out update events irrelevant to a
+// controller, such as updates that do not change the fields it reads, to reduce reconciliation churn.
+package predicates
+
+import (
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// FieldChanged returns a predicate that only passes update events changing the value returned by field, compared
+// with equality.Semantic.DeepEqual. Create, delete and generic events pass, as do updates of objects that are not T.
+//
+// Example:
+//
+//	builder.Watches(&configv1.APIServer{}, handler,
+//	    builder.WithPredicates(predicates.FieldChanged(func(o *configv1.APIServer) *configv1.TLSSecurityProfile {
+//	        return o.Spec.TLSSecurityProfile
+//	    })))
+func FieldChanged[T client.Object, F any](field func(obj T) F) predicate.Predicate {
+	return FieldChangedFunc(field, func(a, b F) bool { return equality.Semantic.DeepEqual(a, b) })
+}
+
+// FieldChangedFunc is FieldChanged with a custom equality function, e.g. cmp.Equal with options.
+func FieldChangedFunc[T client.Object, F any](field func(obj T) F, equal func(a, b F) bool) predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldObj, oldOK := e.ObjectOld.(T)
+			newObj, newOK := e.ObjectNew.(T)
+
+			if !oldOK || !newOK {
+				return true
+			}
+
+			return !equal(field(oldObj), field(newObj))
+		},
+	}
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package predicates
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+var _ = Describe("FieldChanged", func() {
+	tlsProfile := FieldChanged(func(o *configv1.APIServer) *configv1.TLSSecurityProfile {
+		return o.Spec.TLSSecurityProfile
+	})
+
+	It("should only pass updates changing the field", func() {
+		oldObj := &configv1.APIServer{ObjectMeta: metav1.ObjectMeta{Name: "cluster"}}
+		newObj := oldObj.DeepCopy()
+		newObj.Spec.Audit.Profile = configv1.AllRequestBodiesAuditProfileType
+		Expect(tlsProfile.Update(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: newObj})).To(BeFalse())
+
+		newObj.Spec.TLSSecurityProfile = &configv1.TLSSecurityProfile{Type: configv1.TLSProfileModernType}
+		Expect(tlsProfile.Update(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: newObj})).To(BeTrue())
+	})
+
+	It("should pass other events and objects", func() {
+		Expect(tlsProfile.Create(event.CreateEvent{Object: &configv1.APIServer{}})).To(BeTrue())
+		Expect(tlsProfile.Delete(event.DeleteEvent{Object: &configv1.APIServer{}})).To(BeTrue())
+		Expect(tlsProfile.Update(event.UpdateEvent{ObjectOld: &corev1.ConfigMap{}, ObjectNew: &corev1.ConfigMap{}})).To(BeTrue())
+	})
+})
+
+var _ = Describe("FieldChangedFunc", func() {
+	It("should use the equality function", func() {
+		data := FieldChangedFunc(func(o *corev1.ConfigMap) string { return o.Data["config"] }, strings.EqualFold)
+
+		oldObj := &corev1.ConfigMap{Data: map[string]string{"config": "value"}}
+		newObj := &corev1.ConfigMap{Data: map[string]string{"config": "VALUE"}}
+		Expect(data.Update(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: newObj})).To(BeFalse())
+
+		newObj.Data["config"] = "other"
+		Expect(data.Update(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: newObj})).To(BeTrue())
+	})
+})
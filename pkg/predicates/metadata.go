@@ -0,0 +1,84 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package predicates
+
+import (
+	"maps"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// LabelsChanged returns a predicate that only passes update events changing the labels with the given keys,
+// or any label when no key is given. Create, delete and generic events pass.
+func LabelsChanged(keys ...string) predicate.Predicate {
+	return FieldChangedFunc(func(obj client.Object) map[string]string {
+		return selectKeys(obj.GetLabels(), keys)
+	}, maps.Equal)
+}
+
+// LabelsUnchanged is the negation of LabelsChanged for update events: it only passes update events leaving the labels
+// with the given keys unchanged, or all labels when no key is given. Create, delete and generic events pass.
+func LabelsUnchanged(keys ...string) predicate.Predicate {
+	return negateUpdates(LabelsChanged(keys...))
+}
+
+// AnnotationsChanged returns a predicate that only passes update events changing the annotations with the given keys,
+// or any annotation when no key is given. Create, delete and generic events pass.
+//
+// Example:
+//
+//	builder.WithPredicates(predicates.AnnotationsChanged("example.com/config-hash"))
+func AnnotationsChanged(keys ...string) predicate.Predicate {
+	return FieldChangedFunc(func(obj client.Object) map[string]string {
+		return selectKeys(obj.GetAnnotations(), keys)
+	}, maps.Equal)
+}
+
+// AnnotationsUnchanged is the negation of AnnotationsChanged for update events: it only passes update events leaving
+// the annotations with the given keys unchanged, or all annotations when no key is given.
+// Create, delete and generic events pass.
+func AnnotationsUnchanged(keys ...string) predicate.Predicate {
+	return negateUpdates(AnnotationsChanged(keys...))
+}
+
+// negateUpdates returns a predicate passing the update events p filters out, and all other events.
+// Unlike predicate.Not, it does not filter out the other events, which p passes.
+func negateUpdates(p predicate.Predicate) predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return !p.Update(e)
+		},
+	}
+}
+
+// selectKeys returns the entries of m with the given keys, or m when no key is given.
+func selectKeys(m map[string]string, keys []string) map[string]string {
+	if len(keys) == 0 {
+		return m
+	}
+
+	selected := make(map[string]string, len(keys))
+	for _, key := range keys {
+		if value, ok := m[key]; ok {
+			selected[key] = value
+		}
+	}
+
+	return selected
+}
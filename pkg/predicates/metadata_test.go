@@ -0,0 +1,72 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package predicates
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+var _ = Describe("Label and annotation predicates", func() {
+	var oldObj, newObj *corev1.ConfigMap
+
+	update := func() event.UpdateEvent {
+		return event.UpdateEvent{ObjectOld: oldObj, ObjectNew: newObj}
+	}
+
+	BeforeEach(func() {
+		oldObj = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Labels:      map[string]string{"app": "operand"},
+			Annotations: map[string]string{"example.com/config-hash": "abc"},
+		}}
+		newObj = oldObj.DeepCopy()
+	})
+
+	It("should only pass changes of the given annotations", func() {
+		newObj.Annotations["kubectl.kubernetes.io/restartedAt"] = "now"
+		Expect(AnnotationsChanged("example.com/config-hash").Update(update())).To(BeFalse())
+		Expect(AnnotationsChanged().Update(update())).To(BeTrue())
+		Expect(AnnotationsUnchanged("example.com/config-hash").Update(update())).To(BeTrue())
+
+		newObj.Annotations["example.com/config-hash"] = "def"
+		Expect(AnnotationsChanged("example.com/config-hash").Update(update())).To(BeTrue())
+		Expect(AnnotationsUnchanged("example.com/config-hash").Update(update())).To(BeFalse())
+
+		delete(newObj.Annotations, "example.com/config-hash")
+		Expect(AnnotationsChanged("example.com/config-hash").Update(update())).To(BeTrue())
+	})
+
+	It("should only pass changes of the given labels", func() {
+		newObj.Labels["unrelated"] = "label"
+		Expect(LabelsChanged("app").Update(update())).To(BeFalse())
+		Expect(LabelsChanged().Update(update())).To(BeTrue())
+		Expect(LabelsUnchanged("app").Update(update())).To(BeTrue())
+
+		newObj.Labels["app"] = "other"
+		Expect(LabelsChanged("app").Update(update())).To(BeTrue())
+		Expect(LabelsUnchanged("app").Update(update())).To(BeFalse())
+	})
+
+	It("should pass other events, negated or not", func() {
+		Expect(LabelsChanged("app").Create(event.CreateEvent{Object: oldObj})).To(BeTrue())
+		Expect(LabelsUnchanged("app").Create(event.CreateEvent{Object: oldObj})).To(BeTrue())
+		Expect(AnnotationsUnchanged().Delete(event.DeleteEvent{Object: oldObj})).To(BeTrue())
+	})
+})
@@ -0,0 +1,159 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preflight
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/openshift/controller-runtime-common/pkg/apiavailability"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	// ErrKindNotServed is returned by the check of KindsServed when kinds are not served, e.g. as their CRDs are
+	// not installed.
+	ErrKindNotServed = errors.New("kinds are not served")
+
+	// ErrAccessDenied is returned by the check of AccessAllowed when the operator is not allowed some verbs.
+	ErrAccessDenied = errors.New("access denied")
+
+	// ErrObjectNotFound is returned by the check of ObjectsExist when objects do not exist.
+	ErrObjectNotFound = errors.New("objects not found")
+)
+
+// KindsServed returns a check that the kinds are served by the API server, e.g. that the CRDs of the operator are
+// installed, as probed through discovery.
+func KindsServed(d discovery.DiscoveryInterface, gvks ...schema.GroupVersionKind) CheckFunc {
+	return func(ctx context.Context) error {
+		prober := &apiavailability.Prober{Discovery: d}
+		prober.Register(gvks...)
+
+		if err := prober.Probe(ctx); err != nil {
+			return err
+		}
+
+		var missing []string
+
+		for _, gvk := range gvks {
+			if !prober.Available(gvk) {
+				missing = append(missing, gvk.String())
+			}
+		}
+
+		if len(missing) > 0 {
+			return fmt.Errorf("%w: %s", ErrKindNotServed, strings.Join(missing, ", "))
+		}
+
+		return nil
+	}
+}
+
+// AccessAllowed returns a check that the operator is allowed the verbs on the resources, as reviewed by
+// SelfSubjectAccessReviews.
+//
+// Example:
+//
+//	preflight.AccessAllowed(mgr.GetClient(),
+//	    authorizationv1.ResourceAttributes{Verb: "update", Group: "apps", Resource: "deployments"},
+//	    authorizationv1.ResourceAttributes{Verb: "get", Group: "config.openshift.io", Resource: "infrastructures"})
+func AccessAllowed(c client.Client, attributes ...authorizationv1.ResourceAttributes) CheckFunc {
+	return func(ctx context.Context) error {
+		var denied []string
+
+		for _, attrs := range attributes {
+			review := &authorizationv1.SelfSubjectAccessReview{
+				Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: &attrs},
+			}
+
+			if err := c.Create(ctx, review); err != nil {
+				return fmt.Errorf("failed to review access to %s: %w", describe(attrs), err)
+			}
+
+			if !review.Status.Allowed {
+				denied = append(denied, describe(attrs))
+			}
+		}
+
+		if len(denied) > 0 {
+			return fmt.Errorf("%w: %s", ErrAccessDenied, strings.Join(denied, ", "))
+		}
+
+		return nil
+	}
+}
+
+// ObjectsExist returns a check that the objects exist, e.g. the configuration singletons the operator reads.
+// The objects are read with their namespace and name.
+//
+// Example:
+//
+//	preflight.ObjectsExist(mgr.GetAPIReader(), &configv1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Name: "cluster"}})
+func ObjectsExist(reader client.Reader, objs ...client.Object) CheckFunc {
+	return func(ctx context.Context) error {
+		var missing []string
+
+		for _, obj := range objs {
+			key := client.ObjectKeyFromObject(obj)
+
+			err := reader.Get(ctx, key, obj)
+			if apierrors.IsNotFound(err) {
+				missing = append(missing, fmt.Sprintf("%T %s", obj, key.String()))
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("failed to get %T %s: %w", obj, key.String(), err)
+			}
+		}
+
+		if len(missing) > 0 {
+			return fmt.Errorf("%w: %s", ErrObjectNotFound, strings.Join(missing, ", "))
+		}
+
+		return nil
+	}
+}
+
+// describe describes the resource attributes of an access review, e.g. "update apps/deployments in ns".
+func describe(attrs authorizationv1.ResourceAttributes) string {
+	resource := attrs.Resource
+	if attrs.Group != "" {
+		resource = attrs.Group + "/" + resource
+	}
+
+	if attrs.Subresource != "" {
+		resource += "/" + attrs.Subresource
+	}
+
+	description := attrs.Verb + " " + resource
+	if attrs.Name != "" {
+		description += " " + attrs.Name
+	}
+
+	if attrs.Namespace != "" {
+		description += " in " + attrs.Namespace
+	}
+
+	return description
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preflight
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	discoveryfake "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+var _ = Describe("Checks", func() {
+	ctx := context.Background()
+
+	It("should check that kinds are served", func() {
+		routeGVK := schema.GroupVersionKind{Group: "route.openshift.io", Version: "v1", Kind: "Route"}
+		operandGVK := schema.GroupVersionKind{Group: "example.openshift.io", Version: "v1alpha1", Kind: "Operand"}
+
+		discovery := &discoveryfake.FakeDiscovery{Fake: &clienttesting.Fake{Resources: []*metav1.APIResourceList{{
+			GroupVersion: routeGVK.GroupVersion().String(),
+			APIResources: []metav1.APIResource{{Name: "routes", Kind: routeGVK.Kind}},
+		}}}}
+
+		Expect(KindsServed(discovery, routeGVK)(ctx)).To(Succeed())
+		Expect(KindsServed(discovery, routeGVK, operandGVK)(ctx)).
+			To(SatisfyAll(MatchError(ErrKindNotServed), MatchError(ContainSubstring("Kind=Operand"))))
+	})
+
+	It("should check that verbs are allowed", func() {
+		c := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+			Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+				review, ok := obj.(*authorizationv1.SelfSubjectAccessReview)
+				if !ok {
+					return errors.New("unexpected object")
+				}
+
+				review.Status.Allowed = review.Spec.ResourceAttributes.Verb == "get"
+
+				return nil
+			},
+		}).Build()
+
+		get := authorizationv1.ResourceAttributes{Verb: "get", Group: "apps", Resource: "deployments"}
+		update := authorizationv1.ResourceAttributes{Verb: "update", Group: "apps", Resource: "deployments", Namespace: "ns"}
+
+		Expect(AccessAllowed(c, get)(ctx)).To(Succeed())
+		Expect(AccessAllowed(c, get, update)(ctx)).
+			To(SatisfyAll(MatchError(ErrAccessDenied), MatchError(ContainSubstring("update apps/deployments in ns"))))
+	})
+
+	It("should check that objects exist", func() {
+		scheme := runtime.NewScheme()
+		Expect(configv1.AddToScheme(scheme)).To(Succeed())
+
+		c := fake.NewClientBuilder().WithScheme(scheme).
+			WithObjects(&configv1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Name: "cluster"}}).Build()
+
+		Expect(ObjectsExist(c, &configv1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Name: "cluster"}})(ctx)).To(Succeed())
+		Expect(ObjectsExist(c,
+			&configv1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Name: "cluster"}},
+			&configv1.Proxy{ObjectMeta: metav1.ObjectMeta{Name: "cluster"}},
+		)(ctx)).To(SatisfyAll(MatchError(ErrObjectNotFound), MatchError(ContainSubstring("*v1.Proxy /cluster"))))
+	})
+})
@@ -0,0 +1,310 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package preflight runs the checks an operator depends on before its controllers start, e.g. that its CRDs are
+// installed, that it is allowed the verbs it uses or that the configuration singletons it reads exist, so that a
+// misconfigured installation is reported once and clearly rather than by every reconciliation.
+package preflight
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openshift/controller-runtime-common/pkg/conditions"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// TypePreflightChecksPassed is the type of the condition reporting the results of the preflight checks.
+	TypePreflightChecksPassed = "PreflightChecksPassed"
+
+	// ReasonChecksFailed is the reason used when preflight checks failed.
+	ReasonChecksFailed = "PreflightChecksFailed"
+	// ReasonNotRun is the reason used while the preflight checks have not run yet.
+	ReasonNotRun = "PreflightChecksNotRun"
+
+	// DefaultRetryInterval is the default interval between the attempts of checks with the Retry policy.
+	DefaultRetryInterval = 10 * time.Second
+	// DefaultRetryTimeout is the default time checks with the Retry policy are retried for.
+	DefaultRetryTimeout = 5 * time.Minute
+)
+
+var (
+	// ErrCheckFailed is returned by Run when a check with the FailFast policy fails, or one with the Retry policy
+	// does not pass in time.
+	ErrCheckFailed = errors.New("preflight check failed")
+
+	// ErrNotRun is returned by Ready until the checks have run.
+	ErrNotRun = errors.New("preflight checks have not run")
+)
+
+// Policy is what the Runner does when a check fails.
+type Policy int
+
+const (
+	// FailFast stops the checks and makes Run return the error of the check, e.g. so that the operator exits.
+	FailFast Policy = iota
+	// Degrade records the failure, reported by Condition and Errors, and carries on with the next checks.
+	Degrade
+	// Retry retries the check until it passes, and fails as FailFast when it does not pass within the retry timeout.
+	Retry
+)
+
+// String returns the name of the policy.
+func (p Policy) String() string {
+	switch p {
+	case FailFast:
+		return "FailFast"
+	case Degrade:
+		return "Degrade"
+	case Retry:
+		return "Retry"
+	default:
+		return fmt.Sprintf("Policy(%d)", int(p))
+	}
+}
+
+// CheckFunc checks a precondition of the operator, returning an error describing what is missing.
+type CheckFunc func(ctx context.Context) error
+
+// Check is a named CheckFunc with the policy applied when it fails.
+type Check struct {
+	// Name identifies the check in logs, errors and conditions.
+	Name string
+
+	// Policy is applied when Func fails. Defaults to FailFast.
+	Policy Policy
+
+	// Func is the check.
+	Func CheckFunc
+}
+
+// Result is the result of a check.
+type Result struct {
+	// Name is the name of the check.
+	Name string
+
+	// Policy is the policy of the check.
+	Policy Policy
+
+	// Err is the error of the check, nil when it passed.
+	Err error
+}
+
+// Runner runs the registered checks in order of registration. Call Run before starting the manager, or start the
+// Runner as a component of a runnable.Orchestrator that the controllers depend on.
+//
+// Example:
+//
+//	runner := &preflight.Runner{}
+//	runner.Register(
+//	    preflight.Check{Name: "CRDs", Policy: preflight.Retry,
+//	        Func: preflight.KindsServed(discoveryClient, v1alpha1.GroupVersion.WithKind("Operand"))},
+//	    preflight.Check{Name: "RBAC", Func: preflight.AccessAllowed(mgr.GetClient(),
+//	        authorizationv1.ResourceAttributes{Verb: "update", Group: "apps", Resource: "deployments"})},
+//	    preflight.Check{Name: "Infrastructure", Policy: preflight.Degrade, Func: preflight.ObjectsExist(
+//	        mgr.GetAPIReader(), &configv1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Name: "cluster"}})},
+//	)
+//	if err := runner.Run(ctx); err != nil {
+//	    return err
+//	}
+//
+//	runner.Condition() // PreflightChecksPassed, to set in the status of the operator
+type Runner struct {
+	// RetryInterval is the interval between the attempts of checks with the Retry policy.
+	// Defaults to DefaultRetryInterval.
+	RetryInterval time.Duration
+
+	// RetryTimeout is the time checks with the Retry policy are retried for. Defaults to DefaultRetryTimeout.
+	RetryTimeout time.Duration
+
+	mu      sync.RWMutex
+	checks  []Check
+	results []Result
+	ran     bool
+}
+
+// Register registers checks to run.
+func (r *Runner) Register(checks ...Check) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.checks = append(r.checks, checks...)
+}
+
+// Run runs the checks in order of registration and records their results. It returns ErrCheckFailed, wrapping
+// the error of the check, as soon as a check with the FailFast policy fails or one with the Retry policy does not
+// pass in time; the remaining checks are not run. Failures of checks with the Degrade policy are only recorded.
+func (r *Runner) Run(ctx context.Context) error {
+	r.mu.RLock()
+	checks := append([]Check(nil), r.checks...)
+	r.mu.RUnlock()
+
+	logger := log.FromContext(ctx).WithName("preflight")
+	results := make([]Result, 0, len(checks))
+
+	defer func() {
+		r.mu.Lock()
+		r.results = results
+		r.ran = true
+		r.mu.Unlock()
+	}()
+
+	for _, check := range checks {
+		err := r.run(ctx, check)
+		results = append(results, Result{Name: check.Name, Policy: check.Policy, Err: err})
+
+		if err == nil {
+			logger.V(1).Info("Preflight check passed", "check", check.Name)
+			continue
+		}
+
+		if check.Policy == Degrade {
+			logger.Error(err, "Preflight check failed, running degraded", "check", check.Name)
+			continue
+		}
+
+		return fmt.Errorf("%w: %s: %w", ErrCheckFailed, check.Name, err)
+	}
+
+	return nil
+}
+
+// run runs check, retrying it with the Retry policy.
+func (r *Runner) run(ctx context.Context, check Check) error {
+	if check.Policy != Retry {
+		return check.Func(ctx)
+	}
+
+	interval := r.RetryInterval
+	if interval <= 0 {
+		interval = DefaultRetryInterval
+	}
+
+	timeout := r.RetryTimeout
+	if timeout <= 0 {
+		timeout = DefaultRetryTimeout
+	}
+
+	var lastErr error
+
+	if err := wait.PollUntilContextTimeout(ctx, interval, timeout, true, func(ctx context.Context) (bool, error) {
+		lastErr = check.Func(ctx)
+		if lastErr != nil {
+			log.FromContext(ctx).Info("Preflight check failed, retrying", "check", check.Name, "error", lastErr.Error())
+		}
+
+		return lastErr == nil, nil
+	}); err != nil {
+		if lastErr != nil {
+			return fmt.Errorf("%w: %w", err, lastErr)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// Start implements manager.Runnable: it runs the checks and returns, so that a runnable.Orchestrator starts the
+// components depending on the Runner once Ready.
+func (r *Runner) Start(ctx context.Context) error {
+	return r.Run(ctx)
+}
+
+// Ready returns ErrNotRun until the checks have run, and then the error of the first failed check with the FailFast
+// or Retry policy, if any. It is meant as the Ready of a runnable.Component.
+func (r *Runner) Ready(_ context.Context) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if !r.ran {
+		return ErrNotRun
+	}
+
+	for _, result := range r.results {
+		if result.Err != nil && result.Policy != Degrade {
+			return fmt.Errorf("%w: %s: %w", ErrCheckFailed, result.Name, result.Err)
+		}
+	}
+
+	if len(r.results) < len(r.checks) {
+		return ErrNotRun
+	}
+
+	return nil
+}
+
+// Results returns the results of the checks that ran, in order of registration.
+func (r *Runner) Results() []Result {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]Result(nil), r.results...)
+}
+
+// Errors returns the errors of the failed checks, e.g. to report them as the Errors of a conditions.StateReport so
+// that Degraded is set.
+func (r *Runner) Errors() []error {
+	var errs []error
+
+	for _, result := range r.Results() {
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("%w: %s: %w", ErrCheckFailed, result.Name, result.Err))
+		}
+	}
+
+	return errs
+}
+
+// Condition returns the PreflightChecksPassed condition: Unknown until the checks have run, False listing the
+// failed checks, and True otherwise. It has no transition time nor generation, it is meant to be applied with
+// conditions.Set.
+func (r *Runner) Condition() metav1.Condition {
+	r.mu.RLock()
+	ran := r.ran
+	r.mu.RUnlock()
+
+	if !ran {
+		return conditions.New(TypePreflightChecksPassed, metav1.ConditionUnknown, ReasonNotRun).Condition()
+	}
+
+	var failed []string
+
+	for _, result := range r.Results() {
+		if result.Err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", result.Name, result.Err))
+		}
+	}
+
+	if len(failed) > 0 {
+		return conditions.New(TypePreflightChecksPassed, metav1.ConditionFalse, ReasonChecksFailed).
+			Message(strings.Join(failed, "; ")).Condition()
+	}
+
+	return conditions.New(TypePreflightChecksPassed, metav1.ConditionTrue, conditions.ReasonAsExpected).Condition()
+}
+
+// Apply sets the PreflightChecksPassed condition and reports whether it changed.
+func (r *Runner) Apply(conds *[]metav1.Condition, generation int64) bool {
+	return conditions.Set(conds, r.Condition(), generation)
+}
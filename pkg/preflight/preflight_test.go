@@ -0,0 +1,106 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preflight
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/controller-runtime-common/pkg/conditions"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Runner", func() {
+	ctx := context.Background()
+
+	pass := func(context.Context) error { return nil }
+	fail := func(context.Context) error { return errors.New("boom") }
+
+	It("should report the checks as not run until run", func() {
+		r := &Runner{}
+		r.Register(Check{Name: "Pass", Func: pass})
+
+		Expect(r.Ready(ctx)).To(MatchError(ErrNotRun))
+		Expect(r.Condition()).To(HaveField("Status", metav1.ConditionUnknown))
+
+		Expect(r.Run(ctx)).To(Succeed())
+		Expect(r.Ready(ctx)).To(Succeed())
+		Expect(r.Errors()).To(BeEmpty())
+
+		var conds []metav1.Condition
+		Expect(r.Apply(&conds, 1)).To(BeTrue())
+		Expect(conditions.IsTrue(conds, TypePreflightChecksPassed)).To(BeTrue())
+	})
+
+	It("should stop at the first check failing fast", func() {
+		ran := false
+
+		r := &Runner{}
+		r.Register(
+			Check{Name: "Fail", Func: fail},
+			Check{Name: "Never", Func: func(context.Context) error {
+				ran = true
+				return nil
+			}},
+		)
+
+		Expect(r.Run(ctx)).To(SatisfyAll(MatchError(ErrCheckFailed), MatchError(ContainSubstring("Fail: boom"))))
+		Expect(ran).To(BeFalse())
+		Expect(r.Results()).To(ConsistOf(HaveField("Name", "Fail")))
+		Expect(r.Ready(ctx)).To(MatchError(ErrCheckFailed))
+	})
+
+	It("should carry on after degraded checks and report them", func() {
+		r := &Runner{}
+		r.Register(Check{Name: "Degraded", Policy: Degrade, Func: fail}, Check{Name: "Pass", Func: pass})
+
+		Expect(r.Run(ctx)).To(Succeed())
+		Expect(r.Ready(ctx)).To(Succeed())
+		Expect(r.Results()).To(HaveLen(2))
+		Expect(r.Errors()).To(ConsistOf(MatchError(ContainSubstring("Degraded: boom"))))
+
+		condition := r.Condition()
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(ReasonChecksFailed))
+		Expect(condition.Message).To(Equal("Degraded: boom"))
+	})
+
+	It("should retry checks until they pass or time out", func() {
+		attempts := 0
+
+		r := &Runner{RetryInterval: time.Millisecond, RetryTimeout: time.Second}
+		r.Register(Check{Name: "Eventually", Policy: Retry, Func: func(context.Context) error {
+			attempts++
+			if attempts < 3 {
+				return errors.New("not yet")
+			}
+
+			return nil
+		}})
+
+		Expect(r.Run(ctx)).To(Succeed())
+		Expect(attempts).To(Equal(3))
+
+		r = &Runner{RetryInterval: time.Millisecond, RetryTimeout: 20 * time.Millisecond}
+		r.Register(Check{Name: "Never", Policy: Retry, Func: fail})
+
+		Expect(r.Run(ctx)).To(SatisfyAll(MatchError(ErrCheckFailed), MatchError(ContainSubstring("boom"))))
+	})
+})
@@ -0,0 +1,278 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package projection projects selected keys of Secrets and ConfigMaps, e.g. the certificate configured by the user in
+// openshift-config, into copies in the namespaces of the operands, owned by the resources they are projected for and
+// kept in sync with their sources.
+package projection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/controller-runtime-common/pkg/ownerref"
+	"github.com/openshift/controller-runtime-common/pkg/references"
+	"github.com/openshift/controller-runtime-common/pkg/resourceapply"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// SourceAnnotation is the annotation of projected objects holding the namespace and name of their source.
+	SourceAnnotation = "projection.openshift.io/source"
+	// SourceHashAnnotation is the annotation of projected objects holding the hash of their projected data, e.g. to
+	// roll out the pods mounting them with resourceapply.SetInputsHash.
+	SourceHashAnnotation = "projection.openshift.io/source-hash"
+)
+
+// ErrConflict is returned when the destination of a projection exists and is not a projection of its source, e.g. an
+// object created by the user, which is left as it is.
+var ErrConflict = errors.New("destination exists and is not a projection of the source")
+
+// Projection projects keys of a source Secret or ConfigMap into a destination of the same kind.
+type Projection struct {
+	// Source is the namespace and name of the source.
+	Source client.ObjectKey
+
+	// Destination is the namespace and name of the projected copy.
+	Destination client.ObjectKey
+
+	// Keys maps the keys of the source to project to their keys in the destination. All the keys are projected as
+	// they are when empty. The keys are required in the source.
+	Keys map[string]string
+
+	// DeleteOnMissingSource deletes the projected copy when its source does not exist, rather than keeping the last
+	// projected data.
+	DeleteOnMissingSource bool
+}
+
+// SecretReference returns the reference to the source Secret, e.g. to reconcile the owner of the projection when its
+// source changes with a references.Index.
+func (p Projection) SecretReference() references.Reference {
+	return references.SecretReference(p.Source.Namespace, p.Source.Name)
+}
+
+// ConfigMapReference returns the reference to the source ConfigMap.
+func (p Projection) ConfigMapReference() references.Reference {
+	return references.ConfigMapReference(p.Source.Namespace, p.Source.Name)
+}
+
+// Projector projects Secrets and ConfigMaps.
+//
+// Sources are usually in namespaces that are not watched by the operator, so their owners have to be reconciled
+// when they change, e.g. by watching them with a references.Index recording the references of the projections.
+//
+// Example:
+//
+//	projector := &projection.Projector{Client: r.Client, SourceReader: r.APIReader, Recorder: r.Recorder}
+//	servingCert := projection.Projection{
+//	    Source:      client.ObjectKey{Namespace: "openshift-config", Name: operand.Spec.ServingCertSecret},
+//	    Destination: client.ObjectKey{Namespace: operand.Namespace, Name: "operand-serving-cert"},
+//	    Keys:        map[string]string{corev1.TLSCertKey: corev1.TLSCertKey, corev1.TLSPrivateKeyKey: corev1.TLSPrivateKeyKey},
+//	}
+//	r.Index.SetReferences(req.NamespacedName, servingCert.SecretReference())
+//
+//	secret, _, err := projector.ProjectSecret(ctx, operand, servingCert)
+//	if err != nil {
+//	    return err
+//	}
+//	err = resourceapply.SetInputsHash(deployment, secret.Annotations[projection.SourceHashAnnotation])
+type Projector struct {
+	// Client reads and writes the projected copies.
+	Client client.Client
+
+	// SourceReader reads the sources, e.g. the manager's APIReader for sources in namespaces that are not cached.
+	// Defaults to Client.
+	SourceReader client.Reader
+
+	// Recorder records the changes of the projected copies as events when set.
+	Recorder events.EventRecorder
+
+	// Options are passed to the Ensure functions of resourceapply.
+	Options []resourceapply.Option
+}
+
+// ProjectSecret ensures the destination Secret holds the projected keys of the source, controlled by owner when set.
+// The destination keeps the type of the source when all its keys are projected, except for service account tokens,
+// and is Opaque otherwise. It returns the projected Secret and whether it was created or updated.
+//
+// It fails with ErrConflict when the destination exists and is not a projection of the source, and with the errors of
+// references.ResolveSecret when the source does not exist or misses keys.
+func (p *Projector) ProjectSecret(ctx context.Context, owner client.Object, projection Projection) (*corev1.Secret, bool, error) {
+	source, err := references.ResolveSecret(ctx, p.sourceReader(), projection.Source.Namespace,
+		configv1.SecretNameReference{Name: projection.Source.Name}, sourceKeys(projection)...)
+	if err != nil {
+		return nil, false, p.handleMissingSource(ctx, &corev1.Secret{}, projection, err)
+	}
+
+	data := project(source.Data, projection.Keys)
+
+	secretType := corev1.SecretTypeOpaque
+	if len(projection.Keys) == 0 && source.Type != "" && source.Type != corev1.SecretTypeServiceAccountToken {
+		secretType = source.Type
+	}
+
+	required := &corev1.Secret{Type: secretType, Data: data}
+	if err := p.prepare(ctx, &corev1.Secret{}, required, owner, projection, data); err != nil {
+		return nil, false, err
+	}
+
+	return resourceapply.EnsureSecret(ctx, p.Client, p.Recorder, required, p.Options...)
+}
+
+// ProjectConfigMap ensures the destination ConfigMap holds the projected keys of the source, from its data and binary
+// data, controlled by owner when set. It returns the projected ConfigMap and whether it was created or updated.
+//
+// It fails like ProjectSecret.
+func (p *Projector) ProjectConfigMap(ctx context.Context, owner client.Object, projection Projection) (*corev1.ConfigMap, bool, error) {
+	source, err := references.ResolveConfigMap(ctx, p.sourceReader(), projection.Source.Namespace,
+		configv1.ConfigMapNameReference{Name: projection.Source.Name}, sourceKeys(projection)...)
+	if err != nil {
+		return nil, false, p.handleMissingSource(ctx, &corev1.ConfigMap{}, projection, err)
+	}
+
+	data := project(source.Data, projection.Keys)
+	binaryData := project(source.BinaryData, projection.Keys)
+
+	required := &corev1.ConfigMap{Data: data, BinaryData: binaryData}
+	if err := p.prepare(ctx, &corev1.ConfigMap{}, required, owner, projection, data, binaryData); err != nil {
+		return nil, false, err
+	}
+
+	return resourceapply.EnsureConfigMap(ctx, p.Client, p.Recorder, required, p.Options...)
+}
+
+// Remove deletes the destination of the projection, of the kind of obj, e.g. when the projection is no longer
+// needed. Destinations that are not projections of the source are left as they are. It reports whether the
+// destination was deleted.
+func (p *Projector) Remove(ctx context.Context, obj client.Object, projection Projection) (bool, error) {
+	if err := p.Client.Get(ctx, projection.Destination, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("failed to get projection %s: %w", projection.Destination.String(), err)
+	}
+
+	if obj.GetAnnotations()[SourceAnnotation] != projection.Source.String() {
+		return false, nil
+	}
+
+	if err := p.Client.Delete(ctx, obj, client.Preconditions{UID: ptr.To(obj.GetUID())}); client.IgnoreNotFound(err) != nil {
+		return false, fmt.Errorf("failed to delete projection %s: %w", projection.Destination.String(), err)
+	}
+
+	log.FromContext(ctx).Info("Deleted projection", "source", projection.Source.String(),
+		"destination", projection.Destination.String())
+
+	return true, nil
+}
+
+// sourceReader returns the reader of the sources.
+func (p *Projector) sourceReader() client.Reader {
+	if p.SourceReader != nil {
+		return p.SourceReader
+	}
+
+	return p.Client
+}
+
+// handleMissingSource deletes the destination, of the kind of obj, when the source does not exist and the projection
+// asks for it, and returns err.
+func (p *Projector) handleMissingSource(ctx context.Context, obj client.Object, projection Projection, err error) error {
+	if !projection.DeleteOnMissingSource || !errors.Is(err, references.ErrReferenceNotFound) {
+		return err
+	}
+
+	if _, removeErr := p.Remove(ctx, obj, projection); removeErr != nil {
+		return errors.Join(err, removeErr)
+	}
+
+	return err
+}
+
+// prepare sets the metadata of required, the projection of the hashed data, after checking that the existing
+// destination, read into existing, is a projection of the source.
+func (p *Projector) prepare(ctx context.Context, existing, required, owner client.Object, projection Projection, data ...any) error {
+	err := p.Client.Get(ctx, projection.Destination, existing)
+
+	switch {
+	case apierrors.IsNotFound(err):
+	case err != nil:
+		return fmt.Errorf("failed to get projection %s: %w", projection.Destination.String(), err)
+	case existing.GetAnnotations()[SourceAnnotation] != projection.Source.String():
+		return fmt.Errorf("%w: %s is not projected from %s", ErrConflict, projection.Destination.String(),
+			projection.Source.String())
+	}
+
+	hash, err := resourceapply.Hash(data...)
+	if err != nil {
+		return err
+	}
+
+	required.SetNamespace(projection.Destination.Namespace)
+	required.SetName(projection.Destination.Name)
+	required.SetAnnotations(map[string]string{
+		SourceAnnotation:     projection.Source.String(),
+		SourceHashAnnotation: hash,
+	})
+
+	if owner != nil {
+		if err := ownerref.SetControllerReference(p.Client, owner, required); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sourceKeys returns the keys of the source to project, sorted, or none when all are projected.
+func sourceKeys(projection Projection) []string {
+	return slices.Sorted(maps.Keys(projection.Keys))
+}
+
+// project returns the projected keys of data, all of them when keys is empty. Keys missing from data are skipped, as
+// the keys of ConfigMaps are split between their data and binary data.
+func project[V any](data map[string]V, keys map[string]string) map[string]V {
+	if len(keys) == 0 {
+		return maps.Clone(data)
+	}
+
+	var projected map[string]V
+
+	for sourceKey, destinationKey := range keys {
+		value, ok := data[sourceKey]
+		if !ok {
+			continue
+		}
+
+		if projected == nil {
+			projected = map[string]V{}
+		}
+
+		projected[destinationKey] = value
+	}
+
+	return projected
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projection
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/controller-runtime-common/pkg/references"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Projector", func() {
+	var (
+		ctx       context.Context
+		c         client.Client
+		source    *corev1.Secret
+		owner     *corev1.ConfigMap
+		projector *Projector
+	)
+
+	servingCert := Projection{
+		Source:      client.ObjectKey{Namespace: "openshift-config", Name: "user-cert"},
+		Destination: client.ObjectKey{Namespace: "operand", Name: "serving-cert"},
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		source = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-config", Name: "user-cert"},
+			Type:       corev1.SecretTypeTLS,
+			Data: map[string][]byte{
+				corev1.TLSCertKey:       []byte("cert"),
+				corev1.TLSPrivateKeyKey: []byte("key"),
+				"ca.crt":                []byte("ca"),
+			},
+		}
+		owner = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "operand", Name: "owner", UID: "owner-uid"}}
+		mapper := meta.NewDefaultRESTMapper(nil)
+		mapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+		mapper.Add(corev1.SchemeGroupVersion.WithKind("Secret"), meta.RESTScopeNamespace)
+
+		c = fake.NewClientBuilder().WithRESTMapper(mapper).WithObjects(source, owner).Build()
+		projector = &Projector{Client: c, Recorder: events.NewFakeRecorder(10)}
+	})
+
+	It("should project all the keys with the type of the source", func() {
+		secret, changed, err := projector.ProjectSecret(ctx, owner, servingCert)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(secret.Type).To(Equal(corev1.SecretTypeTLS))
+		Expect(secret.Data).To(Equal(source.Data))
+		Expect(secret.Annotations).To(HaveKeyWithValue(SourceAnnotation, "openshift-config/user-cert"))
+		Expect(secret.Annotations).To(HaveKey(SourceHashAnnotation))
+		Expect(metav1.IsControlledBy(secret, owner)).To(BeTrue())
+
+		_, changed, err = projector.ProjectSecret(ctx, owner, servingCert)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+	})
+
+	It("should project the selected keys and sync the changes of the source", func() {
+		caBundle := servingCert
+		caBundle.Destination.Name = "ca-bundle"
+		caBundle.Keys = map[string]string{"ca.crt": "service-ca.crt"}
+
+		secret, _, err := projector.ProjectSecret(ctx, nil, caBundle)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secret.Type).To(Equal(corev1.SecretTypeOpaque))
+		Expect(secret.Data).To(Equal(map[string][]byte{"service-ca.crt": []byte("ca")}))
+		Expect(secret.OwnerReferences).To(BeEmpty())
+		hash := secret.Annotations[SourceHashAnnotation]
+
+		source.Data["ca.crt"] = []byte("rotated")
+		Expect(c.Update(ctx, source)).To(Succeed())
+
+		secret, changed, err := projector.ProjectSecret(ctx, nil, caBundle)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(secret.Data).To(Equal(map[string][]byte{"service-ca.crt": []byte("rotated")}))
+		Expect(secret.Annotations[SourceHashAnnotation]).NotTo(Equal(hash))
+
+		caBundle.Keys = map[string]string{"missing": "missing"}
+		_, _, err = projector.ProjectSecret(ctx, nil, caBundle)
+		Expect(err).To(MatchError(references.ErrInvalidReference))
+	})
+
+	It("should not overwrite objects that are not projections of the source", func() {
+		Expect(c.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "operand", Name: "serving-cert"},
+			Data:       map[string][]byte{"user": []byte("data")},
+		})).To(Succeed())
+
+		_, _, err := projector.ProjectSecret(ctx, owner, servingCert)
+		Expect(err).To(MatchError(ErrConflict))
+
+		deleted, err := projector.Remove(ctx, &corev1.Secret{}, servingCert)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deleted).To(BeFalse())
+	})
+
+	It("should delete the projection when the source is missing if asked to", func() {
+		_, _, err := projector.ProjectSecret(ctx, owner, servingCert)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Delete(ctx, source)).To(Succeed())
+
+		_, _, err = projector.ProjectSecret(ctx, owner, servingCert)
+		Expect(err).To(MatchError(references.ErrReferenceNotFound))
+		Expect(c.Get(ctx, servingCert.Destination, &corev1.Secret{})).To(Succeed())
+
+		deleteOnMissingSource := servingCert
+		deleteOnMissingSource.DeleteOnMissingSource = true
+
+		_, _, err = projector.ProjectSecret(ctx, owner, deleteOnMissingSource)
+		Expect(err).To(MatchError(references.ErrReferenceNotFound))
+		Expect(c.Get(ctx, servingCert.Destination, &corev1.Secret{})).NotTo(Succeed())
+	})
+
+	It("should project ConfigMaps", func() {
+		Expect(c.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-config", Name: "trusted-ca"},
+			Data:       map[string]string{"ca-bundle.crt": "bundle", "other": "value"},
+			BinaryData: map[string][]byte{"ca.der": []byte("der")},
+		})).To(Succeed())
+
+		trustedCA := Projection{
+			Source:      client.ObjectKey{Namespace: "openshift-config", Name: "trusted-ca"},
+			Destination: client.ObjectKey{Namespace: "operand", Name: "trusted-ca"},
+			Keys:        map[string]string{"ca-bundle.crt": "tls-ca-bundle.pem", "ca.der": "ca.der"},
+		}
+
+		configMap, changed, err := projector.ProjectConfigMap(ctx, owner, trustedCA)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(configMap.Data).To(Equal(map[string]string{"tls-ca-bundle.pem": "bundle"}))
+		Expect(configMap.BinaryData).To(Equal(map[string][]byte{"ca.der": []byte("der")}))
+		Expect(trustedCA.ConfigMapReference()).To(Equal(references.ConfigMapReference("openshift-config", "trusted-ca")))
+
+		deleted, err := projector.Remove(ctx, &corev1.ConfigMap{}, trustedCA)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deleted).To(BeTrue())
+	})
+})
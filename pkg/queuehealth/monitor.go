@@ -0,0 +1,299 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package queuehealth monitors the workqueues of controllers and reports those that stay saturated, to readiness
+// probes and in a QueueSaturated condition, surfacing overloaded controllers.
+package queuehealth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openshift/controller-runtime-common/pkg/conditions"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// ConditionType is the type of the condition reporting saturated workqueues.
+	ConditionType = "QueueSaturated"
+	// ReasonSaturated is the reason of the condition when workqueues are saturated.
+	ReasonSaturated = "WorkQueuesSaturated"
+
+	// DefaultInterval is the default interval at which the workqueues are checked.
+	DefaultInterval = 10 * time.Second
+	// DefaultSustainedFor is the default time a workqueue must exceed a threshold to be saturated.
+	DefaultSustainedFor = 2 * time.Minute
+
+	depthMetric                   = "workqueue_" + metrics.DepthKey
+	queueLatencyMetric            = "workqueue_" + metrics.QueueLatencyKey
+	longestRunningProcessorMetric = "workqueue_" + metrics.LongestRunningProcessorKey
+)
+
+// ErrQueuesSaturated is returned by the readiness check while workqueues are saturated.
+var ErrQueuesSaturated = errors.New("workqueues are saturated")
+
+// Monitor periodically checks the controller-runtime workqueue metrics of the controllers, and considers a workqueue
+// saturated once it exceeded a threshold for SustainedFor, until it no longer does. Thresholds left zero are not
+// checked.
+//
+// The Monitor runs on every replica, regardless of leader election, as every replica serves probes.
+//
+// Example:
+//
+//	monitor := &queuehealth.Monitor{MaxDepth: 1000, MaxQueueLatency: time.Minute}
+//	if err := monitor.SetupWithManager(mgr); err != nil {
+//	    return err
+//	}
+//
+//	// In Reconcile:
+//	conditions.Set(&operand.Status.Conditions, monitor.Condition(), operand.Generation)
+type Monitor struct {
+	// MaxDepth is the number of items waiting in a workqueue above which it is saturated.
+	MaxDepth float64
+
+	// MaxQueueLatency is the average time items waited in a workqueue between two checks above which it is saturated.
+	MaxQueueLatency time.Duration
+
+	// MaxProcessingTime is the time the longest running reconciliation of a controller has been running above which
+	// its workqueue is saturated.
+	MaxProcessingTime time.Duration
+
+	// SustainedFor is how long a threshold must be exceeded for a workqueue to be saturated.
+	// Defaults to DefaultSustainedFor.
+	SustainedFor time.Duration
+
+	// Interval is the interval at which the workqueues are checked. Defaults to DefaultInterval.
+	Interval time.Duration
+
+	// Gatherer gathers the workqueue metrics. Defaults to the controller-runtime metrics registry.
+	Gatherer prometheus.Gatherer
+
+	// now returns the current time, it is replaced in tests.
+	now func() time.Time
+
+	mu          sync.RWMutex
+	exceeding   map[string]time.Time
+	saturated   map[string]string
+	lastLatency map[string]histogramTotals
+}
+
+// histogramTotals are the cumulative totals of a histogram.
+type histogramTotals struct {
+	sum   float64
+	count uint64
+}
+
+// SetupWithManager adds the Monitor to the manager and its readiness check, named "workqueues", to the manager's
+// readiness checks.
+func (m *Monitor) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.Add(m); err != nil {
+		return fmt.Errorf("failed to add workqueue health monitor to manager: %w", err)
+	}
+
+	if err := mgr.AddReadyzCheck("workqueues", m.Check); err != nil {
+		return fmt.Errorf("failed to add workqueue readiness check: %w", err)
+	}
+
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+// The Monitor runs on every replica, as every replica serves probes.
+func (m *Monitor) NeedLeaderElection() bool {
+	return false
+}
+
+// Start checks the workqueues at every interval until the context is done.
+func (m *Monitor) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("workqueue-health")
+
+	interval := m.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.check(); err != nil {
+			logger.Error(err, "Failed to check workqueues")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check is a healthz.Checker failing while workqueues are saturated.
+func (m *Monitor) Check(_ *http.Request) error {
+	if saturated := m.Saturated(); len(saturated) > 0 {
+		return fmt.Errorf("%w: %s", ErrQueuesSaturated, strings.Join(saturated, ", "))
+	}
+
+	return nil
+}
+
+// Saturated returns the sorted names of the saturated workqueues, which are the names of their controllers.
+func (m *Monitor) Saturated() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return slices.Sorted(maps.Keys(m.saturated))
+}
+
+// Condition returns the QueueSaturated condition, True while workqueues are saturated.
+// The condition has no transition time nor generation, it is meant to be applied with conditions.Set.
+func (m *Monitor) Condition() metav1.Condition {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if len(m.saturated) == 0 {
+		return metav1.Condition{Type: ConditionType, Status: metav1.ConditionFalse, Reason: conditions.ReasonAsExpected}
+	}
+
+	messages := make([]string, 0, len(m.saturated))
+	for _, name := range slices.Sorted(maps.Keys(m.saturated)) {
+		messages = append(messages, fmt.Sprintf("%s: %s", name, m.saturated[name]))
+	}
+
+	return metav1.Condition{
+		Type:    ConditionType,
+		Status:  metav1.ConditionTrue,
+		Reason:  ReasonSaturated,
+		Message: strings.Join(messages, "; "),
+	}
+}
+
+// check gathers the workqueue metrics and updates the saturated workqueues.
+func (m *Monitor) check() error {
+	gatherer := m.Gatherer
+	if gatherer == nil {
+		gatherer = metrics.Registry
+	}
+
+	families, err := gatherer.Gather()
+	if err != nil {
+		return fmt.Errorf("failed to gather workqueue metrics: %w", err)
+	}
+
+	depths := map[string]float64{}
+	latencies := map[string]histogramTotals{}
+	processingTimes := map[string]float64{}
+
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			name := labelValue(metric, "name")
+
+			switch family.GetName() {
+			case depthMetric:
+				// Priority queues report their depth by priority.
+				depths[name] += metric.GetGauge().GetValue()
+			case queueLatencyMetric:
+				latencies[name] = histogramTotals{sum: metric.GetHistogram().GetSampleSum(), count: metric.GetHistogram().GetSampleCount()}
+			case longestRunningProcessorMetric:
+				processingTimes[name] = metric.GetGauge().GetValue()
+			}
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.clock()
+
+	sustainedFor := m.SustainedFor
+	if sustainedFor <= 0 {
+		sustainedFor = DefaultSustainedFor
+	}
+
+	if m.exceeding == nil {
+		m.exceeding = map[string]time.Time{}
+		m.saturated = map[string]string{}
+	}
+
+	for name, depth := range depths {
+		var exceeded []string
+
+		if m.MaxDepth > 0 && depth > m.MaxDepth {
+			exceeded = append(exceeded, fmt.Sprintf("depth %.0f above %.0f", depth, m.MaxDepth))
+		}
+
+		if previous, ok := m.lastLatency[name]; ok && m.MaxQueueLatency > 0 && latencies[name].count > previous.count {
+			latency := (latencies[name].sum - previous.sum) / float64(latencies[name].count-previous.count)
+			if latency > m.MaxQueueLatency.Seconds() {
+				exceeded = append(exceeded, fmt.Sprintf("queue latency %.1fs above %s", latency, m.MaxQueueLatency))
+			}
+		}
+
+		if m.MaxProcessingTime > 0 && processingTimes[name] > m.MaxProcessingTime.Seconds() {
+			exceeded = append(exceeded, fmt.Sprintf("processing time %.1fs above %s", processingTimes[name], m.MaxProcessingTime))
+		}
+
+		if len(exceeded) == 0 {
+			delete(m.exceeding, name)
+			delete(m.saturated, name)
+
+			continue
+		}
+
+		if _, ok := m.exceeding[name]; !ok {
+			m.exceeding[name] = now
+		}
+
+		if now.Sub(m.exceeding[name]) >= sustainedFor {
+			m.saturated[name] = strings.Join(exceeded, ", ")
+		}
+	}
+
+	m.lastLatency = latencies
+
+	return nil
+}
+
+func (m *Monitor) clock() time.Time {
+	if m.now != nil {
+		return m.now()
+	}
+
+	return time.Now()
+}
+
+// labelValue returns the value of the label of the metric.
+func labelValue(metric *dto.Metric, name string) string {
+	for _, label := range metric.GetLabel() {
+		if label.GetName() == name {
+			return label.GetValue()
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queuehealth
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Monitor", func() {
+	var (
+		monitor        *Monitor
+		now            time.Time
+		depth          *prometheus.GaugeVec
+		latency        *prometheus.HistogramVec
+		longestRunning *prometheus.GaugeVec
+	)
+
+	BeforeEach(func() {
+		registry := prometheus.NewRegistry()
+
+		depth = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: depthMetric, Help: "Depth."},
+			[]string{"name", "controller", "priority"})
+		latency = prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: queueLatencyMetric, Help: "Latency."},
+			[]string{"name", "controller"})
+		longestRunning = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: longestRunningProcessorMetric, Help: "Longest."},
+			[]string{"name", "controller"})
+		registry.MustRegister(depth, latency, longestRunning)
+
+		depth.WithLabelValues("operand", "operand", "").Set(0)
+		depth.WithLabelValues("other", "other", "").Set(0)
+
+		now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		monitor = &Monitor{
+			MaxDepth:          100,
+			MaxQueueLatency:   10 * time.Second,
+			MaxProcessingTime: time.Minute,
+			SustainedFor:      time.Minute,
+			Gatherer:          registry,
+			now:               func() time.Time { return now },
+		}
+	})
+
+	It("should report workqueues exceeding a threshold for the sustained period", func() {
+		Expect(monitor.check()).To(Succeed())
+		Expect(monitor.Check(nil)).To(Succeed())
+		Expect(monitor.Condition()).To(Equal(metav1.Condition{Type: ConditionType, Status: metav1.ConditionFalse, Reason: "AsExpected"}))
+
+		depth.WithLabelValues("operand", "operand", "").Set(80)
+		depth.WithLabelValues("operand", "operand", "1").Set(30)
+		Expect(monitor.check()).To(Succeed())
+		Expect(monitor.Saturated()).To(BeEmpty())
+
+		now = now.Add(time.Minute)
+		Expect(monitor.check()).To(Succeed())
+		Expect(monitor.Saturated()).To(Equal([]string{"operand"}))
+		Expect(monitor.Check(nil)).To(MatchError(ErrQueuesSaturated))
+
+		condition := monitor.Condition()
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(ReasonSaturated))
+		Expect(condition.Message).To(Equal("operand: depth 110 above 100"))
+
+		depth.WithLabelValues("operand", "operand", "1").Set(0)
+		Expect(monitor.check()).To(Succeed())
+		Expect(monitor.Saturated()).To(BeEmpty())
+	})
+
+	It("should report the latency and processing time since the last check", func() {
+		latency.WithLabelValues("other", "other").Observe(60)
+		Expect(monitor.check()).To(Succeed())
+
+		latency.WithLabelValues("other", "other").Observe(20)
+		latency.WithLabelValues("other", "other").Observe(30)
+		longestRunning.WithLabelValues("other", "other").Set(90)
+		Expect(monitor.check()).To(Succeed())
+
+		now = now.Add(time.Minute)
+		latency.WithLabelValues("other", "other").Observe(15)
+		Expect(monitor.check()).To(Succeed())
+
+		Expect(monitor.Condition().Message).To(Equal("other: queue latency 15.0s above 10s, processing time 90.0s above 1m0s"))
+	})
+})
@@ -0,0 +1,63 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// BaseClientQPS is the client QPS of an operator before accounting for its controllers.
+	BaseClientQPS = 20
+	// ClientQPSPerController is the client QPS added for each controller of the operator.
+	ClientQPSPerController = 5
+	// MaxClientQPS caps the client QPS, leaving the fair sharing of the API server to priority and fairness.
+	MaxClientQPS = 200
+	// ClientBurstFactor is the ratio of the client burst to the client QPS.
+	ClientBurstFactor = 2
+)
+
+// ClientRateLimits returns the client QPS and burst of an operator running the given number of controllers, scaling
+// from BaseClientQPS by ClientQPSPerController up to MaxClientQPS. The client-go defaults of 5 QPS and a burst of 10
+// throttle operators running several controllers on large clusters, while the API server protects itself with
+// priority and fairness.
+func ClientRateLimits(controllers int) (float32, int) {
+	qps := BaseClientQPS + ClientQPSPerController*max(controllers, 0)
+	qps = min(qps, MaxClientQPS)
+
+	return float32(qps), qps * ClientBurstFactor
+}
+
+// ConfigureClient sets the QPS and burst of cfg for an operator running the given number of controllers, unless
+// they are already set.
+//
+// Example:
+//
+//	cfg := ctrl.GetConfigOrDie()
+//	ratelimit.ConfigureClient(cfg, 4)
+//	mgr, err := ctrl.NewManager(cfg, options)
+func ConfigureClient(cfg *rest.Config, controllers int) {
+	qps, burst := ClientRateLimits(controllers)
+
+	if cfg.QPS == 0 {
+		cfg.QPS = qps
+	}
+
+	if cfg.Burst == 0 {
+		cfg.Burst = burst
+	}
+}
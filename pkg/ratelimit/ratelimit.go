@@ -0,0 +1,75 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ratelimit provides the workqueue rate limiters and client-side rate limits of OpenShift operators, tuned for
+// large clusters, instead of magic numbers copied across operators.
+package ratelimit
+
+import (
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Preset configures a workqueue rate limiter, delaying the retries of each item exponentially up to a cap, and all
+// retries together with a token bucket.
+type Preset struct {
+	// BaseDelay is the delay of the first retry of an item, doubled at every failure.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay of the retries of an item.
+	MaxDelay time.Duration
+
+	// QPS is the overall rate of retries.
+	QPS float64
+	// Burst is the number of retries allowed above QPS at once.
+	Burst int
+}
+
+var (
+	// ControllerRuntimeDefault is the controller-runtime default: retries from 5ms to 1000s, at 10 per second overall.
+	ControllerRuntimeDefault = Preset{BaseDelay: 5 * time.Millisecond, MaxDelay: 1000 * time.Second, QPS: 10, Burst: 100}
+
+	// LargeCluster suits controllers of objects numbering in the thousands: failing items are retried at least every
+	// 5 minutes rather than after more than 16, and the overall rate lets a resync of every object drain quickly,
+	// while the exponential delays keep persistently failing items from hammering the API server.
+	LargeCluster = Preset{BaseDelay: 10 * time.Millisecond, MaxDelay: 5 * time.Minute, QPS: 50, Burst: 500}
+
+	// SlowRetry suits controllers whose failures are not resolved quickly, e.g. waiting on cloud resources: retries
+	// start after a second and are capped at 10 minutes.
+	SlowRetry = Preset{BaseDelay: time.Second, MaxDelay: 10 * time.Minute, QPS: 10, Burst: 100}
+)
+
+// New returns the workqueue rate limiter of the preset.
+//
+// Example:
+//
+//	err := ctrl.NewControllerManagedBy(mgr).
+//	    For(&v1alpha1.Operand{}).
+//	    WithOptions(controller.Options{RateLimiter: ratelimit.New[reconcile.Request](ratelimit.LargeCluster)}).
+//	    Complete(r)
+func New[T comparable](preset Preset) workqueue.TypedRateLimiter[T] {
+	return workqueue.NewTypedMaxOfRateLimiter(
+		workqueue.NewTypedItemExponentialFailureRateLimiter[T](preset.BaseDelay, preset.MaxDelay),
+		&workqueue.TypedBucketRateLimiter[T]{Limiter: rate.NewLimiter(rate.Limit(preset.QPS), preset.Burst)},
+	)
+}
+
+// ForRequests returns the workqueue rate limiter of the preset for the requests of controllers.
+func ForRequests(preset Preset) workqueue.TypedRateLimiter[reconcile.Request] {
+	return New[reconcile.Request](preset)
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ratelimit
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("New", func() {
+	It("should delay the retries of an item exponentially up to the cap", func() {
+		limiter := New[string](Preset{BaseDelay: time.Second, MaxDelay: 3 * time.Second, QPS: 1000, Burst: 1000})
+
+		Expect(limiter.When("item")).To(Equal(time.Second))
+		Expect(limiter.When("item")).To(Equal(2 * time.Second))
+		Expect(limiter.When("item")).To(Equal(3 * time.Second))
+		Expect(limiter.NumRequeues("item")).To(Equal(3))
+
+		limiter.Forget("item")
+		Expect(limiter.When("item")).To(Equal(time.Second))
+	})
+
+	It("should limit the overall rate of retries", func() {
+		limiter := ForRequests(Preset{BaseDelay: time.Millisecond, MaxDelay: time.Millisecond, QPS: 1, Burst: 1})
+
+		Expect(limiter.When(reconcile.Request{})).To(Equal(time.Millisecond))
+		Expect(limiter.When(reconcile.Request{})).To(BeNumerically(">", 500*time.Millisecond))
+	})
+})
+
+var _ = Describe("ClientRateLimits", func() {
+	DescribeTable("should scale with the number of controllers",
+		func(controllers int, qps float32, burst int) {
+			actualQPS, actualBurst := ClientRateLimits(controllers)
+			Expect(actualQPS).To(Equal(qps))
+			Expect(actualBurst).To(Equal(burst))
+		},
+		Entry("no controller", 0, float32(20), 40),
+		Entry("several controllers", 4, float32(40), 80),
+		Entry("many controllers", 100, float32(200), 400),
+	)
+
+	It("should not override the configured limits", func() {
+		cfg := &rest.Config{QPS: 7}
+		ConfigureClient(cfg, 2)
+		Expect(cfg.QPS).To(Equal(float32(7)))
+		Expect(cfg.Burst).To(Equal(60))
+	})
+})
@@ -0,0 +1,122 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package reconcileerr provides errors that tell how a failed reconciliation is reported in status and retried,
+// and a reconciler applying that policy, so that individual reconcilers only return errors.
+package reconcileerr
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// requeueAfterError asks for the reconciliation to be retried after a delay rather than with backoff.
+type requeueAfterError struct {
+	after time.Duration
+	err   error
+}
+
+func (e *requeueAfterError) Error() string {
+	if e.err == nil {
+		return fmt.Sprintf("requeue after %s", e.after)
+	}
+
+	return e.err.Error()
+}
+
+func (e *requeueAfterError) Unwrap() error {
+	return e.err
+}
+
+// degradedError reports the failure in the Degraded condition with a specific reason.
+type degradedError struct {
+	reason string
+	err    error
+}
+
+func (e *degradedError) Error() string {
+	if e.err == nil {
+		return e.reason
+	}
+
+	return e.err.Error()
+}
+
+func (e *degradedError) Unwrap() error {
+	return e.err
+}
+
+// Terminal marks err as not worth retrying, e.g. an invalid spec, until the object changes.
+// It is controller-runtime's reconcile.TerminalError, which controllers do not requeue.
+func Terminal(err error) error {
+	return reconcile.TerminalError(err)
+}
+
+// RequeueAfter asks for the reconciliation to be retried after the given delay rather than with the controller's
+// backoff, e.g. while waiting for a dependency. err describes what is awaited and may be nil.
+// It does not mark the object Degraded on its own.
+func RequeueAfter(after time.Duration, err error) error {
+	return &requeueAfterError{after: after, err: err}
+}
+
+// Degraded reports err in the Degraded condition with the given CamelCase reason.
+func Degraded(reason string, err error) error {
+	return &degradedError{reason: reason, err: err}
+}
+
+// IsTerminal reports whether err, or an error it wraps, was marked with Terminal.
+func IsTerminal(err error) bool {
+	return err != nil && errors.Is(err, reconcile.TerminalError(nil))
+}
+
+// RequeueAfterOf returns the delay requested with RequeueAfter by err or an error it wraps.
+func RequeueAfterOf(err error) (time.Duration, bool) {
+	var requeueErr *requeueAfterError
+	if errors.As(err, &requeueErr) {
+		return requeueErr.after, true
+	}
+
+	return 0, false
+}
+
+// DegradedReasonOf returns the reason given with Degraded to err or an error it wraps.
+func DegradedReasonOf(err error) (string, bool) {
+	var degradedErr *degradedError
+	if errors.As(err, &degradedErr) {
+		return degradedErr.reason, true
+	}
+
+	return "", false
+}
+
+// ToResult converts the error of a reconciliation into the result and error to return to controller-runtime.
+// Errors from RequeueAfter become a delayed requeue without error, so they are neither logged as failures
+// nor subject to backoff. Other errors, including terminal ones, are returned as is.
+func ToResult(err error) (ctrl.Result, error) {
+	if err == nil {
+		return ctrl.Result{}, nil
+	}
+
+	if after, ok := RequeueAfterOf(err); ok && !IsTerminal(err) {
+		return ctrl.Result{RequeueAfter: after}, nil
+	}
+
+	return ctrl.Result{}, err
+}
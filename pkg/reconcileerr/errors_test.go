@@ -0,0 +1,66 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcileerr
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+var _ = Describe("Errors", func() {
+	cause := errors.New("dependency not ready")
+
+	It("should classify wrapped errors", func() {
+		err := fmt.Errorf("reconciling operand: %w", Degraded("DependencyMissing", RequeueAfter(time.Minute, cause)))
+
+		Expect(err).To(MatchError(cause))
+		Expect(IsTerminal(err)).To(BeFalse())
+
+		after, ok := RequeueAfterOf(err)
+		Expect(ok).To(BeTrue())
+		Expect(after).To(Equal(time.Minute))
+
+		reason, ok := DegradedReasonOf(err)
+		Expect(ok).To(BeTrue())
+		Expect(reason).To(Equal("DependencyMissing"))
+
+		Expect(IsTerminal(fmt.Errorf("invalid spec: %w", Terminal(cause)))).To(BeTrue())
+		Expect(IsTerminal(nil)).To(BeFalse())
+	})
+
+	It("should convert errors to results", func() {
+		result, err := ToResult(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{}))
+
+		result, err = ToResult(RequeueAfter(30*time.Second, nil))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{RequeueAfter: 30 * time.Second}))
+
+		result, err = ToResult(Terminal(RequeueAfter(time.Second, cause)))
+		Expect(err).To(MatchError(cause))
+		Expect(result).To(Equal(ctrl.Result{}))
+
+		_, err = ToResult(cause)
+		Expect(err).To(Equal(cause))
+	})
+})
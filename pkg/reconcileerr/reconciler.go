@@ -0,0 +1,146 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcileerr
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openshift/controller-runtime-common/pkg/conditions"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// ObjectReconciler reconciles a single object, which it may modify, including its status.
+type ObjectReconciler[T client.Object] interface {
+	Reconcile(ctx context.Context, obj T) error
+}
+
+// ObjectReconcilerFunc is a function implementing ObjectReconciler.
+type ObjectReconcilerFunc[T client.Object] func(ctx context.Context, obj T) error
+
+// Reconcile implements ObjectReconciler.
+func (f ObjectReconcilerFunc[T]) Reconcile(ctx context.Context, obj T) error {
+	return f(ctx, obj)
+}
+
+// Reconciler fetches the object of a request, calls the object reconciler, and applies the error policy:
+// the error is reported in the Degraded condition of the object, which is cleared on success,
+// the status is updated when it changed, and the error is converted with ToResult.
+//
+// Errors are reported with the reason given to Degraded, or conditions.ReasonReconcileError.
+// Errors from RequeueAfter that are not also Degraded leave the object not degraded.
+//
+// Example:
+//
+//	r := &reconcileerr.Reconciler[*v1alpha1.Operand]{
+//	    Client:     mgr.GetClient(),
+//	    NewObject:  func() *v1alpha1.Operand { return &v1alpha1.Operand{} },
+//	    Conditions: func(o *v1alpha1.Operand) *[]metav1.Condition { return &o.Status.Conditions },
+//	    Object:     operandReconciler,
+//	}
+type Reconciler[T client.Object] struct {
+	Client client.Client
+
+	// NewObject returns an empty object of the reconciled kind.
+	NewObject func() T
+
+	// Conditions returns the conditions of the status of the object.
+	Conditions func(obj T) *[]metav1.Condition
+
+	// Object reconciles the fetched object.
+	Object ObjectReconciler[T]
+
+	// ConditionType is the type of the condition the errors are reported in. Defaults to conditions.TypeDegraded.
+	ConditionType string
+}
+
+// Reconcile implements reconcile.Reconciler.
+func (r *Reconciler[T]) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	obj := r.NewObject()
+	if err := r.Client.Get(ctx, req.NamespacedName, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to get %T %s: %w", obj, req.NamespacedName.String(), err)
+	}
+
+	if !obj.GetDeletionTimestamp().IsZero() {
+		// Deleted objects are left to the object reconciler, their status is not updated.
+		return ToResult(r.Object.Reconcile(ctx, obj))
+	}
+
+	original, ok := obj.DeepCopyObject().(T)
+	if !ok {
+		return ctrl.Result{}, fmt.Errorf("unexpected copy of %T", obj)
+	}
+
+	reconcileErr := r.Object.Reconcile(ctx, obj)
+
+	r.condition(reconcileErr).Apply(r.Conditions(obj), obj.GetGeneration())
+
+	if !equality.Semantic.DeepEqual(original, obj) {
+		if err := r.Client.Status().Update(ctx, obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				return ToResult(reconcileErr)
+			}
+
+			statusErr := fmt.Errorf("failed to update status of %T %s: %w", obj, req.NamespacedName.String(), err)
+			if reconcileErr == nil {
+				return ctrl.Result{}, statusErr
+			}
+
+			// The reconcile error is only kept as text, so that its terminal marker does not prevent retrying the
+			// status update.
+			return ctrl.Result{}, fmt.Errorf("%w, after reconciliation failed: %v", statusErr, reconcileErr)
+		}
+	}
+
+	if reconcileErr != nil && !IsTerminal(reconcileErr) {
+		log.FromContext(ctx).V(1).Info("Reconciliation failed", "error", reconcileErr.Error())
+	}
+
+	return ToResult(reconcileErr)
+}
+
+// condition returns the condition reporting the error of a reconciliation.
+func (r *Reconciler[T]) condition(err error) *conditions.Builder {
+	conditionType := r.ConditionType
+	if conditionType == "" {
+		conditionType = conditions.TypeDegraded
+	}
+
+	if err == nil {
+		return conditions.New(conditionType, metav1.ConditionFalse, conditions.ReasonAsExpected)
+	}
+
+	reason, degraded := DegradedReasonOf(err)
+	if !degraded {
+		if _, requeue := RequeueAfterOf(err); requeue && !IsTerminal(err) {
+			return conditions.New(conditionType, metav1.ConditionFalse, conditions.ReasonAsExpected)
+		}
+
+		reason = conditions.ReasonReconcileError
+	}
+
+	return conditions.New(conditionType, metav1.ConditionTrue, reason).Because(err)
+}
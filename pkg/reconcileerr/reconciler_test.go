@@ -0,0 +1,137 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconcileerr
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/controller-runtime-common/pkg/conditions"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+// ValidatingAdmissionPolicies are used as the reconciled objects, as their status holds metav1.Conditions.
+var _ = Describe("Reconciler", func() {
+	var (
+		fakeClient   client.Client
+		reconcileErr error
+		reconciler   *Reconciler[*admissionregistrationv1.ValidatingAdmissionPolicy]
+	)
+
+	key := client.ObjectKey{Name: "policy"}
+	req := ctrl.Request{NamespacedName: key}
+
+	degradedCondition := func() *metav1.Condition {
+		GinkgoHelper()
+
+		policy := &admissionregistrationv1.ValidatingAdmissionPolicy{}
+		Expect(fakeClient.Get(ctx, key, policy)).To(Succeed())
+
+		return conditions.Get(policy.Status.Conditions, conditions.TypeDegraded)
+	}
+
+	BeforeEach(func() {
+		policy := &admissionregistrationv1.ValidatingAdmissionPolicy{ObjectMeta: metav1.ObjectMeta{Name: key.Name, Generation: 4}}
+		fakeClient = fake.NewClientBuilder().WithObjects(policy).WithStatusSubresource(policy).Build()
+
+		reconcileErr = nil
+		reconciler = &Reconciler[*admissionregistrationv1.ValidatingAdmissionPolicy]{
+			Client: fakeClient,
+			NewObject: func() *admissionregistrationv1.ValidatingAdmissionPolicy {
+				return &admissionregistrationv1.ValidatingAdmissionPolicy{}
+			},
+			Conditions: func(p *admissionregistrationv1.ValidatingAdmissionPolicy) *[]metav1.Condition {
+				return &p.Status.Conditions
+			},
+			Object: ObjectReconcilerFunc[*admissionregistrationv1.ValidatingAdmissionPolicy](
+				func(_ context.Context, p *admissionregistrationv1.ValidatingAdmissionPolicy) error {
+					p.Status.ObservedGeneration = p.Generation
+					return reconcileErr
+				}),
+		}
+	})
+
+	It("should clear the Degraded condition on success and update the status", func() {
+		result, err := reconciler.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{}))
+
+		condition := degradedCondition()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.ObservedGeneration).To(BeEquivalentTo(4))
+	})
+
+	It("should report errors in the Degraded condition with their reason", func() {
+		reconcileErr = Degraded("CertInvalid", errors.New("expired"))
+		_, err := reconciler.Reconcile(ctx, req)
+		Expect(err).To(MatchError("expired"))
+
+		condition := degradedCondition()
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(Equal("CertInvalid"))
+		Expect(condition.Message).To(Equal("expired"))
+
+		reconcileErr = errors.New("boom")
+		_, err = reconciler.Reconcile(ctx, req)
+		Expect(err).To(HaveOccurred())
+		Expect(degradedCondition().Reason).To(Equal(conditions.ReasonReconcileError))
+	})
+
+	It("should requeue without reporting the object degraded", func() {
+		reconcileErr = RequeueAfter(time.Minute, errors.New("waiting"))
+		result, err := reconciler.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(time.Minute))
+		Expect(degradedCondition().Status).To(Equal(metav1.ConditionFalse))
+	})
+
+	It("should return terminal errors", func() {
+		reconcileErr = Terminal(errors.New("invalid spec"))
+		_, err := reconciler.Reconcile(ctx, req)
+		Expect(IsTerminal(err)).To(BeTrue())
+		Expect(degradedCondition().Status).To(Equal(metav1.ConditionTrue))
+	})
+
+	It("should retry failed status updates after terminal errors", func() {
+		reconciler.Client = interceptor.NewClient(fakeClient.(client.WithWatch), interceptor.Funcs{
+			SubResourceUpdate: func(context.Context, client.Client, string, client.Object, ...client.SubResourceUpdateOption) error {
+				return errors.New("conflict")
+			},
+		})
+
+		reconcileErr = Terminal(errors.New("invalid spec"))
+		_, err := reconciler.Reconcile(ctx, req)
+		Expect(err).To(MatchError(ContainSubstring("conflict")))
+		Expect(err).To(MatchError(ContainSubstring("invalid spec")))
+		Expect(IsTerminal(err)).To(BeFalse())
+	})
+
+	It("should ignore missing objects", func() {
+		result, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKey{Name: "missing"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{}))
+	})
+})
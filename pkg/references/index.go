@@ -0,0 +1,202 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package references provides a reverse index of the objects referenced by reconciled resources, such as the Secrets
+// and ConfigMaps they mount or other custom resources, to reconcile the referencing resources when the referenced
+// objects change, without a field indexer per reference, and resolves references into the objects they reference.
+package references
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Reference identifies a referenced object.
+type Reference struct {
+	Group     string
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// SecretReference returns the reference to the Secret with the given namespace and name.
+func SecretReference(namespace, name string) Reference {
+	return Reference{Kind: "Secret", Namespace: namespace, Name: name}
+}
+
+// ConfigMapReference returns the reference to the ConfigMap with the given namespace and name.
+func ConfigMapReference(namespace, name string) Reference {
+	return Reference{Kind: "ConfigMap", Namespace: namespace, Name: name}
+}
+
+// ReferenceTo returns the reference to the object of the given kind, namespace and name, e.g. a custom resource.
+func ReferenceTo(groupKind schema.GroupKind, namespace, name string) Reference {
+	return Reference{Group: groupKind.Group, Kind: groupKind.Kind, Namespace: namespace, Name: name}
+}
+
+// ReferenceOf returns the reference to obj, whose kind is resolved with the scheme unless it is set on obj,
+// as on unstructured objects.
+func ReferenceOf(obj client.Object, scheme *runtime.Scheme) (Reference, error) {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	if gvk.Kind == "" {
+		var err error
+		if gvk, err = apiutil.GVKForObject(obj, scheme); err != nil {
+			return Reference{}, fmt.Errorf("failed to get the kind of %T: %w", obj, err)
+		}
+	}
+
+	return ReferenceTo(gvk.GroupKind(), obj.GetNamespace(), obj.GetName()), nil
+}
+
+// Index is a reverse index of the objects referenced by the resources reconciled by a controller. Reconcilers declare
+// the objects each resource references, of any kind, and the index maps the events of the referenced objects to
+// requests for the referencing resources. It is safe for concurrent use.
+//
+// The index is kept in memory, so it is only complete once every resource was reconciled since the start of the
+// process, which controllers do on start.
+//
+// Example:
+//
+//	index := &references.Index{Scheme: mgr.GetScheme()}
+//	err := ctrl.NewControllerManagedBy(mgr).
+//	    For(&v1alpha1.Operand{}).
+//	    Watches(&corev1.Secret{}, index.EnqueueReferrers(), builder.WithPredicates(predicates.ContentChanged())).
+//	    Watches(&v1alpha1.Backend{}, index.EnqueueReferrers()).
+//	    Complete(r)
+//
+//	// In Reconcile:
+//	index.SetReferences(req.NamespacedName,
+//	    references.SecretReference(req.Namespace, operand.Spec.SecretName),
+//	    references.ReferenceTo(v1alpha1.GroupVersion.WithKind("Backend").GroupKind(), req.Namespace, operand.Spec.Backend),
+//	)
+type Index struct {
+	// Scheme resolves the kinds of the referenced objects of events. Defaults to the client-go scheme,
+	// which only knows the built-in kinds.
+	Scheme *runtime.Scheme
+
+	mu         sync.RWMutex
+	referrers  map[Reference]map[types.NamespacedName]struct{}
+	references map[types.NamespacedName][]Reference
+}
+
+// SetReferences records the objects referenced by the referrer, replacing those recorded before.
+func (i *Index) SetReferences(referrer types.NamespacedName, references ...Reference) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.remove(referrer)
+
+	if len(references) == 0 {
+		return
+	}
+
+	if i.referrers == nil {
+		i.referrers = map[Reference]map[types.NamespacedName]struct{}{}
+		i.references = map[types.NamespacedName][]Reference{}
+	}
+
+	for _, reference := range references {
+		if i.referrers[reference] == nil {
+			i.referrers[reference] = map[types.NamespacedName]struct{}{}
+		}
+
+		i.referrers[reference][referrer] = struct{}{}
+	}
+
+	i.references[referrer] = slices.Clone(references)
+}
+
+// RemoveReferrer forgets the objects referenced by the referrer, e.g. once it is deleted.
+func (i *Index) RemoveReferrer(referrer types.NamespacedName) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.remove(referrer)
+}
+
+// Referrers returns the sorted referrers of the referenced object.
+func (i *Index) Referrers(reference Reference) []types.NamespacedName {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	referrers := make([]types.NamespacedName, 0, len(i.referrers[reference]))
+	for referrer := range i.referrers[reference] {
+		referrers = append(referrers, referrer)
+	}
+
+	slices.SortFunc(referrers, func(a, b types.NamespacedName) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+
+	return referrers
+}
+
+// MapFunc returns a function mapping a referenced object to requests for its referrers.
+func (i *Index) MapFunc() handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		scheme := i.Scheme
+		if scheme == nil {
+			scheme = clientgoscheme.Scheme
+		}
+
+		reference, err := ReferenceOf(obj, scheme)
+		if err != nil {
+			log.FromContext(ctx).Error(err, "Failed to map referenced object to its referrers")
+
+			return nil
+		}
+
+		referrers := i.Referrers(reference)
+
+		requests := make([]reconcile.Request, 0, len(referrers))
+		for _, referrer := range referrers {
+			requests = append(requests, reconcile.Request{NamespacedName: referrer})
+		}
+
+		return requests
+	}
+}
+
+// EnqueueReferrers returns an event handler enqueuing the referrers of the objects of its events.
+func (i *Index) EnqueueReferrers() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(i.MapFunc())
+}
+
+// remove forgets the references of the referrer. The lock must be held.
+func (i *Index) remove(referrer types.NamespacedName) {
+	for _, reference := range i.references[referrer] {
+		delete(i.referrers[reference], referrer)
+
+		if len(i.referrers[reference]) == 0 {
+			delete(i.referrers, reference)
+		}
+	}
+
+	delete(i.references, referrer)
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package references
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("Index", func() {
+	var index *Index
+
+	first := types.NamespacedName{Namespace: "ns", Name: "first"}
+	second := types.NamespacedName{Namespace: "ns", Name: "second"}
+
+	BeforeEach(func() {
+		index = &Index{}
+	})
+
+	It("should return the referrers of an object", func() {
+		index.SetReferences(second, SecretReference("ns", "tls"), ConfigMapReference("ns", "config"))
+		index.SetReferences(first, SecretReference("ns", "tls"))
+
+		Expect(index.Referrers(SecretReference("ns", "tls"))).To(Equal([]types.NamespacedName{first, second}))
+		Expect(index.Referrers(ConfigMapReference("ns", "config"))).To(Equal([]types.NamespacedName{second}))
+		Expect(index.Referrers(ConfigMapReference("ns", "tls"))).To(BeEmpty())
+	})
+
+	It("should replace and remove the references of a referrer", func() {
+		index.SetReferences(first, SecretReference("ns", "tls"))
+		index.SetReferences(first, SecretReference("ns", "other"))
+
+		Expect(index.Referrers(SecretReference("ns", "tls"))).To(BeEmpty())
+		Expect(index.Referrers(SecretReference("ns", "other"))).To(Equal([]types.NamespacedName{first}))
+
+		index.RemoveReferrer(first)
+		Expect(index.Referrers(SecretReference("ns", "other"))).To(BeEmpty())
+		Expect(index.referrers).To(BeEmpty())
+	})
+
+	It("should enqueue the referrers of changed objects", func() {
+		index.SetReferences(first, SecretReference("ns", "tls"))
+		index.SetReferences(second, ConfigMapReference("ns", "tls"))
+
+		queue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+		DeferCleanup(queue.ShutDown)
+
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "tls"}}
+		index.EnqueueReferrers().Update(context.Background(), event.UpdateEvent{ObjectOld: secret, ObjectNew: secret}, queue)
+
+		Expect(queue.Len()).To(Equal(1))
+
+		request, _ := queue.Get()
+		Expect(request).To(Equal(reconcile.Request{NamespacedName: first}))
+	})
+
+	It("should map custom resources to their referrers", func() {
+		scheme := runtime.NewScheme()
+		Expect(configv1.AddToScheme(scheme)).To(Succeed())
+
+		index.Scheme = scheme
+		index.SetReferences(first, ReferenceTo(schema.GroupKind{Group: configv1.GroupName, Kind: "Proxy"}, "", "cluster"))
+
+		proxy := &configv1.Proxy{ObjectMeta: metav1.ObjectMeta{Name: "cluster"}}
+		Expect(index.MapFunc()(context.Background(), proxy)).To(Equal([]reconcile.Request{{NamespacedName: first}}))
+
+		unstructuredProxy := &unstructured.Unstructured{}
+		unstructuredProxy.SetGroupVersionKind(configv1.GroupVersion.WithKind("Proxy"))
+		unstructuredProxy.SetName("cluster")
+		Expect(index.MapFunc()(context.Background(), unstructuredProxy)).To(Equal([]reconcile.Request{{NamespacedName: first}}))
+
+		Expect(index.MapFunc()(context.Background(), &corev1.Secret{})).To(BeEmpty())
+	})
+
+	It("should not map objects of unknown kinds", func() {
+		index.SetReferences(first, ReferenceTo(schema.GroupKind{Group: configv1.GroupName, Kind: "Proxy"}, "", "cluster"))
+
+		Expect(index.MapFunc()(context.Background(), &configv1.Proxy{ObjectMeta: metav1.ObjectMeta{Name: "cluster"}})).To(BeEmpty())
+	})
+})
@@ -0,0 +1,178 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package references
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/controller-runtime-common/pkg/reconcileerr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ReasonReferenceNotFound is the Degraded reason of the errors of references to objects that do not exist.
+	ReasonReferenceNotFound = "ReferenceNotFound"
+	// ReasonInvalidReference is the Degraded reason of the errors of invalid references, e.g. without name, or to
+	// objects missing required keys.
+	ReasonInvalidReference = "InvalidReference"
+)
+
+var (
+	// ErrReferenceNotFound is wrapped by the errors of references to objects that do not exist.
+	ErrReferenceNotFound = errors.New("referenced object not found")
+	// ErrInvalidReference is wrapped by the errors of invalid references.
+	ErrInvalidReference = errors.New("invalid reference")
+)
+
+// ResolveConfigMap returns the ConfigMap referenced in the namespace, checking that it has the given keys in its data
+// or binary data.
+//
+// The referenced objects are fetched with reader, which is the manager's client for cached lookups, or its APIReader
+// for uncached ones, e.g. for objects of namespaces that are not cached. The errors of references to objects that do
+// not exist wrap ErrReferenceNotFound and the NotFound error, and those of invalid references wrap
+// ErrInvalidReference; both are reconcileerr.Degraded errors with the ReasonReferenceNotFound and
+// ReasonInvalidReference reasons, so that they are reported consistently across operators.
+//
+// Example:
+//
+//	configMap, err := references.ResolveConfigMap(ctx, r.Client, "openshift-config", apiServer.Spec.ClientCA, "ca-bundle.crt")
+//	if err != nil {
+//	    return err
+//	}
+func ResolveConfigMap(ctx context.Context, reader client.Reader, namespace string, ref configv1.ConfigMapNameReference, keys ...string) (*corev1.ConfigMap, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := resolve(ctx, reader, client.ObjectKey{Namespace: namespace, Name: ref.Name}, configMap); err != nil {
+		return nil, err
+	}
+
+	for _, key := range keys {
+		_, inData := configMap.Data[key]
+		_, inBinaryData := configMap.BinaryData[key]
+
+		if !inData && !inBinaryData {
+			return nil, invalid(fmt.Errorf("ConfigMap %s has no key %q", client.ObjectKeyFromObject(configMap).String(), key))
+		}
+	}
+
+	return configMap, nil
+}
+
+// ResolveSecret returns the Secret referenced in the namespace, checking that it has the given keys in its data.
+// It is fetched and fails like ResolveConfigMap.
+func ResolveSecret(ctx context.Context, reader client.Reader, namespace string, ref configv1.SecretNameReference, keys ...string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	if err := resolve(ctx, reader, client.ObjectKey{Namespace: namespace, Name: ref.Name}, secret); err != nil {
+		return nil, err
+	}
+
+	for _, key := range keys {
+		if _, ok := secret.Data[key]; !ok {
+			return nil, invalid(fmt.Errorf("Secret %s has no key %q", client.ObjectKeyFromObject(secret).String(), key))
+		}
+	}
+
+	return secret, nil
+}
+
+// ResolveObjectReference returns the object referenced, typed when its kind is in the scheme and unstructured
+// otherwise. The namespace of the reference defaults to namespace. It is fetched and fails like ResolveConfigMap.
+func ResolveObjectReference(ctx context.Context, reader client.Reader, scheme *runtime.Scheme, namespace string, ref corev1.ObjectReference) (client.Object, error) {
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return nil, invalid(fmt.Errorf("invalid apiVersion %q: %w", ref.APIVersion, err))
+	}
+
+	if ref.Namespace != "" {
+		namespace = ref.Namespace
+	}
+
+	return resolveKind(ctx, reader, scheme, gv.WithKind(ref.Kind), client.ObjectKey{Namespace: namespace, Name: ref.Name})
+}
+
+// ResolveTypedLocalObjectReference returns the object referenced in the namespace, typed when its kind is in the
+// scheme and unstructured otherwise. References without API group resolve to the core group, at its only version v1,
+// while those with an API group resolve to the preferred version of the group in the scheme. It is fetched and fails
+// like ResolveConfigMap.
+func ResolveTypedLocalObjectReference(ctx context.Context, reader client.Reader, scheme *runtime.Scheme, namespace string, ref corev1.TypedLocalObjectReference) (client.Object, error) {
+	gv := corev1.SchemeGroupVersion
+
+	if ref.APIGroup != nil && *ref.APIGroup != "" {
+		versions := scheme.PrioritizedVersionsForGroup(*ref.APIGroup)
+		if len(versions) == 0 {
+			return nil, invalid(fmt.Errorf("API group %q of %s %q is not in the scheme", *ref.APIGroup, ref.Kind, ref.Name))
+		}
+
+		gv = versions[0]
+	}
+
+	return resolveKind(ctx, reader, scheme, gv.WithKind(ref.Kind), client.ObjectKey{Namespace: namespace, Name: ref.Name})
+}
+
+// resolveKind fetches the object of the given kind and key.
+func resolveKind(ctx context.Context, reader client.Reader, scheme *runtime.Scheme, gvk schema.GroupVersionKind, key client.ObjectKey) (client.Object, error) {
+	if gvk.Kind == "" {
+		return nil, invalid(fmt.Errorf("reference to %q has no kind", key.Name))
+	}
+
+	var obj client.Object
+
+	if typed, err := scheme.New(gvk); err == nil {
+		obj, _ = typed.(client.Object)
+	}
+
+	if obj == nil {
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(gvk)
+		obj = u
+	}
+
+	if err := resolve(ctx, reader, key, obj); err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// resolve fetches the object of the given key into obj.
+func resolve(ctx context.Context, reader client.Reader, key client.ObjectKey, obj client.Object) error {
+	if key.Name == "" {
+		return invalid(fmt.Errorf("reference to %T has no name", obj))
+	}
+
+	if err := reader.Get(ctx, key, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcileerr.Degraded(ReasonReferenceNotFound, fmt.Errorf("%w: %w", ErrReferenceNotFound, err))
+		}
+
+		return fmt.Errorf("failed to get referenced object %s: %w", key.String(), err)
+	}
+
+	return nil
+}
+
+// invalid returns the error of an invalid reference.
+func invalid(err error) error {
+	return reconcileerr.Degraded(ReasonInvalidReference, fmt.Errorf("%w: %w", ErrInvalidReference, err))
+}
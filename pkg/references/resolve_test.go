@@ -0,0 +1,152 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package references
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/controller-runtime-common/pkg/reconcileerr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+var _ = Describe("Resolve", func() {
+	var (
+		ctx    context.Context
+		scheme *runtime.Scheme
+		reader client.Reader
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(appsv1.AddToScheme(scheme)).To(Succeed())
+
+		reader = fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-config", Name: "client-ca"},
+				Data:       map[string]string{"ca-bundle.crt": "bundle"},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-config", Name: "serving"},
+				Data:       map[string][]byte{corev1.TLSCertKey: []byte("cert")},
+			},
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "operand"}},
+		).Build()
+	})
+
+	expectReason := func(err error, reason string) {
+		GinkgoHelper()
+
+		actual, ok := reconcileerr.DegradedReasonOf(err)
+		Expect(ok).To(BeTrue())
+		Expect(actual).To(Equal(reason))
+	}
+
+	It("should resolve ConfigMaps and Secrets with their keys", func() {
+		configMap, err := ResolveConfigMap(ctx, reader, "openshift-config", configv1.ConfigMapNameReference{Name: "client-ca"}, "ca-bundle.crt")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(configMap.Data).To(HaveKeyWithValue("ca-bundle.crt", "bundle"))
+
+		secret, err := ResolveSecret(ctx, reader, "openshift-config", configv1.SecretNameReference{Name: "serving"}, corev1.TLSCertKey)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secret.Data).To(HaveKeyWithValue(corev1.TLSCertKey, []byte("cert")))
+	})
+
+	It("should report missing objects and keys", func() {
+		_, err := ResolveSecret(ctx, reader, "openshift-config", configv1.SecretNameReference{Name: "missing"})
+		Expect(err).To(MatchError(ErrReferenceNotFound))
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		expectReason(err, ReasonReferenceNotFound)
+
+		_, err = ResolveSecret(ctx, reader, "openshift-config", configv1.SecretNameReference{Name: "serving"}, corev1.TLSPrivateKeyKey)
+		Expect(err).To(MatchError(ErrInvalidReference))
+		Expect(err).To(MatchError(ContainSubstring(`has no key "tls.key"`)))
+		expectReason(err, ReasonInvalidReference)
+
+		_, err = ResolveConfigMap(ctx, reader, "openshift-config", configv1.ConfigMapNameReference{})
+		Expect(err).To(MatchError(ErrInvalidReference))
+	})
+
+	It("should resolve object references", func() {
+		obj, err := ResolveObjectReference(ctx, reader, scheme, "ns", corev1.ObjectReference{
+			APIVersion: "apps/v1", Kind: "Deployment", Name: "operand",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(obj).To(BeAssignableToTypeOf(&appsv1.Deployment{}))
+		Expect(obj.GetName()).To(Equal("operand"))
+
+		_, err = ResolveObjectReference(ctx, reader, scheme, "ns", corev1.ObjectReference{
+			APIVersion: "apps/v1", Kind: "Deployment", Namespace: "other", Name: "operand",
+		})
+		Expect(err).To(MatchError(ErrReferenceNotFound))
+
+		_, err = ResolveObjectReference(ctx, reader, scheme, "ns", corev1.ObjectReference{APIVersion: "apps/v1", Name: "operand"})
+		Expect(err).To(MatchError(ErrInvalidReference))
+	})
+
+	It("should resolve typed local object references", func() {
+		obj, err := ResolveTypedLocalObjectReference(ctx, reader, scheme, "ns", corev1.TypedLocalObjectReference{
+			APIGroup: ptr.To("apps"), Kind: "Deployment", Name: "operand",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(obj).To(BeAssignableToTypeOf(&appsv1.Deployment{}))
+
+		obj, err = ResolveTypedLocalObjectReference(ctx, reader, scheme, "openshift-config", corev1.TypedLocalObjectReference{
+			Kind: "ConfigMap", Name: "client-ca",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(obj).To(BeAssignableToTypeOf(&corev1.ConfigMap{}))
+
+		_, err = ResolveTypedLocalObjectReference(ctx, reader, scheme, "ns", corev1.TypedLocalObjectReference{
+			APIGroup: ptr.To("example.com"), Kind: "Backend", Name: "operand",
+		})
+		Expect(err).To(MatchError(ErrInvalidReference))
+	})
+
+	It("should resolve the kinds that are not in the scheme as unstructured", func() {
+		var fetched client.Object
+
+		interceptedReader := interceptor.NewClient(fake.NewClientBuilder().WithScheme(scheme).Build(), interceptor.Funcs{
+			Get: func(_ context.Context, _ client.WithWatch, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+				fetched = obj
+				return nil
+			},
+		})
+
+		obj, err := ResolveObjectReference(ctx, interceptedReader, scheme, "ns", corev1.ObjectReference{
+			APIVersion: "example.com/v1", Kind: "Backend", Name: "operand",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(obj).To(BeIdenticalTo(fetched))
+		Expect(obj).To(BeAssignableToTypeOf(&unstructured.Unstructured{}))
+		Expect(obj.GetObjectKind().GroupVersionKind()).To(Equal(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Backend"}))
+	})
+})
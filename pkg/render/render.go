@@ -0,0 +1,265 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package render renders the manifests of operands from Go templates or static manifests, e.g. embedded in the
+// operator binary, into typed objects validated against a scheme and hashed, ready to be ensured with resourceapply.
+package render
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/openshift/controller-runtime-common/pkg/resourceapply"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	// ErrNoTemplates is returned when the patterns of Render match no template.
+	ErrNoTemplates = errors.New("no template matches the patterns")
+	// ErrUnsupportedKind is returned by Ensure for objects without resourceapply Ensure function.
+	ErrUnsupportedKind = errors.New("unsupported kind")
+)
+
+// Object is a rendered object.
+type Object struct {
+	client.Object
+
+	// Template is the name of the template the object was rendered from.
+	Template string
+	// Hash is the hash of the rendered object, e.g. to roll out the pods mounting a rendered ConfigMap with
+	// resourceapply.SetInputsHash.
+	Hash string
+}
+
+// Renderer renders manifests from the templates of a file system. The templates are Go templates, executed with
+// missingkey=error so that missing parameters are not silently rendered as empty values, and may hold several YAML
+// documents separated by "---". Static manifests are templates without actions.
+//
+// Example:
+//
+//	//go:embed manifests
+//	var manifests embed.FS
+//
+//	renderer := &render.Renderer{FS: manifests}
+//	objects, err := renderer.Render(operandParams{Namespace: namespace, Image: image, Replicas: 2}, "manifests/*.yaml")
+//	if err != nil {
+//	    return err
+//	}
+//
+//	changed, err := render.Ensure(ctx, r.Client, r.Recorder, objects)
+type Renderer struct {
+	// FS holds the templates.
+	FS fs.FS
+
+	// Scheme decodes the rendered objects into typed objects, rejecting unknown kinds and fields.
+	// Defaults to the client-go scheme.
+	Scheme *runtime.Scheme
+
+	// Funcs are template functions added to the functions of the package: toYAML, indent and quote.
+	Funcs template.FuncMap
+}
+
+// Render executes the templates matching the patterns, in the order of their names, with params, and decodes the
+// rendered documents. Empty documents are skipped.
+func (r *Renderer) Render(params any, patterns ...string) ([]Object, error) {
+	// The templates are named after the base names of their files, the templates they define are only executed by
+	// them.
+	var names []string
+
+	for _, pattern := range patterns {
+		files, err := fs.Glob(r.FS, pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+
+		for _, file := range files {
+			names = append(names, path.Base(file))
+		}
+	}
+
+	if len(names) == 0 {
+		return nil, fmt.Errorf("%w: %v", ErrNoTemplates, patterns)
+	}
+
+	slices.Sort(names)
+	names = slices.Compact(names)
+
+	tmpl, err := template.New("").Option("missingkey=error").Funcs(funcs()).Funcs(r.Funcs).ParseFS(r.FS, patterns...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse templates %v: %w", patterns, err)
+	}
+
+	var objects []Object
+
+	for _, name := range names {
+		var rendered bytes.Buffer
+		if err := tmpl.ExecuteTemplate(&rendered, name, params); err != nil {
+			return nil, fmt.Errorf("failed to render template %s: %w", name, err)
+		}
+
+		decoded, err := r.Decode(rendered.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode template %s: %w", name, err)
+		}
+
+		for _, obj := range decoded {
+			obj.Template = name
+			objects = append(objects, obj)
+		}
+	}
+
+	return objects, nil
+}
+
+// Decode decodes the YAML or JSON documents of manifests into typed objects of the scheme, rejecting unknown kinds and
+// fields, and hashes them.
+func (r *Renderer) Decode(manifests []byte) ([]Object, error) {
+	objScheme := r.Scheme
+	if objScheme == nil {
+		objScheme = scheme.Scheme
+	}
+
+	decoder := serializer.NewCodecFactory(objScheme, serializer.EnableStrict).UniversalDeserializer()
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(manifests)))
+
+	var objects []Object
+
+	for i := 0; ; i++ {
+		document, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return objects, nil
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to read document %d: %w", i, err)
+		}
+
+		data, err := yaml.YAMLToJSON(document)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse document %d: %w", i, err)
+		}
+
+		// Documents made of comments or whitespace only are empty.
+		if trimmed := bytes.TrimSpace(data); len(trimmed) == 0 || string(trimmed) == "null" {
+			continue
+		}
+
+		decoded, _, err := decoder.Decode(data, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("invalid document %d: %w", i, err)
+		}
+
+		obj, ok := decoded.(client.Object)
+		if !ok {
+			return nil, fmt.Errorf("invalid document %d: %T is not an object", i, decoded)
+		}
+
+		hash, err := resourceapply.Hash(obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash document %d: %w", i, err)
+		}
+
+		objects = append(objects, Object{Object: obj, Hash: hash})
+	}
+}
+
+// Ensure ensures the objects with the resourceapply Ensure function of their kind, in order, and reports whether any
+// of them was created or updated. It fails with ErrUnsupportedKind for kinds without Ensure function, before ensuring
+// any object.
+func Ensure(ctx context.Context, c client.Client, recorder events.EventRecorder, objects []Object, opts ...resourceapply.Option) (bool, error) {
+	for _, obj := range objects {
+		switch obj.Object.(type) {
+		case *appsv1.Deployment, *appsv1.DaemonSet, *corev1.Service, *corev1.ConfigMap, *corev1.Secret,
+			*policyv1.PodDisruptionBudget:
+		default:
+			return false, fmt.Errorf("%w: %T from template %s", ErrUnsupportedKind, obj.Object, obj.Template)
+		}
+	}
+
+	changed := false
+
+	for _, obj := range objects {
+		var (
+			objChanged bool
+			err        error
+		)
+
+		switch o := obj.Object.(type) {
+		case *appsv1.Deployment:
+			_, objChanged, err = resourceapply.EnsureDeployment(ctx, c, recorder, o, opts...)
+		case *appsv1.DaemonSet:
+			_, objChanged, err = resourceapply.EnsureDaemonSet(ctx, c, recorder, o, opts...)
+		case *corev1.Service:
+			_, objChanged, err = resourceapply.EnsureService(ctx, c, recorder, o, opts...)
+		case *corev1.ConfigMap:
+			_, objChanged, err = resourceapply.EnsureConfigMap(ctx, c, recorder, o, opts...)
+		case *corev1.Secret:
+			_, objChanged, err = resourceapply.EnsureSecret(ctx, c, recorder, o, opts...)
+		case *policyv1.PodDisruptionBudget:
+			_, objChanged, err = resourceapply.EnsurePodDisruptionBudget(ctx, c, recorder, o, opts...)
+		}
+
+		if err != nil {
+			return changed, err
+		}
+
+		changed = changed || objChanged
+	}
+
+	return changed, nil
+}
+
+// funcs returns the template functions of the package.
+func funcs() template.FuncMap {
+	return template.FuncMap{
+		// toYAML renders a value as YAML, e.g. the resources of a container, to be indented with indent.
+		"toYAML": func(value any) (string, error) {
+			data, err := yaml.Marshal(value)
+			if err != nil {
+				return "", fmt.Errorf("failed to render YAML: %w", err)
+			}
+
+			return strings.TrimSuffix(string(data), "\n"), nil
+		},
+		// indent indents every line of text with the given number of spaces.
+		"indent": func(spaces int, text string) string {
+			padding := strings.Repeat(" ", spaces)
+			return padding + strings.ReplaceAll(text, "\n", "\n"+padding)
+		},
+		// quote renders a string as a quoted YAML string.
+		"quote": func(value any) string {
+			return fmt.Sprintf("%q", fmt.Sprint(value))
+		},
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"context"
+	"testing/fstest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// params are the parameters of the test templates.
+type params struct {
+	Namespace string
+	Data      map[string]string
+}
+
+var manifests = fstest.MapFS{
+	"manifests/configmap.yaml": {Data: []byte(`
+{{- define "labels" }}
+  labels:
+    app: operand
+{{- end }}
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: operand-config
+  namespace: {{ .Namespace }}
+{{- template "labels" }}
+data:
+{{ toYAML .Data | indent 2 }}
+---
+# Only comments.
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: operand
+  namespace: {{ quote .Namespace }}
+spec:
+  ports:
+  - port: 443
+`)},
+	"manifests/account.yaml": {Data: []byte(`
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: operand
+  namespace: {{ .Namespace }}
+`)},
+	"invalid/field.yaml": {Data: []byte(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: operand
+unknown: field
+`)},
+	"invalid/kind.yaml": {Data: []byte(`
+apiVersion: example.com/v1
+kind: Unknown
+metadata:
+  name: operand
+`)},
+	"invalid/missing.yaml": {Data: []byte(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Name }}
+`)},
+	"unsupported/role.yaml": {Data: []byte(`
+apiVersion: rbac.authorization.k8s.io/v1
+kind: Role
+metadata:
+  name: operand
+  namespace: ns
+`)},
+}
+
+var _ = Describe("Renderer", func() {
+	renderer := &Renderer{FS: manifests}
+
+	It("should render the templates in name order", func() {
+		objects, err := renderer.Render(params{Namespace: "ns", Data: map[string]string{"key": "value"}}, "manifests/*.yaml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(objects).To(HaveLen(3))
+
+		Expect(objects[0].Template).To(Equal("account.yaml"))
+		Expect(objects[0].Object).To(BeAssignableToTypeOf(&corev1.ServiceAccount{}))
+
+		configMap, ok := objects[1].Object.(*corev1.ConfigMap)
+		Expect(ok).To(BeTrue())
+		Expect(configMap.Namespace).To(Equal("ns"))
+		Expect(configMap.Labels).To(Equal(map[string]string{"app": "operand"}))
+		Expect(configMap.Data).To(Equal(map[string]string{"key": "value"}))
+		Expect(objects[1].Hash).NotTo(BeEmpty())
+
+		service, ok := objects[2].Object.(*corev1.Service)
+		Expect(ok).To(BeTrue())
+		Expect(service.Namespace).To(Equal("ns"))
+		Expect(objects[2].Template).To(Equal("configmap.yaml"))
+	})
+
+	It("should hash the rendered content", func() {
+		first, err := renderer.Render(params{Namespace: "ns", Data: map[string]string{"key": "first"}}, "manifests/configmap.yaml")
+		Expect(err).NotTo(HaveOccurred())
+
+		second, err := renderer.Render(params{Namespace: "ns", Data: map[string]string{"key": "second"}}, "manifests/configmap.yaml")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(first[0].Hash).NotTo(Equal(second[0].Hash))
+		Expect(first[1].Hash).To(Equal(second[1].Hash))
+	})
+
+	It("should reject invalid templates and manifests", func() {
+		_, err := renderer.Render(params{}, "none/*.yaml")
+		Expect(err).To(MatchError(ErrNoTemplates))
+
+		_, err = renderer.Render(params{}, "invalid/field.yaml")
+		Expect(err).To(MatchError(ContainSubstring("unknown field")))
+
+		_, err = renderer.Render(params{}, "invalid/kind.yaml")
+		Expect(err).To(MatchError(ContainSubstring("no kind")))
+
+		_, err = renderer.Render(params{}, "invalid/missing.yaml")
+		Expect(err).To(MatchError(ContainSubstring("Name")))
+	})
+})
+
+var _ = Describe("Ensure", func() {
+	ctx := context.Background()
+	renderer := &Renderer{FS: manifests}
+
+	It("should ensure the rendered objects", func() {
+		objects, err := renderer.Render(params{Namespace: "ns", Data: map[string]string{"key": "value"}}, "manifests/configmap.yaml")
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeClient := fake.NewClientBuilder().Build()
+		recorder := events.NewFakeRecorder(10)
+
+		changed, err := Ensure(ctx, fakeClient, recorder, objects)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: "ns", Name: "operand-config"}, &corev1.ConfigMap{})).To(Succeed())
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: "ns", Name: "operand"}, &corev1.Service{})).To(Succeed())
+
+		changed, err = Ensure(ctx, fakeClient, recorder, objects)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+	})
+
+	It("should reject unsupported kinds before ensuring any object", func() {
+		objects, err := renderer.Render(params{Namespace: "ns"}, "manifests/account.yaml", "unsupported/role.yaml")
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeClient := fake.NewClientBuilder().Build()
+
+		_, err = Ensure(ctx, fakeClient, events.NewFakeRecorder(10), objects)
+		Expect(err).To(MatchError(ErrUnsupportedKind))
+		Expect(err).To(MatchError(ContainSubstring("*v1.ServiceAccount")))
+	})
+})
@@ -0,0 +1,147 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceapply
+
+import (
+	"context"
+	"reflect"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// EnsureDeployment ensures the Deployment matches required, see mergeSpec for how the spec is compared.
+// The replicas of the existing Deployment are kept when required does not set them, e.g. when they are scaled by
+// an autoscaler.
+func EnsureDeployment(ctx context.Context, c client.Client, recorder events.EventRecorder, required *appsv1.Deployment, opts ...Option) (*appsv1.Deployment, bool, error) {
+	return ensure(ctx, c, recorder, required, &appsv1.Deployment{}, opts, func(existing, required *appsv1.Deployment) bool {
+		return mergeSpec(&existing.Spec, required.Spec.DeepCopy(), func(spec *appsv1.DeploymentSpec) {
+			if spec.Replicas == nil {
+				spec.Replicas = existing.Spec.Replicas
+			}
+		})
+	})
+}
+
+// EnsureDaemonSet ensures the DaemonSet matches required, see mergeSpec for how the spec is compared.
+func EnsureDaemonSet(ctx context.Context, c client.Client, recorder events.EventRecorder, required *appsv1.DaemonSet, opts ...Option) (*appsv1.DaemonSet, bool, error) {
+	return ensure(ctx, c, recorder, required, &appsv1.DaemonSet{}, opts, func(existing, required *appsv1.DaemonSet) bool {
+		return mergeSpec(&existing.Spec, required.Spec.DeepCopy(), nil)
+	})
+}
+
+// mergeSpec replaces the existing spec with the required one when they differ. Before comparing them, the fields
+// required leaves unset are preserved from existing, see preserveDefaults, so that fields defaulted by the API server
+// do not cause an update on every call, while items removed from lists, e.g. an environment variable, an argument,
+// a port or a volume, are removed from existing.
+// preserve, when set, is called on the required spec before the defaulted fields are preserved, to keep existing
+// fields that are not defaults, such as replicas scaled by an autoscaler.
+func mergeSpec[S any](existing, required *S, preserve func(required *S)) bool {
+	if preserve != nil {
+		preserve(required)
+	}
+
+	preserveDefaults(reflect.ValueOf(required).Elem(), reflect.ValueOf(existing).Elem())
+
+	if equality.Semantic.DeepEqual(*required, *existing) {
+		return false
+	}
+
+	*existing = *required
+
+	return true
+}
+
+// preserveDefaults sets the zero fields and nil pointers of required to their value in existing, recursively.
+// Lists and maps are kept as required, as they are not defaulted, so that removed items are removed; the items of
+// lists are matched by name when they have one, and by index when the lists have the same length otherwise.
+// As a consequence, a field cannot be cleared by leaving it unset in required.
+func preserveDefaults(required, existing reflect.Value) {
+	switch required.Kind() {
+	case reflect.Pointer:
+		switch {
+		case existing.IsNil():
+		case required.IsNil():
+			required.Set(existing)
+		default:
+			preserveDefaults(required.Elem(), existing.Elem())
+		}
+	case reflect.Struct:
+		if !exportedFields(required.Type()) {
+			// Structs with unexported fields, such as resource.Quantity or metav1.Time, are values of their own.
+			if required.IsZero() {
+				required.Set(existing)
+			}
+
+			return
+		}
+
+		for i := range required.NumField() {
+			preserveDefaults(required.Field(i), existing.Field(i))
+		}
+	case reflect.Slice:
+		preserveItemDefaults(required, existing)
+	case reflect.Map, reflect.Interface, reflect.Array, reflect.Chan, reflect.Func, reflect.UnsafePointer:
+	default:
+		if required.IsZero() {
+			required.Set(existing)
+		}
+	}
+}
+
+// preserveItemDefaults preserves the defaulted fields of the items of the required list from the matching items of
+// the existing list.
+func preserveItemDefaults(required, existing reflect.Value) {
+	item := required.Type().Elem()
+	if item.Kind() == reflect.Struct {
+		if name, ok := item.FieldByName("Name"); ok && name.Type.Kind() == reflect.String && name.IsExported() {
+			existingByName := make(map[string]reflect.Value, existing.Len())
+			for i := range existing.Len() {
+				existingByName[existing.Index(i).FieldByIndex(name.Index).String()] = existing.Index(i)
+			}
+
+			for i := range required.Len() {
+				if match, ok := existingByName[required.Index(i).FieldByIndex(name.Index).String()]; ok {
+					preserveDefaults(required.Index(i), match)
+				}
+			}
+
+			return
+		}
+	}
+
+	if required.Len() != existing.Len() {
+		return
+	}
+
+	for i := range required.Len() {
+		preserveDefaults(required.Index(i), existing.Index(i))
+	}
+}
+
+// exportedFields reports whether all the fields of the struct type t are exported.
+func exportedFields(t reflect.Type) bool {
+	for i := range t.NumField() {
+		if !t.Field(i).IsExported() {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceapply
+
+import (
+	"maps"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// newDeployment returns a Deployment running the given image.
+func newDeployment(image string) *appsv1.Deployment {
+	labels := map[string]string{"app": "operand"}
+
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "operand", Namespace: "ns", Labels: maps.Clone(labels)},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: maps.Clone(labels)},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "operand", Image: image}},
+				},
+			},
+		},
+	}
+}
+
+var _ = Describe("EnsureDeployment", func() {
+	var (
+		fakeClient client.Client
+		recorder   *events.FakeRecorder
+	)
+
+	BeforeEach(func() {
+		fakeClient = fake.NewClientBuilder().Build()
+		recorder = events.NewFakeRecorder(10)
+	})
+
+	It("should create, leave unchanged and update the Deployment", func() {
+		deployment, changed, err := EnsureDeployment(ctx, fakeClient, recorder, newDeployment("operand:v1"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(deployment.ResourceVersion).NotTo(BeEmpty())
+		Expect(<-recorder.Events).To(Equal("Normal DeploymentCreated Deployment ns/operand created"))
+
+		_, changed, err = EnsureDeployment(ctx, fakeClient, recorder, newDeployment("operand:v1"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+		Expect(recorder.Events).To(BeEmpty())
+
+		deployment, changed, err = EnsureDeployment(ctx, fakeClient, recorder, newDeployment("operand:v2"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(deployment.Spec.Template.Spec.Containers[0].Image).To(Equal("operand:v2"))
+		Expect(<-recorder.Events).To(Equal("Normal DeploymentUpdated Deployment ns/operand updated"))
+	})
+
+	It("should preserve defaulted fields, scaled replicas and foreign metadata", func() {
+		existing := newDeployment("operand:v1")
+		existing.Labels["owner"] = "someone-else"
+		existing.Spec.Replicas = ptr.To[int32](5)
+		existing.Spec.Template.Spec.RestartPolicy = corev1.RestartPolicyAlways
+		Expect(fakeClient.Create(ctx, existing)).To(Succeed())
+
+		_, changed, err := EnsureDeployment(ctx, fakeClient, nil, newDeployment("operand:v1"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+
+		required := newDeployment("operand:v2")
+		required.Annotations = map[string]string{"note": "v2"}
+
+		deployment, changed, err := EnsureDeployment(ctx, fakeClient, nil, required)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(deployment.Spec.Replicas).To(HaveValue(BeEquivalentTo(5)))
+		Expect(deployment.Labels).To(HaveKeyWithValue("owner", "someone-else"))
+		Expect(deployment.Annotations).To(HaveKeyWithValue("note", "v2"))
+	})
+
+	It("should remove the items removed from required", func() {
+		existing := newDeployment("operand:v1")
+		existing.Spec.Template.Spec.Containers[0].Env = []corev1.EnvVar{{Name: "A", Value: "a"}, {Name: "B", Value: "b"}}
+		existing.Spec.Template.Spec.Containers[0].Args = []string{"--a", "--b"}
+		existing.Spec.Template.Spec.Containers[0].ImagePullPolicy = corev1.PullIfNotPresent
+		Expect(fakeClient.Create(ctx, existing)).To(Succeed())
+
+		required := newDeployment("operand:v1")
+		required.Spec.Template.Spec.Containers[0].Env = []corev1.EnvVar{{Name: "A", Value: "a"}}
+		required.Spec.Template.Spec.Containers[0].Args = []string{"--a"}
+
+		deployment, changed, err := EnsureDeployment(ctx, fakeClient, nil, required)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+
+		container := deployment.Spec.Template.Spec.Containers[0]
+		Expect(container.Env).To(Equal([]corev1.EnvVar{{Name: "A", Value: "a"}}))
+		Expect(container.Args).To(Equal([]string{"--a"}))
+		Expect(container.ImagePullPolicy).To(Equal(corev1.PullIfNotPresent))
+
+		_, changed, err = EnsureDeployment(ctx, fakeClient, nil, required)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+	})
+
+	It("should leave unmanaged Deployments unchanged", func() {
+		existing := newDeployment("operand:v1")
+		existing.Annotations = map[string]string{UnmanagedAnnotation: "true"}
+		Expect(fakeClient.Create(ctx, existing)).To(Succeed())
+
+		deployment, changed, err := EnsureDeployment(ctx, fakeClient, recorder, newDeployment("operand:v2"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+		Expect(deployment.Spec.Template.Spec.Containers[0].Image).To(Equal("operand:v1"))
+	})
+})
+
+var _ = Describe("EnsureDaemonSet", func() {
+	It("should create and update the DaemonSet", func() {
+		fakeClient := fake.NewClientBuilder().Build()
+		deployment := newDeployment("operand:v1")
+		required := &appsv1.DaemonSet{
+			ObjectMeta: deployment.ObjectMeta,
+			Spec:       appsv1.DaemonSetSpec{Selector: deployment.Spec.Selector, Template: deployment.Spec.Template},
+		}
+
+		_, changed, err := EnsureDaemonSet(ctx, fakeClient, nil, required)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+
+		_, changed, err = EnsureDaemonSet(ctx, fakeClient, nil, required)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+
+		required.Spec.Template.Spec.Containers[0].Args = []string{"--verbose"}
+		daemonSet, changed, err := EnsureDaemonSet(ctx, fakeClient, nil, required)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(daemonSet.Spec.Template.Spec.Containers[0].Args).To(ConsistOf("--verbose"))
+	})
+})
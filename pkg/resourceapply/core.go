@@ -0,0 +1,102 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceapply
+
+import (
+	"context"
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// EnsureService ensures the Service matches required, see mergeSpec for how the spec is compared.
+// The cluster IPs, IP families and health check node port allocated to the existing Service are kept when
+// required does not set them.
+func EnsureService(ctx context.Context, c client.Client, recorder events.EventRecorder, required *corev1.Service, opts ...Option) (*corev1.Service, bool, error) {
+	return ensure(ctx, c, recorder, required, &corev1.Service{}, opts, func(existing, required *corev1.Service) bool {
+		return mergeSpec(&existing.Spec, required.Spec.DeepCopy(), func(spec *corev1.ServiceSpec) {
+			if spec.ClusterIP == "" {
+				spec.ClusterIP = existing.Spec.ClusterIP
+			}
+
+			if len(spec.ClusterIPs) == 0 {
+				spec.ClusterIPs = existing.Spec.ClusterIPs
+			}
+
+			if len(spec.IPFamilies) == 0 {
+				spec.IPFamilies = existing.Spec.IPFamilies
+			}
+
+			if spec.IPFamilyPolicy == nil {
+				spec.IPFamilyPolicy = existing.Spec.IPFamilyPolicy
+			}
+
+			if spec.HealthCheckNodePort == 0 {
+				spec.HealthCheckNodePort = existing.Spec.HealthCheckNodePort
+			}
+		})
+	})
+}
+
+// EnsureConfigMap ensures the ConfigMap matches required. Its data is replaced by the required data, so that keys
+// removed from required are removed from the ConfigMap.
+func EnsureConfigMap(ctx context.Context, c client.Client, recorder events.EventRecorder, required *corev1.ConfigMap, opts ...Option) (*corev1.ConfigMap, bool, error) {
+	return ensure(ctx, c, recorder, required, &corev1.ConfigMap{}, opts, func(existing, required *corev1.ConfigMap) bool {
+		if maps.Equal(existing.Data, required.Data) && equalBinaryData(existing.BinaryData, required.BinaryData) {
+			return false
+		}
+
+		existing.Data = maps.Clone(required.Data)
+		existing.BinaryData = maps.Clone(required.BinaryData)
+
+		return true
+	})
+}
+
+// EnsureSecret ensures the Secret matches required. Its data is replaced by the required data, including the
+// stringData of required, so that keys removed from required are removed from the Secret.
+// The type of the existing Secret is kept when required does not set it.
+func EnsureSecret(ctx context.Context, c client.Client, recorder events.EventRecorder, required *corev1.Secret, opts ...Option) (*corev1.Secret, bool, error) {
+	return ensure(ctx, c, recorder, required, &corev1.Secret{}, opts, func(existing, required *corev1.Secret) bool {
+		data := maps.Clone(required.Data)
+		for key, value := range required.StringData {
+			if data == nil {
+				data = map[string][]byte{}
+			}
+
+			data[key] = []byte(value)
+		}
+
+		if equalBinaryData(existing.Data, data) && (required.Type == "" || required.Type == existing.Type) {
+			return false
+		}
+
+		existing.Data = data
+		if required.Type != "" {
+			existing.Type = required.Type
+		}
+
+		return true
+	})
+}
+
+// equalBinaryData reports whether both maps hold the same keys and values.
+func equalBinaryData(a, b map[string][]byte) bool {
+	return maps.EqualFunc(a, b, func(x, y []byte) bool { return string(x) == string(y) })
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceapply
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("EnsureService", func() {
+	It("should keep the allocated cluster IP", func() {
+		fakeClient := fake.NewClientBuilder().WithObjects(&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "operand", Namespace: "ns"},
+			Spec: corev1.ServiceSpec{
+				ClusterIP:  "172.30.0.10",
+				ClusterIPs: []string{"172.30.0.10"},
+				Ports:      []corev1.ServicePort{{Name: "https", Port: 443}},
+			},
+		}).Build()
+
+		required := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "operand", Namespace: "ns"},
+			Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "https", Port: 8443}}},
+		}
+
+		service, changed, err := EnsureService(ctx, fakeClient, nil, required)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(service.Spec.ClusterIP).To(Equal("172.30.0.10"))
+		Expect(service.Spec.Ports).To(ConsistOf(HaveField("Port", BeEquivalentTo(8443))))
+
+		_, changed, err = EnsureService(ctx, fakeClient, nil, required)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+	})
+})
+
+var _ = Describe("EnsureConfigMap", func() {
+	It("should replace the data", func() {
+		fakeClient := fake.NewClientBuilder().Build()
+		required := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "ns"},
+			Data:       map[string]string{"a": "1", "b": "2"},
+		}
+
+		_, changed, err := EnsureConfigMap(ctx, fakeClient, nil, required)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+
+		_, changed, err = EnsureConfigMap(ctx, fakeClient, nil, required)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+
+		required.Data = map[string]string{"a": "1"}
+		_, changed, err = EnsureConfigMap(ctx, fakeClient, nil, required)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+
+		stored := &corev1.ConfigMap{}
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(required), stored)).To(Succeed())
+		Expect(stored.Data).To(Equal(map[string]string{"a": "1"}))
+	})
+})
+
+var _ = Describe("EnsureSecret", func() {
+	It("should merge the string data and keep the type", func() {
+		fakeClient := fake.NewClientBuilder().WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "ns"},
+			Type:       corev1.SecretTypeOpaque,
+			Data:       map[string][]byte{"password": []byte("old")},
+		}).Build()
+
+		required := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "ns"},
+			StringData: map[string]string{"password": "new"},
+		}
+
+		secret, changed, err := EnsureSecret(ctx, fakeClient, nil, required)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(secret.Type).To(Equal(corev1.SecretTypeOpaque))
+		Expect(secret.Data).To(HaveKeyWithValue("password", []byte("new")))
+
+		_, changed, err = EnsureSecret(ctx, fakeClient, nil, required)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+	})
+})
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceapply
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/openshift/controller-runtime-common/pkg/predicates"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// InputsHashAnnotation is the annotation of pod templates holding the hash of the inputs of the pods, such as the
+// data of the ConfigMaps and Secrets they mount, so that the pods are rolled out when the inputs change.
+const InputsHashAnnotation = "operator.openshift.io/inputs-hash"
+
+// ErrUnsupportedWorkload is returned when setting the inputs hash of an object that has no pod template.
+var ErrUnsupportedWorkload = errors.New("unsupported workload, expected a Deployment, DaemonSet or StatefulSet")
+
+// Hash returns a stable hash of the given inputs, e.g. flags and the data of ConfigMaps and Secrets.
+// The inputs are serialized as JSON, which sorts map keys, so that the hash only changes with their content.
+//
+// Example:
+//
+//	hash, err := resourceapply.Hash(config.Data, secret.Data, args)
+//	if err != nil {
+//	    return err
+//	}
+//	err = resourceapply.SetInputsHash(deployment, hash)
+func Hash(inputs ...any) (string, error) {
+	hasher := sha256.New()
+	encoder := json.NewEncoder(hasher)
+
+	for i, input := range inputs {
+		if err := encoder.Encode(input); err != nil {
+			return "", fmt.Errorf("failed to hash input %d: %w", i, err)
+		}
+	}
+
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// SetInputsHash sets the InputsHashAnnotation of the pod template of a Deployment, DaemonSet or StatefulSet,
+// which rolls out its pods when the hash changes.
+func SetInputsHash(obj client.Object, hash string) error {
+	var template *corev1.PodTemplateSpec
+
+	switch workload := obj.(type) {
+	case *appsv1.Deployment:
+		template = &workload.Spec.Template
+	case *appsv1.DaemonSet:
+		template = &workload.Spec.Template
+	case *appsv1.StatefulSet:
+		template = &workload.Spec.Template
+	default:
+		return fmt.Errorf("%w: %T", ErrUnsupportedWorkload, obj)
+	}
+
+	if template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+
+	template.Annotations[InputsHashAnnotation] = hash
+
+	return nil
+}
+
+// DataChanged returns a predicate that filters out updates of ConfigMaps and Secrets that leave their data
+// unchanged, such as metadata-only updates. It is meant for watches of the objects referenced by workloads, e.g.
+// with handler.EnqueueRequestForOwner, so that their owners are only reconciled, and their inputs hash recomputed,
+// when the data changes. Updates of other objects pass.
+//
+// It is equivalent to predicates.ContentChanged.
+func DataChanged() predicate.Predicate {
+	return predicates.ContentChanged()
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceapply
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+var _ = Describe("Hash", func() {
+	It("should only change with the content of the inputs", func() {
+		first, err := Hash(map[string]string{"a": "1", "b": "2"}, []string{"--verbose"})
+		Expect(err).NotTo(HaveOccurred())
+
+		second, err := Hash(map[string]string{"b": "2", "a": "1"}, []string{"--verbose"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(second).To(Equal(first))
+
+		third, err := Hash(map[string]string{"a": "1", "b": "3"}, []string{"--verbose"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(third).NotTo(Equal(first))
+	})
+
+	It("should fail on inputs that cannot be serialized", func() {
+		_, err := Hash(func() {})
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("SetInputsHash", func() {
+	It("should annotate the pod template of workloads", func() {
+		deployment := newDeployment("operand:v1")
+		Expect(SetInputsHash(deployment, "abc")).To(Succeed())
+		Expect(deployment.Spec.Template.Annotations).To(HaveKeyWithValue(InputsHashAnnotation, "abc"))
+
+		statefulSet := &appsv1.StatefulSet{}
+		Expect(SetInputsHash(statefulSet, "abc")).To(Succeed())
+		Expect(statefulSet.Spec.Template.Annotations).To(HaveKeyWithValue(InputsHashAnnotation, "abc"))
+
+		Expect(SetInputsHash(&corev1.Pod{}, "abc")).To(MatchError(ErrUnsupportedWorkload))
+	})
+
+	It("should update the Deployment when the hash changes", func() {
+		fakeClient := fake.NewClientBuilder().Build()
+		required := newDeployment("operand:v1")
+		Expect(SetInputsHash(required, "abc")).To(Succeed())
+		_, _, err := EnsureDeployment(ctx, fakeClient, nil, required)
+		Expect(err).NotTo(HaveOccurred())
+
+		required = newDeployment("operand:v1")
+		Expect(SetInputsHash(required, "def")).To(Succeed())
+		deployment, changed, err := EnsureDeployment(ctx, fakeClient, nil, required)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(deployment.Spec.Template.Annotations).To(HaveKeyWithValue(InputsHashAnnotation, "def"))
+	})
+})
+
+var _ = Describe("DataChanged", func() {
+	meta := metav1.ObjectMeta{Name: "config", Namespace: "ns"}
+
+	It("should filter out updates leaving the data unchanged", func() {
+		oldConfigMap := &corev1.ConfigMap{ObjectMeta: meta, Data: map[string]string{"a": "1"}}
+		newConfigMap := oldConfigMap.DeepCopy()
+		newConfigMap.Labels = map[string]string{"new": "label"}
+		Expect(DataChanged().Update(event.UpdateEvent{ObjectOld: oldConfigMap, ObjectNew: newConfigMap})).To(BeFalse())
+
+		newConfigMap.Data["a"] = "2"
+		Expect(DataChanged().Update(event.UpdateEvent{ObjectOld: oldConfigMap, ObjectNew: newConfigMap})).To(BeTrue())
+
+		oldSecret := &corev1.Secret{ObjectMeta: meta, Data: map[string][]byte{"a": []byte("1")}}
+		newSecret := oldSecret.DeepCopy()
+		Expect(DataChanged().Update(event.UpdateEvent{ObjectOld: oldSecret, ObjectNew: newSecret})).To(BeFalse())
+
+		newSecret.Data["a"] = []byte("2")
+		Expect(DataChanged().Update(event.UpdateEvent{ObjectOld: oldSecret, ObjectNew: newSecret})).To(BeTrue())
+	})
+
+	It("should pass updates of other objects", func() {
+		deployment := newDeployment("operand:v1")
+		Expect(DataChanged().Update(event.UpdateEvent{ObjectOld: deployment, ObjectNew: deployment})).To(BeTrue())
+	})
+})
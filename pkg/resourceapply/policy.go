@@ -0,0 +1,41 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceapply
+
+import (
+	"context"
+
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// EnsurePodDisruptionBudget ensures the PodDisruptionBudget matches required, see mergeSpec for how the spec is
+// compared. As MinAvailable and MaxUnavailable are exclusive, the one required does not set is cleared.
+func EnsurePodDisruptionBudget(ctx context.Context, c client.Client, recorder events.EventRecorder, required *policyv1.PodDisruptionBudget, opts ...Option) (*policyv1.PodDisruptionBudget, bool, error) {
+	return ensure(ctx, c, recorder, required, &policyv1.PodDisruptionBudget{}, opts, func(existing, required *policyv1.PodDisruptionBudget) bool {
+		// mergeSpec preserves the bound required does not set, which must be cleared rather than kept.
+		if (required.Spec.MinAvailable == nil) != (existing.Spec.MinAvailable == nil) ||
+			(required.Spec.MaxUnavailable == nil) != (existing.Spec.MaxUnavailable == nil) {
+			existing.Spec = *required.Spec.DeepCopy()
+
+			return true
+		}
+
+		return mergeSpec(&existing.Spec, required.Spec.DeepCopy(), nil)
+	})
+}
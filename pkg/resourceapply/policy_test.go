@@ -0,0 +1,65 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceapply
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// newPodDisruptionBudget returns a PodDisruptionBudget allowing one operand pod to be unavailable.
+func newPodDisruptionBudget() *policyv1.PodDisruptionBudget {
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: "operand", Namespace: "ns"},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "operand"}},
+			MaxUnavailable: ptr.To(intstr.FromInt32(1)),
+		},
+	}
+}
+
+var _ = Describe("EnsurePodDisruptionBudget", func() {
+	It("should create, leave unchanged and update the PodDisruptionBudget", func() {
+		fakeClient := fake.NewClientBuilder().Build()
+		recorder := events.NewFakeRecorder(10)
+
+		_, changed, err := EnsurePodDisruptionBudget(ctx, fakeClient, recorder, newPodDisruptionBudget())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(<-recorder.Events).To(Equal("Normal PodDisruptionBudgetCreated PodDisruptionBudget ns/operand created"))
+
+		_, changed, err = EnsurePodDisruptionBudget(ctx, fakeClient, recorder, newPodDisruptionBudget())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+
+		required := newPodDisruptionBudget()
+		required.Spec.MaxUnavailable = nil
+		required.Spec.MinAvailable = ptr.To(intstr.FromInt32(2))
+
+		pdb, changed, err := EnsurePodDisruptionBudget(ctx, fakeClient, recorder, required)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(pdb.Spec.MaxUnavailable).To(BeNil())
+		Expect(pdb.Spec.MinAvailable).To(HaveValue(Equal(intstr.FromInt32(2))))
+	})
+})
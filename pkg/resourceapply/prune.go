@@ -0,0 +1,268 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceapply
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// ManagedByLabel is the label identifying the operator managing an object, set on the objects ensured with a
+	// Tracker and used by the Pruner to find the objects it may delete.
+	ManagedByLabel = "app.kubernetes.io/managed-by"
+
+	// KeepAnnotation protects an object from being pruned when set to "true".
+	KeepAnnotation = "operator.openshift.io/keep"
+)
+
+// ErrInvalidPruner is returned when pruning with a Pruner that is not configured properly.
+var ErrInvalidPruner = errors.New("invalid pruner")
+
+// Tracker tracks the objects an operator currently desires, as ensured with the WithTracker option, so that the
+// Pruner deletes the other objects it manages, e.g. after an operand was renamed or moved to another namespace.
+// It is safe for concurrent use.
+type Tracker struct {
+	// ManagedBy is the value of the ManagedByLabel set on the tracked objects, e.g. the name of the operator.
+	ManagedBy string
+
+	mu      sync.RWMutex
+	desired map[schema.GroupVersionKind]map[client.ObjectKey]struct{}
+}
+
+// WithTracker tracks the ensured object as desired in tracker, and sets its ManagedByLabel.
+func WithTracker(tracker *Tracker) Option {
+	return func(o *options) {
+		o.tracker = tracker
+	}
+}
+
+// Track records the object of the given kind and key as desired.
+func (t *Tracker) Track(gvk schema.GroupVersionKind, key client.ObjectKey) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.desired == nil {
+		t.desired = map[schema.GroupVersionKind]map[client.ObjectKey]struct{}{}
+	}
+
+	if t.desired[gvk] == nil {
+		t.desired[gvk] = map[client.ObjectKey]struct{}{}
+	}
+
+	t.desired[gvk][key] = struct{}{}
+}
+
+// IsDesired reports whether the object of the given kind and key is tracked as desired.
+func (t *Tracker) IsDesired(gvk schema.GroupVersionKind, key client.ObjectKey) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	_, ok := t.desired[gvk][key]
+
+	return ok
+}
+
+// Reset forgets the tracked objects. It is called before ensuring the complete desired state again, so that the
+// objects that are no longer ensured are pruned.
+func (t *Tracker) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.desired = nil
+}
+
+// track records required as desired in tracker and returns a copy of it with the ManagedByLabel set.
+func track[T client.Object](c client.Client, tracker *Tracker, required T) (T, error) {
+	gvk, err := apiutil.GVKForObject(required, c.Scheme())
+	if err != nil {
+		return required, fmt.Errorf("failed to get the kind of %T: %w", required, err)
+	}
+
+	tracker.Track(gvk, client.ObjectKeyFromObject(required))
+
+	if tracker.ManagedBy == "" {
+		return required, nil
+	}
+
+	tracked, ok := required.DeepCopyObject().(T)
+	if !ok {
+		return required, fmt.Errorf("unexpected copy of %T", required)
+	}
+
+	labels, _ := mergeMap(tracked.GetLabels(), map[string]string{ManagedByLabel: tracker.ManagedBy})
+	tracked.SetLabels(labels)
+
+	return tracked, nil
+}
+
+// Pruner deletes the objects labeled as managed by the operator that its Tracker does not track as desired.
+// Objects annotated with KeepAnnotation or UnmanagedAnnotation set to "true" are never deleted.
+//
+// Pruning must only run once the complete desired state was ensured since the Tracker was last reset, otherwise
+// desired objects not ensured yet would be deleted.
+//
+// Example:
+//
+//	tracker := &resourceapply.Tracker{ManagedBy: "my-operator"}
+//	pruner := &resourceapply.Pruner{
+//	    Client:  mgr.GetClient(),
+//	    Tracker: tracker,
+//	    Lists:   []client.ObjectList{&appsv1.DeploymentList{}, &corev1.ConfigMapList{}},
+//	}
+//
+//	tracker.Reset()
+//	_, _, err := resourceapply.EnsureDeployment(ctx, c, recorder, deployment, resourceapply.WithTracker(tracker))
+//	...
+//	_, err = pruner.Prune(ctx)
+type Pruner struct {
+	Client client.Client
+
+	// Tracker tracks the desired objects. Its ManagedBy selects the objects to prune and must be set.
+	Tracker *Tracker
+
+	// Lists are empty lists of the kinds of objects to prune.
+	Lists []client.ObjectList
+
+	// Namespace restricts pruning to a namespace. All namespaces are pruned when empty.
+	Namespace string
+
+	// DryRun only reports and logs the objects that would be deleted.
+	DryRun bool
+
+	// Recorder optionally records an event for each deleted object.
+	Recorder events.EventRecorder
+}
+
+// Prune deletes the orphaned objects and returns them, or only returns them in dry-run mode.
+func (p *Pruner) Prune(ctx context.Context) ([]client.Object, error) {
+	if p.Tracker == nil || p.Tracker.ManagedBy == "" {
+		return nil, fmt.Errorf("%w: the pruner needs a tracker with ManagedBy set", ErrInvalidPruner)
+	}
+
+	var pruned []client.Object
+
+	for _, list := range p.Lists {
+		orphans, err := p.orphans(ctx, list)
+		if err != nil {
+			return pruned, err
+		}
+
+		for _, obj := range orphans {
+			deleted, err := p.delete(ctx, obj)
+			if err != nil {
+				return pruned, err
+			}
+
+			if deleted {
+				pruned = append(pruned, obj)
+			}
+		}
+	}
+
+	return pruned, nil
+}
+
+// orphans returns the managed objects of the given list kind that are not desired nor protected.
+func (p *Pruner) orphans(ctx context.Context, list client.ObjectList) ([]client.Object, error) {
+	objects, ok := list.DeepCopyObject().(client.ObjectList)
+	if !ok {
+		return nil, fmt.Errorf("unexpected copy of %T", list)
+	}
+
+	opts := []client.ListOption{client.MatchingLabels{ManagedByLabel: p.Tracker.ManagedBy}}
+	if p.Namespace != "" {
+		opts = append(opts, client.InNamespace(p.Namespace))
+	}
+
+	if err := p.Client.List(ctx, objects, opts...); err != nil {
+		return nil, fmt.Errorf("failed to list %T: %w", objects, err)
+	}
+
+	items, err := meta.ExtractList(objects)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract the items of %T: %w", objects, err)
+	}
+
+	var orphans []client.Object
+
+	for _, item := range items {
+		obj, ok := item.(client.Object)
+		if !ok {
+			continue
+		}
+
+		gvk, err := apiutil.GVKForObject(obj, p.Client.Scheme())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the kind of %T: %w", obj, err)
+		}
+
+		annotations := obj.GetAnnotations()
+		if p.Tracker.IsDesired(gvk, client.ObjectKeyFromObject(obj)) ||
+			annotations[KeepAnnotation] == "true" || annotations[UnmanagedAnnotation] == "true" {
+			continue
+		}
+
+		orphans = append(orphans, obj)
+	}
+
+	return orphans, nil
+}
+
+// delete deletes an orphaned object unless in dry-run mode, and reports whether it was or would have been deleted.
+func (p *Pruner) delete(ctx context.Context, obj client.Object) (bool, error) {
+	kind := kindOf(p.Client, obj)
+	key := client.ObjectKeyFromObject(obj)
+	logger := log.FromContext(ctx).WithValues("kind", kind, "object", key.String())
+
+	if p.DryRun {
+		logger.Info("Would prune orphaned object (dry run)")
+		return true, nil
+	}
+
+	uid := obj.GetUID()
+	if err := p.Client.Delete(ctx, obj,
+		client.PropagationPolicy(metav1.DeletePropagationBackground),
+		client.Preconditions{UID: &uid},
+	); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("failed to prune %s %s: %w", kind, key.String(), err)
+	}
+
+	logger.Info("Pruned orphaned object")
+
+	if p.Recorder != nil {
+		p.Recorder.Eventf(obj, nil, corev1.EventTypeNormal, kind+"Pruned", "Delete", "%s %s pruned", kind, key.String())
+	}
+
+	return true, nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceapply
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Pruner", func() {
+	var (
+		fakeClient client.Client
+		tracker    *Tracker
+		pruner     *Pruner
+	)
+
+	configMap := func(name string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"}}
+	}
+
+	exists := func(name string) bool {
+		GinkgoHelper()
+
+		err := fakeClient.Get(ctx, client.ObjectKey{Name: name, Namespace: "ns"}, &corev1.ConfigMap{})
+		if apierrors.IsNotFound(err) {
+			return false
+		}
+
+		Expect(err).NotTo(HaveOccurred())
+
+		return true
+	}
+
+	BeforeEach(func() {
+		unrelated := configMap("unrelated")
+		protected := configMap("protected")
+		protected.Labels = map[string]string{ManagedByLabel: "my-operator"}
+		protected.Annotations = map[string]string{KeepAnnotation: "true"}
+
+		fakeClient = fake.NewClientBuilder().WithObjects(unrelated, protected).Build()
+		tracker = &Tracker{ManagedBy: "my-operator"}
+		pruner = &Pruner{Client: fakeClient, Tracker: tracker, Lists: []client.ObjectList{&corev1.ConfigMapList{}}}
+
+		for _, name := range []string{"old", "new"} {
+			_, _, err := EnsureConfigMap(ctx, fakeClient, nil, configMap(name), WithTracker(tracker))
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		tracker.Reset()
+		_, _, err := EnsureConfigMap(ctx, fakeClient, nil, configMap("new"), WithTracker(tracker))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should label the tracked objects", func() {
+		stored := &corev1.ConfigMap{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "new", Namespace: "ns"}, stored)).To(Succeed())
+		Expect(stored.Labels).To(HaveKeyWithValue(ManagedByLabel, "my-operator"))
+	})
+
+	It("should delete the managed objects that are no longer desired", func() {
+		pruned, err := pruner.Prune(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pruned).To(ConsistOf(HaveField("ObjectMeta.Name", "old")))
+
+		Expect(exists("old")).To(BeFalse())
+		Expect(exists("new")).To(BeTrue())
+		Expect(exists("protected")).To(BeTrue())
+		Expect(exists("unrelated")).To(BeTrue())
+	})
+
+	It("should only report the orphans in dry-run mode", func() {
+		pruner.DryRun = true
+
+		pruned, err := pruner.Prune(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pruned).To(HaveLen(1))
+		Expect(exists("old")).To(BeTrue())
+	})
+
+	It("should require a tracker with ManagedBy set", func() {
+		pruner.Tracker = &Tracker{}
+
+		_, err := pruner.Prune(ctx)
+		Expect(err).To(MatchError(ErrInvalidPruner))
+	})
+})
@@ -0,0 +1,138 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceapply
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// DefaultRecreateTimeout is how long an object being recreated is waited for to be deleted by default.
+	DefaultRecreateTimeout = time.Minute
+
+	// recreatePollInterval is the interval at which the deletion of an object being recreated is checked.
+	recreatePollInterval = time.Second
+)
+
+// Option configures the Ensure functions.
+type Option func(*options)
+
+// options are the options of the Ensure functions.
+type options struct {
+	recreate        bool
+	recreateTimeout time.Duration
+	tracker         *Tracker
+}
+
+// newOptions returns the options with opts applied.
+func newOptions(opts []Option) options {
+	o := options{recreateTimeout: DefaultRecreateTimeout}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+// RecreateOnImmutableFieldChange opts in to deleting and creating again objects whose update is rejected because it
+// changes immutable fields, e.g. the clusterIP of a Service, the selector of a Job or the type of a Secret.
+// The object is deleted with foreground propagation, so that its dependents are deleted first, and waited for until it
+// is gone, at most timeout, or DefaultRecreateTimeout when zero.
+//
+// Recreating an object interrupts what it serves, so this is only meant for objects that can be safely recreated.
+func RecreateOnImmutableFieldChange(timeout time.Duration) Option {
+	return func(o *options) {
+		o.recreate = true
+		if timeout > 0 {
+			o.recreateTimeout = timeout
+		}
+	}
+}
+
+// IsImmutableFieldError reports whether err is the rejection of an update changing immutable fields.
+func IsImmutableFieldError(err error) bool {
+	if !apierrors.IsInvalid(err) {
+		return false
+	}
+
+	var statusErr apierrors.APIStatus
+	if !errors.As(err, &statusErr) || statusErr.Status().Details == nil {
+		return strings.Contains(err.Error(), "immutable")
+	}
+
+	for _, cause := range statusErr.Status().Details.Causes {
+		if strings.Contains(cause.Message, "immutable") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// recreate deletes existing, waits until it is gone and creates required.
+func recreate[T client.Object](ctx context.Context, c client.Client, recorder events.EventRecorder, existing, required T, kind string, timeout time.Duration) (T, bool, error) {
+	key := client.ObjectKeyFromObject(existing)
+	uid := existing.GetUID()
+
+	log.FromContext(ctx).Info("Recreating object to change immutable fields", "kind", kind, "object", key.String())
+
+	if err := c.Delete(ctx, existing,
+		client.PropagationPolicy(metav1.DeletePropagationForeground),
+		client.Preconditions{UID: &uid},
+	); err != nil && !apierrors.IsNotFound(err) {
+		return existing, false, fmt.Errorf("failed to delete %s %s to recreate it: %w", kind, key.String(), err)
+	}
+
+	if err := wait.PollUntilContextTimeout(ctx, recreatePollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		current, ok := existing.DeepCopyObject().(client.Object)
+		if !ok {
+			return false, fmt.Errorf("unexpected copy of %T", existing)
+		}
+
+		err := c.Get(ctx, key, current)
+		switch {
+		case apierrors.IsNotFound(err):
+			return true, nil
+		case err != nil:
+			return false, err
+		default:
+			return current.GetUID() != uid, nil
+		}
+	}); err != nil {
+		return existing, false, fmt.Errorf("failed to wait for the deletion of %s %s to recreate it: %w", kind, key.String(), err)
+	}
+
+	created, err := create(ctx, c, required, kind)
+	if err != nil {
+		return existing, false, err
+	}
+
+	record(recorder, created, kind, "Recreate")
+
+	return created, true, nil
+}
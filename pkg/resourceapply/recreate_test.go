@@ -0,0 +1,96 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceapply
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+// immutableFieldError returns the error of the API server rejecting a change of the clusterIP of a Service.
+func immutableFieldError(name string) error {
+	return apierrors.NewInvalid(schema.GroupKind{Kind: "Service"}, name, field.ErrorList{
+		field.Invalid(field.NewPath("spec", "clusterIP"), "172.30.0.11", "field is immutable"),
+	})
+}
+
+var _ = Describe("IsImmutableFieldError", func() {
+	It("should detect rejected changes of immutable fields", func() {
+		Expect(IsImmutableFieldError(immutableFieldError("operand"))).To(BeTrue())
+		Expect(IsImmutableFieldError(apierrors.NewInvalid(schema.GroupKind{Kind: "Service"}, "operand", field.ErrorList{
+			field.Required(field.NewPath("spec", "ports"), ""),
+		}))).To(BeFalse())
+		Expect(IsImmutableFieldError(errors.New("field is immutable"))).To(BeFalse())
+	})
+})
+
+var _ = Describe("RecreateOnImmutableFieldChange", func() {
+	var (
+		fakeClient client.Client
+		recorder   *events.FakeRecorder
+		required   *corev1.Service
+	)
+
+	BeforeEach(func() {
+		existing := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "operand", Namespace: "ns", UID: types.UID("old")},
+			Spec:       corev1.ServiceSpec{ClusterIP: "172.30.0.10"},
+		}
+		required = &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "operand", Namespace: "ns"},
+			Spec:       corev1.ServiceSpec{ClusterIP: "172.30.0.11"},
+		}
+
+		recorder = events.NewFakeRecorder(10)
+		fakeClient = fake.NewClientBuilder().WithObjects(existing).WithInterceptorFuncs(interceptor.Funcs{
+			Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+				return immutableFieldError(obj.GetName())
+			},
+		}).Build()
+	})
+
+	It("should fail without the option", func() {
+		_, _, err := EnsureService(ctx, fakeClient, recorder, required)
+		Expect(IsImmutableFieldError(err)).To(BeTrue())
+	})
+
+	It("should delete and create the object again with the option", func() {
+		service, changed, err := EnsureService(ctx, fakeClient, recorder, required, RecreateOnImmutableFieldChange(0))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(service.Spec.ClusterIP).To(Equal("172.30.0.11"))
+		Expect(<-recorder.Events).To(Equal("Normal ServiceRecreated Service ns/operand recreated"))
+
+		stored := &corev1.Service{}
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(required), stored)).To(Succeed())
+		Expect(stored.Spec.ClusterIP).To(Equal("172.30.0.11"))
+		Expect(stored.UID).NotTo(Equal(types.UID("old")))
+	})
+})
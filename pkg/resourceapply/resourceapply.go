@@ -0,0 +1,216 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package resourceapply provides functions that ensure objects match their desired state, merging the desired
+// fields into the existing objects rather than replacing them, so that fields defaulted by the API server or set by
+// other actors are preserved.
+//
+// Each Ensure function creates the object when it does not exist, and otherwise updates it when the desired fields
+// differ, reporting whether a change was made. Labels, annotations and owner references of the desired object are
+// merged into the existing ones. Objects annotated with UnmanagedAnnotation set to "true" are left as they are, so that
+// administrators can take over an object, e.g. while debugging. Changes are recorded as events on the objects when a
+// recorder is given.
+package resourceapply
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// UnmanagedAnnotation opts an object out of the Ensure functions when set to "true" on the existing object.
+const UnmanagedAnnotation = "operator.openshift.io/unmanaged"
+
+// mergeFunc merges the desired fields of required into existing and reports whether existing changed.
+type mergeFunc[T client.Object] func(existing, required T) bool
+
+// ensure creates required when it does not exist, and otherwise merges it into existing, an empty object of the same
+// type, with mergeMetadata and merge, updating it when it changed. It returns the resulting object and whether it was
+// created or updated.
+func ensure[T client.Object](ctx context.Context, c client.Client, recorder events.EventRecorder, required, existing T, opts []Option, merge mergeFunc[T]) (T, bool, error) {
+	options := newOptions(opts)
+	kind := kindOf(c, required)
+	key := client.ObjectKeyFromObject(required)
+
+	if options.tracker != nil {
+		tracked, err := track(c, options.tracker, required)
+		if err != nil {
+			return existing, false, err
+		}
+
+		required = tracked
+	}
+
+	if err := c.Get(ctx, key, existing); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return existing, false, fmt.Errorf("failed to get %s %s: %w", kind, key.String(), err)
+		}
+
+		created, err := create(ctx, c, required, kind)
+		if err != nil {
+			return existing, false, err
+		}
+
+		record(recorder, created, kind, "Create")
+		log.FromContext(ctx).Info("Created object", "kind", kind, "object", key.String())
+
+		return created, true, nil
+	}
+
+	if existing.GetAnnotations()[UnmanagedAnnotation] == "true" {
+		log.FromContext(ctx).V(1).Info("Skipping unmanaged object", "kind", kind, "object", key.String())
+		return existing, false, nil
+	}
+
+	modified := mergeMetadata(existing, required)
+	if merge(existing, required) {
+		modified = true
+	}
+
+	if !modified {
+		return existing, false, nil
+	}
+
+	if err := c.Update(ctx, existing); err != nil {
+		if options.recreate && IsImmutableFieldError(err) {
+			return recreate(ctx, c, recorder, existing, required, kind, options.recreateTimeout)
+		}
+
+		return existing, false, fmt.Errorf("failed to update %s %s: %w", kind, key.String(), err)
+	}
+
+	record(recorder, existing, kind, "Update")
+	log.FromContext(ctx).Info("Updated object", "kind", kind, "object", key.String())
+
+	return existing, true, nil
+}
+
+// create creates a copy of required and returns it.
+func create[T client.Object](ctx context.Context, c client.Client, required T, kind string) (T, error) {
+	created, ok := required.DeepCopyObject().(T)
+	if !ok {
+		return created, fmt.Errorf("unexpected copy of %T", required)
+	}
+
+	if err := c.Create(ctx, created); err != nil {
+		return created, fmt.Errorf("failed to create %s %s: %w", kind, client.ObjectKeyFromObject(required).String(), err)
+	}
+
+	return created, nil
+}
+
+// mergeMetadata merges the labels, annotations and owner references of required into existing
+// and reports whether existing changed.
+func mergeMetadata(existing, required metav1.Object) bool {
+	modified := false
+
+	if labels, changed := mergeMap(existing.GetLabels(), required.GetLabels()); changed {
+		existing.SetLabels(labels)
+		modified = true
+	}
+
+	if annotations, changed := mergeMap(existing.GetAnnotations(), required.GetAnnotations()); changed {
+		existing.SetAnnotations(annotations)
+		modified = true
+	}
+
+	ownerReferences := existing.GetOwnerReferences()
+	changed := false
+
+	for _, required := range required.GetOwnerReferences() {
+		i := slices.IndexFunc(ownerReferences, func(o metav1.OwnerReference) bool { return o.UID == required.UID })
+		switch {
+		case i < 0:
+			ownerReferences = append(ownerReferences, required)
+			changed = true
+		case !equalOwnerReferences(ownerReferences[i], required):
+			ownerReferences[i] = required
+			changed = true
+		}
+	}
+
+	if changed {
+		existing.SetOwnerReferences(ownerReferences)
+		modified = true
+	}
+
+	return modified
+}
+
+// mergeMap returns existing with the entries of required, and whether that changed existing.
+func mergeMap(existing, required map[string]string) (map[string]string, bool) {
+	changed := false
+
+	for key, value := range required {
+		if current, ok := existing[key]; ok && current == value {
+			continue
+		}
+
+		if existing == nil {
+			existing = map[string]string{}
+		}
+
+		existing[key] = value
+		changed = true
+	}
+
+	return existing, changed
+}
+
+// equalOwnerReferences reports whether both owner references are equal.
+func equalOwnerReferences(a, b metav1.OwnerReference) bool {
+	return a.APIVersion == b.APIVersion && a.Kind == b.Kind && a.Name == b.Name && a.UID == b.UID &&
+		equalBoolPtr(a.Controller, b.Controller) && equalBoolPtr(a.BlockOwnerDeletion, b.BlockOwnerDeletion)
+}
+
+// equalBoolPtr reports whether both pointers are nil or point to equal values.
+func equalBoolPtr(a, b *bool) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+// record records an event for the given action on obj, e.g. Create with the reason DeploymentCreated,
+// when recorder is set.
+func record(recorder events.EventRecorder, obj client.Object, kind, action string) {
+	if recorder == nil {
+		return
+	}
+
+	recorder.Eventf(obj, nil, corev1.EventTypeNormal, kind+action+"d", action,
+		"%s %s %sd", kind, client.ObjectKeyFromObject(obj).String(), strings.ToLower(action))
+}
+
+// kindOf returns the kind of obj, falling back to its Go type when it is not registered with the client scheme.
+func kindOf(c client.Client, obj client.Object) string {
+	gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+	if err != nil {
+		return fmt.Sprintf("%T", obj)
+	}
+
+	return gvk.Kind
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceapply
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/openshift/controller-runtime-common/pkg/metricsregistry"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// DefaultResyncInterval is the default interval at which the desired state is applied again.
+	DefaultResyncInterval = 10 * time.Minute
+
+	// DefaultResyncJitterFactor is the default maximum fraction of the interval added to it as jitter.
+	DefaultResyncJitterFactor = 0.1
+)
+
+var (
+	driftCorrections = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "desired_state_drift_corrections_total",
+		Help: "Number of objects changed out of band that were corrected by a periodic resync, by provider.",
+	}, []string{"provider"})
+
+	resyncErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "desired_state_resync_errors_total",
+		Help: "Number of periodic resyncs of the desired state that failed, by provider.",
+	}, []string{"provider"})
+)
+
+func init() {
+	metricsregistry.Add(driftCorrections, resyncErrors)
+}
+
+// Provider ensures the desired objects of a controller, typically with the Ensure functions,
+// and reports how many objects it created or updated.
+type Provider interface {
+	EnsureDesired(ctx context.Context) (changed int, err error)
+}
+
+// ProviderFunc is a function implementing Provider.
+type ProviderFunc func(ctx context.Context) (int, error)
+
+// EnsureDesired implements Provider.
+func (f ProviderFunc) EnsureDesired(ctx context.Context) (int, error) {
+	return f(ctx)
+}
+
+// Resyncer periodically ensures the desired state of the registered providers, to correct changes made out of band
+// even when no watch event triggers a reconciliation, e.g. for objects that are not watched. The corrections are
+// counted in the desired_state_drift_corrections_total metric, and failures in desired_state_resync_errors_total.
+//
+// Example:
+//
+//	resyncer := &resourceapply.Resyncer{Interval: 30 * time.Minute}
+//	resyncer.Register("operand", resourceapply.ProviderFunc(r.ensureOperand))
+//	if err := resyncer.SetupWithManager(mgr); err != nil {
+//	    return err
+//	}
+type Resyncer struct {
+	// Interval is the interval between resyncs. Defaults to DefaultResyncInterval.
+	Interval time.Duration
+
+	// JitterFactor is the maximum fraction of the interval randomly added to it, so that replicas of several operators
+	// do not resync at once. Defaults to DefaultResyncJitterFactor.
+	JitterFactor float64
+
+	mu        sync.RWMutex
+	providers map[string]Provider
+}
+
+// Register registers the provider under the given name, replacing the provider registered under that name.
+func (r *Resyncer) Register(name string, provider Provider) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.providers == nil {
+		r.providers = map[string]Provider{}
+	}
+
+	r.providers[name] = provider
+}
+
+// Unregister removes the provider registered under the given name.
+func (r *Resyncer) Unregister(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.providers, name)
+}
+
+// Providers returns the names of the registered providers, sorted.
+func (r *Resyncer) Providers() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return slices.Sorted(maps.Keys(r.providers))
+}
+
+// SetupWithManager adds the Resyncer to the manager.
+func (r *Resyncer) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.Add(r); err != nil {
+		return fmt.Errorf("failed to add desired state resyncer to manager: %w", err)
+	}
+
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+// The Resyncer writes objects, so it only runs on the leader.
+func (r *Resyncer) NeedLeaderElection() bool {
+	return true
+}
+
+// Start resyncs the desired state at every interval, the first time after one interval, until the context is done.
+func (r *Resyncer) Start(ctx context.Context) error {
+	interval := r.Interval
+	if interval <= 0 {
+		interval = DefaultResyncInterval
+	}
+
+	jitterFactor := r.JitterFactor
+	if jitterFactor <= 0 {
+		jitterFactor = DefaultResyncJitterFactor
+	}
+
+	ctx = log.IntoContext(ctx, log.FromContext(ctx).WithName("desired-state-resyncer"))
+
+	timer := time.NewTimer(wait.Jitter(interval, jitterFactor))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timer.C:
+			r.Resync(ctx)
+			timer.Reset(wait.Jitter(interval, jitterFactor))
+		}
+	}
+}
+
+// Resync ensures the desired state of every registered provider once, in name order. Failures are logged and
+// counted, and do not prevent the other providers from being resynced.
+func (r *Resyncer) Resync(ctx context.Context) {
+	r.mu.RLock()
+	providers := maps.Clone(r.providers)
+	r.mu.RUnlock()
+
+	for _, name := range slices.Sorted(maps.Keys(providers)) {
+		logger := log.FromContext(ctx).WithValues("provider", name)
+
+		changed, err := providers[name].EnsureDesired(ctx)
+		if err != nil {
+			resyncErrors.WithLabelValues(name).Inc()
+			logger.Error(err, "Failed to resync desired state")
+
+			continue
+		}
+
+		if changed > 0 {
+			driftCorrections.WithLabelValues(name).Add(float64(changed))
+			logger.Info("Corrected drift from desired state", "objects", changed)
+		}
+	}
+}
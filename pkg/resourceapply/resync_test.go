@@ -0,0 +1,116 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceapply
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Resyncer", func() {
+	It("should correct drift and count the corrections", func() {
+		fakeClient := fake.NewClientBuilder().Build()
+		required := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "ns"},
+			Data:       map[string]string{"key": "desired"},
+		}
+
+		resyncer := &Resyncer{}
+		resyncer.Register("drift-test", ProviderFunc(func(ctx context.Context) (int, error) {
+			_, changed, err := EnsureConfigMap(ctx, fakeClient, nil, required)
+			if changed {
+				return 1, err
+			}
+
+			return 0, err
+		}))
+
+		resyncer.Resync(ctx)
+		before := testutil.ToFloat64(driftCorrections.WithLabelValues("drift-test"))
+
+		resyncer.Resync(ctx)
+		Expect(testutil.ToFloat64(driftCorrections.WithLabelValues("drift-test"))).To(Equal(before))
+
+		stored := &corev1.ConfigMap{}
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(required), stored)).To(Succeed())
+		stored.Data["key"] = "changed out of band"
+		Expect(fakeClient.Update(ctx, stored)).To(Succeed())
+
+		resyncer.Resync(ctx)
+		Expect(testutil.ToFloat64(driftCorrections.WithLabelValues("drift-test"))).To(Equal(before + 1))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(required), stored)).To(Succeed())
+		Expect(stored.Data).To(HaveKeyWithValue("key", "desired"))
+	})
+
+	It("should count failures and resync the other providers", func() {
+		var calls atomic.Int32
+
+		resyncer := &Resyncer{}
+		resyncer.Register("failing-test", ProviderFunc(func(context.Context) (int, error) {
+			return 0, errors.New("boom")
+		}))
+		resyncer.Register("other-test", ProviderFunc(func(context.Context) (int, error) {
+			calls.Add(1)
+			return 0, nil
+		}))
+
+		Expect(resyncer.Providers()).To(Equal([]string{"failing-test", "other-test"}))
+
+		before := testutil.ToFloat64(resyncErrors.WithLabelValues("failing-test"))
+		resyncer.Resync(ctx)
+		Expect(testutil.ToFloat64(resyncErrors.WithLabelValues("failing-test"))).To(Equal(before + 1))
+		Expect(calls.Load()).To(BeEquivalentTo(1))
+
+		resyncer.Unregister("other-test")
+		Expect(resyncer.Providers()).To(Equal([]string{"failing-test"}))
+		resyncer.Resync(ctx)
+		Expect(calls.Load()).To(BeEquivalentTo(1))
+	})
+
+	It("should resync periodically until stopped", func() {
+		var calls atomic.Int32
+
+		resyncer := &Resyncer{Interval: 10 * time.Millisecond}
+		resyncer.Register("periodic-test", ProviderFunc(func(context.Context) (int, error) {
+			calls.Add(1)
+			return 0, nil
+		}))
+
+		runCtx, cancel := context.WithCancel(ctx)
+		done := make(chan error)
+
+		go func() {
+			done <- resyncer.Start(runCtx)
+		}()
+
+		Eventually(calls.Load).Should(BeNumerically(">=", 2))
+
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+	})
+})
@@ -0,0 +1,160 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restmapping
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// DefaultRetryTimeout is the default period after the creation of the client "no matches for kind" errors are
+	// retried for, long enough for the CRDs installed along with an operator to be established.
+	DefaultRetryTimeout = 2 * time.Minute
+	// DefaultRetryInterval is the default interval between retries.
+	DefaultRetryInterval = 2 * time.Second
+)
+
+// RetryOptions configure the retries of "no matches for kind" errors.
+type RetryOptions struct {
+	// Timeout is the period after the creation of the client the requests are retried for.
+	// Defaults to DefaultRetryTimeout.
+	Timeout time.Duration
+
+	// Interval is the interval between retries. Defaults to DefaultRetryInterval.
+	Interval time.Duration
+}
+
+// retryClient is a client retrying the requests failing with "no matches for kind" errors.
+type retryClient struct {
+	client.Client
+
+	opts RetryOptions
+
+	// deadline is when the retry window, starting at the creation of the client, ends.
+	deadline time.Time
+}
+
+// WithNoKindMatchRetry returns a client retrying the requests of c failing with "no matches for kind" errors, e.g.
+// for custom resources whose CRDs are not established yet, until they succeed, fail otherwise, the timeout expires or
+// the context is done. The RESTMapper of c is reset before every retry when it implements meta.ResettableRESTMapper,
+// e.g. with NewResettableMapper.
+//
+// The timeout is a window starting when the client is created, which covers the CRDs installed along with the
+// operator: once it has expired, the requests fail immediately, so that the requests for optional kinds that are
+// legitimately not served do not block workers.
+//
+// The writes of status and other subresources are not retried, as they require the object to exist.
+//
+// Example:
+//
+//	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
+//	    MapperProvider: restmapping.NewResettableMapper,
+//	    NewClient: func(cfg *rest.Config, opts client.Options) (client.Client, error) {
+//	        c, err := client.New(cfg, opts)
+//	        if err != nil {
+//	            return nil, err
+//	        }
+//
+//	        return restmapping.WithNoKindMatchRetry(c, restmapping.RetryOptions{}), nil
+//	    },
+//	})
+func WithNoKindMatchRetry(c client.Client, opts RetryOptions) client.Client {
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultRetryTimeout
+	}
+
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultRetryInterval
+	}
+
+	return &retryClient{Client: c, opts: opts, deadline: time.Now().Add(opts.Timeout)}
+}
+
+// retry calls fn until it does not fail with a "no matches for kind" error, the retry window expires or the context
+// is done, and returns its last error.
+func (c *retryClient) retry(ctx context.Context, fn func() error) error {
+	err := fn()
+	if !meta.IsNoMatchError(err) {
+		return err
+	}
+
+	remaining := time.Until(c.deadline)
+	if remaining <= 0 {
+		return err
+	}
+
+	log.FromContext(ctx).V(1).Info("Retrying request for a kind that is not served yet", "error", err.Error())
+
+	_ = wait.PollUntilContextTimeout(ctx, c.opts.Interval, remaining, false, func(context.Context) (bool, error) {
+		if mapper, ok := c.RESTMapper().(meta.ResettableRESTMapper); ok {
+			mapper.Reset()
+		}
+
+		err = fn()
+
+		return !meta.IsNoMatchError(err), nil
+	})
+
+	return err
+}
+
+// Get implements client.Reader.
+func (c *retryClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	return c.retry(ctx, func() error { return c.Client.Get(ctx, key, obj, opts...) })
+}
+
+// List implements client.Reader.
+func (c *retryClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return c.retry(ctx, func() error { return c.Client.List(ctx, list, opts...) })
+}
+
+// Apply implements client.Writer.
+func (c *retryClient) Apply(ctx context.Context, obj runtime.ApplyConfiguration, opts ...client.ApplyOption) error {
+	return c.retry(ctx, func() error { return c.Client.Apply(ctx, obj, opts...) })
+}
+
+// Create implements client.Writer.
+func (c *retryClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	return c.retry(ctx, func() error { return c.Client.Create(ctx, obj, opts...) })
+}
+
+// Delete implements client.Writer.
+func (c *retryClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	return c.retry(ctx, func() error { return c.Client.Delete(ctx, obj, opts...) })
+}
+
+// Update implements client.Writer.
+func (c *retryClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	return c.retry(ctx, func() error { return c.Client.Update(ctx, obj, opts...) })
+}
+
+// Patch implements client.Writer.
+func (c *retryClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	return c.retry(ctx, func() error { return c.Client.Patch(ctx, obj, patch, opts...) })
+}
+
+// DeleteAllOf implements client.Writer.
+func (c *retryClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	return c.retry(ctx, func() error { return c.Client.DeleteAllOf(ctx, obj, opts...) })
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restmapping
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+var _ = Describe("WithNoKindMatchRetry", func() {
+	var (
+		ctx    context.Context
+		resets int
+		mapper *ResettableMapper
+	)
+
+	noKindMatch := &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: "example.com", Kind: "Operand"}}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		resets = 0
+		mapper = &ResettableMapper{newMapper: func() (meta.RESTMapper, error) {
+			resets++
+			return meta.NewDefaultRESTMapper(nil), nil
+		}, mapper: meta.NewDefaultRESTMapper(nil)}
+	})
+
+	// newClient returns a client whose reads fail with err the given number of times.
+	newClient := func(failures int, err error) client.Client {
+		return fake.NewClientBuilder().
+			WithRESTMapper(mapper).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+					if failures > 0 {
+						failures--
+						return err
+					}
+
+					return c.Get(ctx, key, obj, opts...)
+				},
+			}).
+			Build()
+	}
+
+	It("should retry until the kind is served, resetting the RESTMapper", func() {
+		c := WithNoKindMatchRetry(newClient(2, noKindMatch), RetryOptions{Interval: time.Millisecond})
+
+		err := c.Get(ctx, client.ObjectKey{Namespace: "ns", Name: "operand"}, &corev1.ConfigMap{})
+		Expect(err).To(MatchError(ContainSubstring("not found")))
+		Expect(resets).To(Equal(2))
+	})
+
+	It("should give up after the timeout and stop retrying", func() {
+		c := WithNoKindMatchRetry(newClient(1000, noKindMatch), RetryOptions{Interval: time.Millisecond, Timeout: 20 * time.Millisecond})
+
+		err := c.Get(ctx, client.ObjectKey{Namespace: "ns", Name: "operand"}, &corev1.ConfigMap{})
+		Expect(meta.IsNoMatchError(err)).To(BeTrue())
+
+		retried := resets
+		err = c.Get(ctx, client.ObjectKey{Namespace: "ns", Name: "operand"}, &corev1.ConfigMap{})
+		Expect(meta.IsNoMatchError(err)).To(BeTrue())
+		Expect(resets).To(Equal(retried))
+	})
+
+	It("should not retry other errors", func() {
+		c := WithNoKindMatchRetry(newClient(1, errors.New("boom")), RetryOptions{Interval: time.Millisecond})
+
+		err := c.Get(ctx, client.ObjectKey{Namespace: "ns", Name: "operand"}, &corev1.ConfigMap{})
+		Expect(err).To(MatchError("boom"))
+		Expect(resets).To(BeZero())
+	})
+})
+
+var _ = Describe("ResettableMapper", func() {
+	It("should keep the current mapper when a new one cannot be created", func() {
+		current := meta.NewDefaultRESTMapper(nil)
+		current.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+
+		mapper := &ResettableMapper{
+			newMapper: func() (meta.RESTMapper, error) { return nil, errors.New("boom") },
+			mapper:    current,
+		}
+		mapper.Reset()
+
+		mapping, err := mapper.RESTMapping(schema.GroupKind{Kind: "ConfigMap"}, "v1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mapping.Resource.Resource).To(Equal("configmaps"))
+	})
+})
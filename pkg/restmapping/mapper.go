@@ -0,0 +1,117 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package restmapping recovers from "no matches for kind" errors, e.g. when an operator starts before its CRDs are
+// established, by resetting the RESTMapper and retrying the requests for a bounded period.
+package restmapping
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// ResettableMapper is a dynamic RESTMapper whose discovery cache can be reset, implementing
+// meta.ResettableRESTMapper, so that APIs that were not found are discovered again.
+type ResettableMapper struct {
+	newMapper func() (meta.RESTMapper, error)
+
+	mu     sync.RWMutex
+	mapper meta.RESTMapper
+}
+
+var _ meta.ResettableRESTMapper = &ResettableMapper{}
+
+// NewResettableMapper returns a ResettableMapper discovering the APIs lazily, like the default mapper of managers.
+// Its signature matches the MapperProvider of the manager options.
+func NewResettableMapper(cfg *rest.Config, httpClient *http.Client) (meta.RESTMapper, error) {
+	m := &ResettableMapper{
+		newMapper: func() (meta.RESTMapper, error) {
+			return apiutil.NewDynamicRESTMapper(cfg, httpClient)
+		},
+	}
+
+	mapper, err := m.newMapper()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create RESTMapper: %w", err)
+	}
+
+	m.mapper = mapper
+
+	return m, nil
+}
+
+// Reset drops the discovered APIs, to be discovered again on the next mappings.
+// The current mapper is kept when a new one cannot be created.
+func (m *ResettableMapper) Reset() {
+	mapper, err := m.newMapper()
+	if err != nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.mapper = mapper
+}
+
+// getMapper returns the current mapper.
+func (m *ResettableMapper) getMapper() meta.RESTMapper {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.mapper
+}
+
+// KindFor implements meta.RESTMapper.
+func (m *ResettableMapper) KindFor(resource schema.GroupVersionResource) (schema.GroupVersionKind, error) {
+	return m.getMapper().KindFor(resource)
+}
+
+// KindsFor implements meta.RESTMapper.
+func (m *ResettableMapper) KindsFor(resource schema.GroupVersionResource) ([]schema.GroupVersionKind, error) {
+	return m.getMapper().KindsFor(resource)
+}
+
+// ResourceFor implements meta.RESTMapper.
+func (m *ResettableMapper) ResourceFor(input schema.GroupVersionResource) (schema.GroupVersionResource, error) {
+	return m.getMapper().ResourceFor(input)
+}
+
+// ResourcesFor implements meta.RESTMapper.
+func (m *ResettableMapper) ResourcesFor(input schema.GroupVersionResource) ([]schema.GroupVersionResource, error) {
+	return m.getMapper().ResourcesFor(input)
+}
+
+// RESTMapping implements meta.RESTMapper.
+func (m *ResettableMapper) RESTMapping(gk schema.GroupKind, versions ...string) (*meta.RESTMapping, error) {
+	return m.getMapper().RESTMapping(gk, versions...)
+}
+
+// RESTMappings implements meta.RESTMapper.
+func (m *ResettableMapper) RESTMappings(gk schema.GroupKind, versions ...string) ([]*meta.RESTMapping, error) {
+	return m.getMapper().RESTMappings(gk, versions...)
+}
+
+// ResourceSingularizer implements meta.RESTMapper.
+func (m *ResettableMapper) ResourceSingularizer(resource string) (string, error) {
+	return m.getMapper().ResourceSingularizer(resource)
+}
@@ -0,0 +1,341 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runnable
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// DefaultReadyInterval is the default interval at which the readiness of started components is polled.
+const DefaultReadyInterval = time.Second
+
+var (
+	// ErrDuplicateComponent is returned when two components of an Orchestrator have the same name.
+	ErrDuplicateComponent = errors.New("duplicate component")
+
+	// ErrUnknownDependency is returned when a component depends on a component the Orchestrator does not have.
+	ErrUnknownDependency = errors.New("unknown dependency")
+
+	// ErrDependencyCycle is returned when components depend on each other.
+	ErrDependencyCycle = errors.New("dependency cycle")
+
+	// errNotReady is returned by the readiness check of an Orchestrator while components are not ready.
+	errNotReady = errors.New("components are not ready")
+)
+
+// Component is a runnable started by an Orchestrator once the components it depends on are ready.
+type Component struct {
+	// Name identifies the component in dependencies, logs and the readiness check.
+	Name string
+
+	// Runnable is started once the components it depends on are ready. Its NeedLeaderElection, if any, is ignored:
+	// components run when the Orchestrator does.
+	Runnable manager.Runnable
+
+	// DependsOn are the names of the components that must be ready before Runnable is started.
+	DependsOn []string
+
+	// Ready returns nil once the started component is ready, e.g. once a certificate is written or a server listens.
+	// It is polled until then. The component is ready as soon as it is started when Ready is nil.
+	Ready func(ctx context.Context) error
+}
+
+// Orchestrator starts components in the order of their dependencies, rather than in the implicit order of the
+// manager: each component is started once the components it depends on are ready, e.g. a certificate generator
+// before the webhook server serving its certificate, or configuration watchers before the reconcilers using them.
+// It reports the readiness of every component.
+//
+// The Orchestrator stops all the components and returns the error of the first component returning one.
+// Components returning nil are done, e.g. one-shot generators, and stay ready.
+//
+// Example:
+//
+//	orchestrator := &runnable.Orchestrator{
+//	    Components: []runnable.Component{
+//	        {Name: "certificates", Runnable: generator, Ready: generator.Ready},
+//	        {Name: "webhooks", Runnable: server, DependsOn: []string{"certificates"},
+//	            Ready: runnable.ReadyFromChecker(server.StartedChecker())},
+//	    },
+//	    ReadyzCheckName: "components",
+//	}
+//	if err := orchestrator.SetupWithManager(mgr); err != nil {
+//	    return err
+//	}
+type Orchestrator struct {
+	// Components are the components to start.
+	Components []Component
+
+	// LeaderElection runs the Orchestrator only on the elected leader. It runs on every replica otherwise.
+	LeaderElection bool
+
+	// ReadyzCheckName is the name of the readiness check added to the manager by SetupWithManager, failing until
+	// all components are ready. No check is added when empty, which should be the case for Orchestrators run only
+	// on the leader, as the other replicas would never be ready.
+	ReadyzCheckName string
+
+	// ReadyInterval is the interval at which the readiness of started components is polled.
+	// Defaults to DefaultReadyInterval.
+	ReadyInterval time.Duration
+
+	mu    sync.RWMutex
+	ready map[string]bool
+}
+
+// SetupWithManager validates the components and adds the Orchestrator, and its readiness check when
+// ReadyzCheckName is set, to the manager.
+func (o *Orchestrator) SetupWithManager(mgr ctrl.Manager) error {
+	if _, err := o.Order(); err != nil {
+		return err
+	}
+
+	r := manager.Runnable(Always(o))
+	if o.LeaderElection {
+		r = WhenLeader(o)
+	}
+
+	if err := mgr.Add(r); err != nil {
+		return fmt.Errorf("failed to add orchestrator to manager: %w", err)
+	}
+
+	if o.ReadyzCheckName == "" {
+		return nil
+	}
+
+	if err := mgr.AddReadyzCheck(o.ReadyzCheckName, o.Check); err != nil {
+		return fmt.Errorf("failed to add %s readiness check: %w", o.ReadyzCheckName, err)
+	}
+
+	return nil
+}
+
+// Order returns the names of the components in an order satisfying their dependencies, keeping the order of
+// Components otherwise. It returns ErrDuplicateComponent, ErrUnknownDependency or ErrDependencyCycle when the
+// components are invalid.
+func (o *Orchestrator) Order() ([]string, error) {
+	components := make(map[string]Component, len(o.Components))
+	for _, c := range o.Components {
+		if _, ok := components[c.Name]; ok {
+			return nil, fmt.Errorf("%w: %q", ErrDuplicateComponent, c.Name)
+		}
+
+		components[c.Name] = c
+	}
+
+	for _, c := range o.Components {
+		for _, dependency := range c.DependsOn {
+			if _, ok := components[dependency]; !ok {
+				return nil, fmt.Errorf("%w: %q depends on %q", ErrUnknownDependency, c.Name, dependency)
+			}
+		}
+	}
+
+	order := make([]string, 0, len(o.Components))
+	// visiting holds the components being visited, to detect cycles; visited those already ordered.
+	visiting := map[string]bool{}
+	visited := map[string]bool{}
+
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		if visited[name] {
+			return nil
+		}
+
+		path = append(path, name)
+		if visiting[name] {
+			return fmt.Errorf("%w: %s", ErrDependencyCycle, strings.Join(path, " -> "))
+		}
+
+		visiting[name] = true
+
+		for _, dependency := range components[name].DependsOn {
+			if err := visit(dependency, path); err != nil {
+				return err
+			}
+		}
+
+		visited[name] = true
+		order = append(order, name)
+
+		return nil
+	}
+
+	for _, c := range o.Components {
+		if err := visit(c.Name, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return order, nil
+}
+
+// Ready reports whether the named component is ready.
+func (o *Orchestrator) Ready(name string) bool {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	return o.ready[name]
+}
+
+// Check is a healthz.Checker failing until all components are ready.
+func (o *Orchestrator) Check(_ *http.Request) error {
+	var notReady []string
+
+	for _, c := range o.Components {
+		if !o.Ready(c.Name) {
+			notReady = append(notReady, c.Name)
+		}
+	}
+
+	if len(notReady) > 0 {
+		return fmt.Errorf("%w: %s", errNotReady, strings.Join(notReady, ", "))
+	}
+
+	return nil
+}
+
+// Start starts the components in the order of their dependencies and blocks until the context is done or a
+// component fails, in which case all the components are stopped and its error returned.
+func (o *Orchestrator) Start(ctx context.Context) error {
+	order, err := o.Order()
+	if err != nil {
+		return err
+	}
+
+	o.mu.Lock()
+	o.ready = make(map[string]bool, len(order))
+	o.mu.Unlock()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// readiness is closed when the component of the same name is ready.
+	readiness := make(map[string]chan struct{}, len(order))
+	for _, name := range order {
+		readiness[name] = make(chan struct{})
+	}
+
+	errs := make(chan error, len(order))
+
+	var wg sync.WaitGroup
+
+	for _, c := range o.Components {
+		dependencies := make([]chan struct{}, 0, len(c.DependsOn))
+		for _, dependency := range c.DependsOn {
+			dependencies = append(dependencies, readiness[dependency])
+		}
+
+		wg.Go(func() {
+			if err := o.run(ctx, c, dependencies, readiness[c.Name]); err != nil {
+				errs <- err
+
+				cancel()
+			}
+		})
+	}
+
+	wg.Wait()
+	close(errs)
+
+	return <-errs
+}
+
+// run starts c once its dependencies are ready, closes ready once it is ready, and blocks until it returns.
+func (o *Orchestrator) run(ctx context.Context, c Component, dependencies []chan struct{}, ready chan struct{}) error {
+	for _, dependency := range dependencies {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-dependency:
+		}
+	}
+
+	logger := log.FromContext(ctx).WithValues("component", c.Name)
+	logger.Info("Starting component")
+
+	done := make(chan error, 1)
+	go func() {
+		done <- c.Runnable.Start(ctx)
+	}()
+
+	interval := o.ReadyInterval
+	if interval <= 0 {
+		interval = DefaultReadyInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for c.Ready != nil && c.Ready(ctx) != nil {
+		select {
+		case <-ctx.Done():
+			return wait(c, done)
+		case err := <-done:
+			if err != nil {
+				return fmt.Errorf("component %s failed: %w", c.Name, err)
+			}
+
+			// The component is done, its readiness is still polled.
+			done = nil
+		case <-ticker.C:
+		}
+	}
+
+	o.mu.Lock()
+	o.ready[c.Name] = true
+	o.mu.Unlock()
+	close(ready)
+	logger.Info("Component is ready")
+
+	return wait(c, done)
+}
+
+// wait waits for the component c to return on done, unless it already did when done is nil.
+func wait(c Component, done chan error) error {
+	if done == nil {
+		return nil
+	}
+
+	if err := <-done; err != nil {
+		return fmt.Errorf("component %s failed: %w", c.Name, err)
+	}
+
+	return nil
+}
+
+// ReadyFromChecker adapts a healthz.Checker, e.g. the StartedChecker of a webhook server, to the Ready of a
+// Component.
+func ReadyFromChecker(checker healthz.Checker) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, "/", nil)
+		if err != nil {
+			return fmt.Errorf("failed to create readiness request: %w", err)
+		}
+
+		return checker(request)
+	}
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runnable
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+var _ = Describe("Orchestrator", func() {
+	var (
+		mu      sync.Mutex
+		started []string
+	)
+
+	BeforeEach(func() {
+		started = nil
+	})
+
+	// component returns a component recording its start and blocking until the context is done.
+	component := func(name string, dependsOn ...string) Component {
+		return Component{
+			Name: name,
+			Runnable: manager.RunnableFunc(func(ctx context.Context) error {
+				mu.Lock()
+				started = append(started, name)
+				mu.Unlock()
+				<-ctx.Done()
+
+				return nil
+			}),
+			DependsOn: dependsOn,
+		}
+	}
+
+	startedComponents := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return append([]string(nil), started...)
+	}
+
+	Describe("Order", func() {
+		It("should order components after their dependencies", func() {
+			o := &Orchestrator{Components: []Component{
+				component("reconcilers", "config"),
+				component("webhooks", "certificates"),
+				component("certificates"),
+				component("config"),
+			}}
+
+			Expect(o.Order()).To(Equal([]string{"config", "reconcilers", "certificates", "webhooks"}))
+		})
+
+		DescribeTable("should reject invalid components",
+			func(components []Component, expected error) {
+				_, err := (&Orchestrator{Components: components}).Order()
+				Expect(err).To(MatchError(expected))
+			},
+			Entry("duplicate", []Component{component("a"), component("a")}, ErrDuplicateComponent),
+			Entry("unknown dependency", []Component{component("a", "b")}, ErrUnknownDependency),
+			Entry("cycle", []Component{component("a", "b"), component("b", "c"), component("c", "a")}, ErrDependencyCycle),
+		)
+	})
+
+	It("should start components once their dependencies are ready", func() {
+		var certificatesReady atomic.Bool
+
+		certificates := component("certificates")
+		certificates.Ready = func(context.Context) error {
+			if !certificatesReady.Load() {
+				return errors.New("no certificate")
+			}
+
+			return nil
+		}
+
+		o := &Orchestrator{
+			Components:    []Component{component("webhooks", "certificates"), certificates},
+			ReadyInterval: 10 * time.Millisecond,
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() {
+			done <- o.Start(ctx)
+		}()
+
+		Eventually(startedComponents).Should(Equal([]string{"certificates"}))
+		Consistently(startedComponents, 50*time.Millisecond).Should(Equal([]string{"certificates"}))
+		Expect(o.Ready("certificates")).To(BeFalse())
+		Expect(o.Check(nil)).To(MatchError(ContainSubstring("webhooks, certificates")))
+
+		certificatesReady.Store(true)
+		Eventually(startedComponents).Should(Equal([]string{"certificates", "webhooks"}))
+		Eventually(func() error { return o.Check(nil) }).Should(Succeed())
+		Expect(o.Ready("webhooks")).To(BeTrue())
+
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+	})
+
+	It("should keep components done before being ready", func() {
+		var generated atomic.Bool
+
+		generator := Component{
+			Name: "generator",
+			Runnable: manager.RunnableFunc(func(context.Context) error {
+				generated.Store(true)
+				return nil
+			}),
+			Ready: func(context.Context) error {
+				if !generated.Load() {
+					return errors.New("not generated")
+				}
+
+				return nil
+			},
+		}
+
+		o := &Orchestrator{Components: []Component{generator, component("server", "generator")}, ReadyInterval: 10 * time.Millisecond}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() {
+			done <- o.Start(ctx)
+		}()
+
+		Eventually(startedComponents).Should(Equal([]string{"server"}))
+		Expect(o.Check(nil)).To(Succeed())
+
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+	})
+
+	It("should stop all components when one fails", func() {
+		failing := Component{
+			Name: "failing",
+			Runnable: manager.RunnableFunc(func(context.Context) error {
+				return errors.New("boom")
+			}),
+			DependsOn: []string{"first"},
+			Ready: func(context.Context) error {
+				return errors.New("not ready")
+			},
+		}
+
+		o := &Orchestrator{Components: []Component{component("first"), failing, component("never", "failing")}}
+
+		done := make(chan error)
+		go func() {
+			done <- o.Start(context.Background())
+		}()
+
+		Eventually(done).Should(Receive(MatchError("component failing failed: boom")))
+		Expect(startedComponents()).To(Equal([]string{"first"}))
+		Expect(o.Ready("never")).To(BeFalse())
+	})
+})
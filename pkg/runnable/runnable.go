@@ -0,0 +1,148 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package runnable declares whether runnables added to a manager run on every replica or only on the elected
+// leader. The manager runs runnables that do not implement manager.LeaderElectionRunnable only on the leader, so a
+// component that forgets NeedLeaderElection silently stops serving on the other replicas; Always and WhenLeader make
+// the intent explicit where the runnable is added. The Orchestrator starts runnables in the order of their
+// dependencies.
+package runnable
+
+import (
+	"context"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// AlwaysRunnable is a runnable declared with Always to run on every replica, whether it is the leader or not, e.g.
+// certificate watchers, metrics exporters or servers. Functions accepting an AlwaysRunnable require callers to
+// declare the intent rather than relying on NeedLeaderElection.
+type AlwaysRunnable interface {
+	manager.Runnable
+	manager.LeaderElectionRunnable
+
+	always()
+}
+
+// LeaderRunnable is a runnable declared with WhenLeader to run only on the elected leader, e.g. components writing
+// to the cluster.
+type LeaderRunnable interface {
+	manager.Runnable
+	manager.LeaderElectionRunnable
+
+	whenLeader()
+}
+
+// Always returns r declared to run on every replica: NeedLeaderElection returns false, regardless of r.
+// It must not be used for caches, webhook servers or the metrics server, as the manager starts them specifically.
+//
+// Example:
+//
+//	if err := mgr.Add(runnable.Always(watcher)); err != nil {
+//	    return fmt.Errorf("failed to add certificate watcher to manager: %w", err)
+//	}
+func Always(r manager.Runnable) AlwaysRunnable {
+	return &always{Runnable: r}
+}
+
+// WhenLeader returns r declared to run only on the elected leader: NeedLeaderElection returns true, regardless of r.
+// The Warmup of r, if any, is kept so that controllers still warm up before becoming leader.
+// It must not be used for caches, webhook servers or the metrics server, as the manager starts them specifically.
+//
+// Example:
+//
+//	if err := mgr.Add(runnable.WhenLeader(manager.RunnableFunc(gc.Run))); err != nil {
+//	    return fmt.Errorf("failed to add garbage collector to manager: %w", err)
+//	}
+func WhenLeader(r manager.Runnable) LeaderRunnable {
+	if w, ok := r.(warmupRunnable); ok {
+		return &leaderWithWarmup{leader: leader{Runnable: r}, warmup: w}
+	}
+
+	return &leader{Runnable: r}
+}
+
+// AddAlways adds r to the manager to run on every replica.
+func AddAlways(mgr ctrl.Manager, r manager.Runnable) error {
+	if err := mgr.Add(Always(r)); err != nil {
+		return fmt.Errorf("failed to add %T to manager: %w", r, err)
+	}
+
+	return nil
+}
+
+// AddWhenLeader adds r to the manager to run only on the elected leader.
+func AddWhenLeader(mgr ctrl.Manager, r manager.Runnable) error {
+	if err := mgr.Add(WhenLeader(r)); err != nil {
+		return fmt.Errorf("failed to add %T to manager: %w", r, err)
+	}
+
+	return nil
+}
+
+// NeedsLeaderElection reports whether the manager runs r only on the elected leader, as it decides it: runnables
+// that do not implement manager.LeaderElectionRunnable run only on the leader. It is meant for tests asserting how
+// components are run.
+func NeedsLeaderElection(r manager.Runnable) bool {
+	if r, ok := r.(manager.LeaderElectionRunnable); ok {
+		return r.NeedLeaderElection()
+	}
+
+	return true
+}
+
+// warmupRunnable is implemented by runnables warming up before leader election, such as controllers.
+type warmupRunnable interface {
+	Warmup(ctx context.Context) error
+}
+
+// always runs the wrapped runnable on every replica.
+type always struct {
+	manager.Runnable
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+func (*always) NeedLeaderElection() bool {
+	return false
+}
+
+func (*always) always() {}
+
+// leader runs the wrapped runnable only on the elected leader.
+type leader struct {
+	manager.Runnable
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+func (*leader) NeedLeaderElection() bool {
+	return true
+}
+
+func (*leader) whenLeader() {}
+
+// leaderWithWarmup runs the wrapped runnable only on the elected leader, warming it up beforehand.
+type leaderWithWarmup struct {
+	leader
+
+	warmup warmupRunnable
+}
+
+// Warmup warms up the wrapped runnable.
+func (l *leaderWithWarmup) Warmup(ctx context.Context) error {
+	return l.warmup.Warmup(ctx)
+}
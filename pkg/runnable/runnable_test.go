@@ -0,0 +1,81 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runnable
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// warmedUp is a runnable recording its warmup, needing leader election unless configured otherwise.
+type warmedUp struct {
+	needLeaderElection bool
+	warm               bool
+}
+
+func (w *warmedUp) Start(context.Context) error {
+	return nil
+}
+
+func (w *warmedUp) NeedLeaderElection() bool {
+	return w.needLeaderElection
+}
+
+func (w *warmedUp) Warmup(context.Context) error {
+	w.warm = true
+
+	return nil
+}
+
+var _ = Describe("Always and WhenLeader", func() {
+	noop := manager.RunnableFunc(func(context.Context) error { return nil })
+
+	It("should override the leader election of runnables", func() {
+		Expect(NeedsLeaderElection(noop)).To(BeTrue())
+		Expect(NeedsLeaderElection(Always(noop))).To(BeFalse())
+		Expect(NeedsLeaderElection(Always(&warmedUp{needLeaderElection: true}))).To(BeFalse())
+
+		Expect(NeedsLeaderElection(&warmedUp{})).To(BeFalse())
+		Expect(NeedsLeaderElection(WhenLeader(&warmedUp{}))).To(BeTrue())
+		Expect(NeedsLeaderElection(WhenLeader(noop))).To(BeTrue())
+	})
+
+	It("should keep the warmup of leader runnables", func() {
+		Expect(WhenLeader(noop)).NotTo(BeAssignableToTypeOf(&leaderWithWarmup{}))
+
+		r := &warmedUp{}
+		declared, ok := WhenLeader(r).(warmupRunnable)
+		Expect(ok).To(BeTrue())
+		Expect(declared.Warmup(context.Background())).To(Succeed())
+		Expect(r.warm).To(BeTrue())
+	})
+
+	It("should start the wrapped runnables", func() {
+		started := 0
+		r := manager.RunnableFunc(func(context.Context) error {
+			started++
+			return nil
+		})
+
+		Expect(Always(r).Start(context.Background())).To(Succeed())
+		Expect(WhenLeader(r).Start(context.Background())).To(Succeed())
+		Expect(started).To(Equal(2))
+	})
+})
@@ -0,0 +1,326 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package secretsync provides a controller keeping copies of Secrets in sync across namespaces, e.g. CA bundles or pull
+// secrets replicated into the namespaces of the operands.
+package secretsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	"github.com/openshift/controller-runtime-common/pkg/projection"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	// SyncLabel is the label opting a source Secret in to being synchronized, when set to "true", so that Secrets are
+	// only copied out of their namespace with the consent of their owner.
+	SyncLabel = "secretsync.openshift.io/sync"
+
+	// DefaultControllerName is the default name of the controller.
+	DefaultControllerName = "secretsync"
+
+	// ReasonDestinationConflict is the reason of the event recorded when the destination of a mapping exists and is
+	// not a copy of its source.
+	ReasonDestinationConflict = "SecretSyncConflict"
+)
+
+var (
+	// ErrSourceNotAllowed is returned when a mapping has a source outside of the allowed source namespaces.
+	ErrSourceNotAllowed = errors.New("source namespace is not allowed")
+	// ErrDuplicateDestination is returned when several mappings have the same destination.
+	ErrDuplicateDestination = errors.New("duplicate destination")
+)
+
+// Mapping declares the copy of a source Secret into a destination.
+type Mapping struct {
+	// Source is the namespace and name of the source Secret.
+	Source client.ObjectKey
+
+	// Destination is the namespace and name of the copy.
+	Destination client.ObjectKey
+
+	// Keys maps the keys of the source to copy to their keys in the copy. All the keys are copied when empty.
+	Keys map[string]string
+}
+
+// Controller keeps the copies of the Mappings in sync with their sources, copying the sources labeled with SyncLabel
+// set to "true" and deleting the copies of the sources that are missing or not labeled. Copies are annotated as
+// projections of their sources, and destinations that are not, e.g. Secrets created by users, are left as they are
+// and reported with a ReasonDestinationConflict event.
+//
+// SetupWithManager watches the Secrets with a dedicated cache restricted to the namespaces of the sources and
+// destinations, which also serves the reads of Client, so that the Secrets of the other namespaces are not cached.
+//
+// The operator needs RBAC to get, list and watch Secrets in the namespaces of the sources and destinations, and to
+// create, update and delete them in the namespaces of the destinations.
+//
+// Example:
+//
+//	syncer := &secretsync.Controller{
+//	    Client:                  mgr.GetClient(),
+//	    Recorder:                recorder,
+//	    AllowedSourceNamespaces: []string{"openshift-config"},
+//	    Mappings: []secretsync.Mapping{{
+//	        Source:      client.ObjectKey{Namespace: "openshift-config", Name: "pull-secret"},
+//	        Destination: client.ObjectKey{Namespace: "openshift-example", Name: "pull-secret"},
+//	    }},
+//	}
+//	if err := syncer.SetupWithManager(mgr); err != nil {
+//	    return err
+//	}
+type Controller struct {
+	client.Client
+
+	// Name is the name of the controller. Defaults to DefaultControllerName.
+	Name string
+
+	// Recorder records the changes of the copies as events when set.
+	Recorder events.EventRecorder
+
+	// AllowedSourceNamespaces are the namespaces sources may be in. Sources may be in any namespace when empty.
+	AllowedSourceNamespaces []string
+
+	// Mappings are the copies to keep in sync.
+	Mappings []Mapping
+
+	byDestination map[client.ObjectKey]Mapping
+	bySource      map[client.ObjectKey][]client.ObjectKey
+
+	// secrets is the cache of the Secrets of the namespaces of the mappings, set by SetupWithManager.
+	secrets cache.Cache
+}
+
+// Validate checks that the sources of the mappings are allowed and that their destinations are unique.
+func (r *Controller) Validate() error {
+	destinations := map[client.ObjectKey]bool{}
+
+	for _, mapping := range r.Mappings {
+		if len(r.AllowedSourceNamespaces) > 0 && !slices.Contains(r.AllowedSourceNamespaces, mapping.Source.Namespace) {
+			return fmt.Errorf("%w: %s", ErrSourceNotAllowed, mapping.Source.String())
+		}
+
+		if destinations[mapping.Destination] {
+			return fmt.Errorf("%w: %s", ErrDuplicateDestination, mapping.Destination.String())
+		}
+
+		destinations[mapping.Destination] = true
+	}
+
+	return nil
+}
+
+// SetupWithManager validates the mappings and sets up the controller with the Manager.
+func (r *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	r.index()
+
+	name := r.Name
+	if name == "" {
+		name = DefaultControllerName
+	}
+
+	secrets, err := cache.New(mgr.GetConfig(), cache.Options{
+		HTTPClient:        mgr.GetHTTPClient(),
+		Scheme:            mgr.GetScheme(),
+		Mapper:            mgr.GetRESTMapper(),
+		DefaultNamespaces: r.namespaces(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create cache for %s: %w", name, err)
+	}
+
+	if err := mgr.Add(secrets); err != nil {
+		return fmt.Errorf("failed to add cache for %s to manager: %w", name, err)
+	}
+
+	r.secrets = secrets
+
+	if err := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WatchesRawSource(source.Kind(secrets, &corev1.Secret{},
+			handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, secret *corev1.Secret) []reconcile.Request {
+				return r.destinationsOf(ctx, secret)
+			}))).
+		// Override the default log constructor as it makes the logs very chatty.
+		WithLogConstructor(func(_ *reconcile.Request) logr.Logger {
+			return mgr.GetLogger().WithValues(
+				"controller", name,
+			)
+		}).
+		Complete(r); err != nil {
+		return fmt.Errorf("could not set up controller for %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// Reconcile synchronizes the copy of the destination of the request with its source.
+func (r *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx, "destination", req.NamespacedName.String())
+
+	logger.V(1).Info("Reconciling Secret copy")
+	defer logger.V(1).Info("Finished reconciling Secret copy")
+
+	mapping, ok := r.byDestination[req.NamespacedName]
+	if !ok {
+		return ctrl.Result{}, nil
+	}
+
+	c := r.secretClient()
+	projector := &projection.Projector{Client: c, Recorder: r.Recorder}
+	p := projection.Projection{
+		Source:      mapping.Source,
+		Destination: mapping.Destination,
+		Keys:        mapping.Keys,
+	}
+
+	sourceSecret := &corev1.Secret{}
+	err := c.Get(ctx, mapping.Source, sourceSecret)
+
+	if err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, fmt.Errorf("failed to get source Secret %s: %w", mapping.Source.String(), err)
+	}
+
+	if err != nil || sourceSecret.Labels[SyncLabel] != "true" {
+		// The source is gone or did not opt in, its copy has to go.
+		deleted, err := projector.Remove(ctx, &corev1.Secret{}, p)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		if !deleted {
+			logger.V(1).Info("Skipping source Secret that does not exist or is not labeled for sync",
+				"source", mapping.Source.String(), "label", SyncLabel)
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	if _, _, err := projector.ProjectSecret(ctx, nil, p); err != nil {
+		if errors.Is(err, projection.ErrConflict) {
+			// The destination belongs to someone else, retrying cannot succeed until it is deleted, which is watched.
+			logger.Info("Skipping destination Secret that is not a copy of its source", "source", mapping.Source.String())
+			r.recordConflict(mapping)
+
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, fmt.Errorf("failed to sync Secret %s to %s: %w", mapping.Source.String(),
+			mapping.Destination.String(), err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// secretClient returns the client reading the Secrets from the cache of the controller, once set up.
+func (r *Controller) secretClient() client.Client {
+	if r.secrets == nil {
+		return r.Client
+	}
+
+	return &cachedClient{Client: r.Client, cache: r.secrets}
+}
+
+// recordConflict records an event on the destination of the mapping, which is not a copy of its source, when a
+// recorder is set.
+func (r *Controller) recordConflict(mapping Mapping) {
+	if r.Recorder == nil {
+		return
+	}
+
+	destination := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Namespace: mapping.Destination.Namespace,
+		Name:      mapping.Destination.Name,
+	}}
+	r.Recorder.Eventf(destination, nil, corev1.EventTypeWarning, ReasonDestinationConflict, "Sync",
+		"Secret %s exists and is not a copy of %s, it is left as it is", mapping.Destination.String(),
+		mapping.Source.String())
+}
+
+// namespaces returns the namespaces of the sources and destinations of the mappings.
+func (r *Controller) namespaces() map[string]cache.Config {
+	namespaces := map[string]cache.Config{}
+
+	for _, mapping := range r.Mappings {
+		namespaces[mapping.Source.Namespace] = cache.Config{}
+		namespaces[mapping.Destination.Namespace] = cache.Config{}
+	}
+
+	return namespaces
+}
+
+// index indexes the mappings by destination and source.
+func (r *Controller) index() {
+	r.byDestination = map[client.ObjectKey]Mapping{}
+	r.bySource = map[client.ObjectKey][]client.ObjectKey{}
+
+	for _, mapping := range r.Mappings {
+		r.byDestination[mapping.Destination] = mapping
+		r.bySource[mapping.Source] = append(r.bySource[mapping.Source], mapping.Destination)
+	}
+}
+
+// destinationsOf returns the requests for the destinations of the Secret, as a source or a destination.
+func (r *Controller) destinationsOf(_ context.Context, obj client.Object) []reconcile.Request {
+	key := client.ObjectKeyFromObject(obj)
+
+	var requests []reconcile.Request
+
+	for _, destination := range r.bySource[key] {
+		requests = append(requests, reconcile.Request{NamespacedName: destination})
+	}
+
+	if _, ok := r.byDestination[key]; ok {
+		requests = append(requests, reconcile.Request{NamespacedName: key})
+	}
+
+	return requests
+}
+
+// cachedClient is a client reading from a cache rather than from the cache of its manager.
+type cachedClient struct {
+	client.Client
+
+	cache client.Reader
+}
+
+// Get implements client.Client.
+func (c *cachedClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	return c.cache.Get(ctx, key, obj, opts...)
+}
+
+// List implements client.Client.
+func (c *cachedClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return c.cache.List(ctx, list, opts...)
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretsync
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/controller-runtime-common/pkg/projection"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("Controller", func() {
+	var (
+		ctx        context.Context
+		c          client.Client
+		recorder   *events.FakeRecorder
+		source     *corev1.Secret
+		controller *Controller
+	)
+
+	sourceKey := client.ObjectKey{Namespace: "openshift-config", Name: "pull-secret"}
+	destinationKey := client.ObjectKey{Namespace: "openshift-example", Name: "pull-secret"}
+	req := ctrl.Request{NamespacedName: destinationKey}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		source = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: sourceKey.Namespace,
+				Name:      sourceKey.Name,
+				Labels:    map[string]string{SyncLabel: "true"},
+			},
+			Type: corev1.SecretTypeDockerConfigJson,
+			Data: map[string][]byte{corev1.DockerConfigJsonKey: []byte("{}")},
+		}
+		c = fake.NewClientBuilder().WithObjects(source).Build()
+		recorder = events.NewFakeRecorder(10)
+
+		controller = &Controller{
+			Client:                  c,
+			Recorder:                recorder,
+			AllowedSourceNamespaces: []string{"openshift-config"},
+			Mappings:                []Mapping{{Source: sourceKey, Destination: destinationKey}},
+		}
+		Expect(controller.Validate()).To(Succeed())
+		controller.index()
+	})
+
+	It("should validate the mappings", func() {
+		controller.Mappings = append(controller.Mappings, Mapping{
+			Source:      client.ObjectKey{Namespace: "kube-system", Name: "secret"},
+			Destination: client.ObjectKey{Namespace: "openshift-example", Name: "secret"},
+		})
+		Expect(controller.Validate()).To(MatchError(ErrSourceNotAllowed))
+
+		controller.Mappings[1].Source.Namespace = "openshift-config"
+		controller.Mappings[1].Destination = destinationKey
+		Expect(controller.Validate()).To(MatchError(ErrDuplicateDestination))
+	})
+
+	It("should cache the Secrets of the namespaces of the mappings", func() {
+		Expect(controller.namespaces()).To(HaveLen(2))
+		Expect(controller.namespaces()).To(HaveKey(sourceKey.Namespace))
+		Expect(controller.namespaces()).To(HaveKey(destinationKey.Namespace))
+	})
+
+	It("should map the events of sources and destinations to their destinations", func() {
+		Expect(controller.destinationsOf(ctx, source)).To(Equal([]reconcile.Request{req}))
+		Expect(controller.destinationsOf(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+			Namespace: destinationKey.Namespace, Name: destinationKey.Name,
+		}})).To(Equal([]reconcile.Request{req}))
+		Expect(controller.destinationsOf(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+			Namespace: "other", Name: "pull-secret",
+		}})).To(BeEmpty())
+	})
+
+	It("should sync the copy while the source is labeled", func() {
+		Expect(controller.Reconcile(ctx, req)).To(Equal(ctrl.Result{}))
+
+		copied := &corev1.Secret{}
+		Expect(c.Get(ctx, destinationKey, copied)).To(Succeed())
+		Expect(copied.Type).To(Equal(corev1.SecretTypeDockerConfigJson))
+		Expect(copied.Data).To(Equal(source.Data))
+		Expect(copied.Annotations).To(HaveKeyWithValue(projection.SourceAnnotation, sourceKey.String()))
+
+		source.Data[corev1.DockerConfigJsonKey] = []byte(`{"auths":{}}`)
+		Expect(c.Update(ctx, source)).To(Succeed())
+		Expect(controller.Reconcile(ctx, req)).To(Equal(ctrl.Result{}))
+		Expect(c.Get(ctx, destinationKey, copied)).To(Succeed())
+		Expect(copied.Data).To(Equal(source.Data))
+
+		delete(source.Labels, SyncLabel)
+		Expect(c.Update(ctx, source)).To(Succeed())
+		Expect(controller.Reconcile(ctx, req)).To(Equal(ctrl.Result{}))
+		Expect(c.Get(ctx, destinationKey, copied)).NotTo(Succeed())
+	})
+
+	It("should delete the copy when the source is deleted", func() {
+		Expect(controller.Reconcile(ctx, req)).To(Equal(ctrl.Result{}))
+		Expect(c.Delete(ctx, source)).To(Succeed())
+
+		Expect(controller.Reconcile(ctx, req)).To(Equal(ctrl.Result{}))
+		Expect(c.Get(ctx, destinationKey, &corev1.Secret{})).NotTo(Succeed())
+	})
+
+	It("should leave the Secrets that are not copies alone", func() {
+		userSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: destinationKey.Namespace, Name: destinationKey.Name},
+			Data:       map[string][]byte{"user": []byte("data")},
+		}
+		Expect(c.Create(ctx, userSecret)).To(Succeed())
+
+		Expect(controller.Reconcile(ctx, req)).To(Equal(ctrl.Result{}))
+		Expect(recorder.Events).To(Receive(HavePrefix("Warning " + ReasonDestinationConflict)))
+		Expect(c.Get(ctx, destinationKey, userSecret)).To(Succeed())
+		Expect(userSecret.Data).To(HaveKey("user"))
+
+		delete(source.Labels, SyncLabel)
+		Expect(c.Update(ctx, source)).To(Succeed())
+		Expect(controller.Reconcile(ctx, req)).To(Equal(ctrl.Result{}))
+		Expect(c.Get(ctx, destinationKey, &corev1.Secret{})).To(Succeed())
+	})
+})
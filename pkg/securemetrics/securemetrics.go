@@ -0,0 +1,126 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package securemetrics configures the controller-runtime metrics server the way OpenShift operators serve metrics:
+// over TLS with the cluster TLS profile and a service serving certificate, authenticating and authorizing scrapers
+// without a kube-rbac-proxy sidecar, on a port layout matching ServiceMonitors.
+package securemetrics
+
+import (
+	"crypto/tls"
+	"fmt"
+
+	configv1 "github.com/openshift/api/config/v1"
+	crtls "github.com/openshift/controller-runtime-common/pkg/tls"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+)
+
+const (
+	// DefaultPort is the port metrics are served on.
+	DefaultPort = 8443
+	// PortName is the name of the container and Service ports serving metrics, selected by ServiceMonitors.
+	PortName = "metrics"
+	// Path is the path metrics are served on.
+	Path = "/metrics"
+
+	// DefaultCertDir is the directory the service serving certificate Secret is mounted in.
+	DefaultCertDir = "/etc/tls/private"
+	// CertName is the name of the certificate file in the service serving certificate Secret.
+	CertName = corev1.TLSCertKey
+	// KeyName is the name of the key file in the service serving certificate Secret.
+	KeyName = corev1.TLSPrivateKeyKey
+)
+
+// Options configure the metrics server.
+type Options struct {
+	// BindAddress is the address metrics are served on. Defaults to DefaultPort on all interfaces, and "0" disables
+	// the metrics server.
+	BindAddress string
+
+	// CertDir is the directory holding the serving certificate and key. Defaults to DefaultCertDir.
+	CertDir string
+
+	// TLSProfile is the TLS profile to serve with, typically fetched with tls.FetchAPIServerTLSProfile and watched
+	// with a tls.SecurityProfileWatcher restarting the operator when it changes. Defaults to the default profile.
+	TLSProfile *configv1.TLSProfileSpec
+
+	// DisableAuth disables the authentication and authorization of scrapers, e.g. for local development.
+	DisableAuth bool
+}
+
+// ServerOptions returns the options of the controller-runtime metrics server serving metrics securely, along with the
+// ciphers of the TLS profile that are not supported, to log. Scrapers are authenticated with TokenReviews and
+// authorized with SubjectAccessReviews for the get verb on the metrics path, which the operator needs RBAC to create.
+//
+// Example:
+//
+//	profile, err := tls.FetchAPIServerTLSProfile(ctx, setupClient)
+//	if err != nil {
+//	    return err
+//	}
+//	metricsOptions, unsupportedCiphers := securemetrics.ServerOptions(securemetrics.Options{TLSProfile: &profile})
+//	mgr, err := ctrl.NewManager(cfg, ctrl.Options{Metrics: metricsOptions})
+func ServerOptions(opts Options) (metricsserver.Options, []string) {
+	profile := opts.TLSProfile
+	if profile == nil {
+		defaultProfile, _ := crtls.GetTLSProfileSpec(nil)
+		profile = &defaultProfile
+	}
+
+	tlsConfig, unsupportedCiphers := crtls.NewTLSConfigFromProfile(*profile)
+
+	serverOptions := metricsserver.Options{
+		SecureServing: true,
+		BindAddress:   opts.BindAddress,
+		CertDir:       opts.CertDir,
+		CertName:      CertName,
+		KeyName:       KeyName,
+		TLSOpts:       []func(*tls.Config){tlsConfig},
+	}
+
+	if serverOptions.BindAddress == "" {
+		serverOptions.BindAddress = fmt.Sprintf(":%d", DefaultPort)
+	}
+
+	if serverOptions.CertDir == "" {
+		serverOptions.CertDir = DefaultCertDir
+	}
+
+	if !opts.DisableAuth {
+		serverOptions.FilterProvider = filters.WithAuthenticationAndAuthorization
+	}
+
+	return serverOptions, unsupportedCiphers
+}
+
+// ContainerPort returns the port of the operator container serving metrics.
+func ContainerPort() corev1.ContainerPort {
+	return corev1.ContainerPort{Name: PortName, ContainerPort: DefaultPort, Protocol: corev1.ProtocolTCP}
+}
+
+// ServicePort returns the port of the Service exposing metrics to a ServiceMonitor, whose endpoint selects PortName
+// with the https scheme and Path.
+func ServicePort() corev1.ServicePort {
+	return corev1.ServicePort{
+		Name:       PortName,
+		Port:       DefaultPort,
+		TargetPort: intstr.FromString(PortName),
+		Protocol:   corev1.ProtocolTCP,
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securemetrics
+
+import (
+	"crypto/tls"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ = Describe("ServerOptions", func() {
+	It("should serve securely by default", func() {
+		options, unsupportedCiphers := ServerOptions(Options{})
+		Expect(unsupportedCiphers).To(BeEmpty())
+
+		Expect(options.SecureServing).To(BeTrue())
+		Expect(options.BindAddress).To(Equal(":8443"))
+		Expect(options.CertDir).To(Equal(DefaultCertDir))
+		Expect(options.CertName).To(Equal("tls.crt"))
+		Expect(options.KeyName).To(Equal("tls.key"))
+		Expect(options.FilterProvider).NotTo(BeNil())
+
+		tlsConfig := &tls.Config{}
+		for _, opt := range options.TLSOpts {
+			opt(tlsConfig)
+		}
+
+		Expect(tlsConfig.MinVersion).To(Equal(uint16(tls.VersionTLS12)))
+		Expect(tlsConfig.CipherSuites).NotTo(BeEmpty())
+	})
+
+	It("should honor the options", func() {
+		profile := *configv1.TLSProfiles[configv1.TLSProfileModernType]
+		options, _ := ServerOptions(Options{BindAddress: "0", CertDir: "/certs", TLSProfile: &profile, DisableAuth: true})
+
+		Expect(options.BindAddress).To(Equal("0"))
+		Expect(options.CertDir).To(Equal("/certs"))
+		Expect(options.FilterProvider).To(BeNil())
+
+		tlsConfig := &tls.Config{}
+		for _, opt := range options.TLSOpts {
+			opt(tlsConfig)
+		}
+
+		Expect(tlsConfig.MinVersion).To(Equal(uint16(tls.VersionTLS13)))
+	})
+
+	It("should expose matching container and service ports", func() {
+		Expect(ContainerPort().ContainerPort).To(Equal(ServicePort().Port))
+		Expect(ServicePort().TargetPort).To(Equal(intstr.FromString(ContainerPort().Name)))
+	})
+})
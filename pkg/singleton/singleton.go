@@ -0,0 +1,142 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package singleton watches a single named cluster-scoped object, such as the cluster configuration objects of
+// OpenShift, without caching the other objects of its kind.
+package singleton
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// HandlerFunc handles the current state of the watched object, which is nil once it has been deleted.
+// Errors are retried with backoff.
+type HandlerFunc[T client.Object] func(ctx context.Context, obj T) error
+
+// Watch calls fn with the cluster-scoped object of the type of obj with the given name, once it has been cached and
+// whenever it changes. The object is cached by a dedicated cache selecting it by name, so that the informer does not
+// cache the other objects of its kind, and the controller runs on every replica.
+//
+// The controller is named after the kind and name of the object, e.g. "apiserver-cluster", so an object is watched
+// once per manager.
+//
+// Example:
+//
+//	err := singleton.Watch(mgr, &configv1.APIServer{}, "cluster", func(ctx context.Context, apiServer *configv1.APIServer) error {
+//	    if apiServer == nil {
+//	        return nil
+//	    }
+//
+//	    return r.applyTLSProfile(ctx, apiServer.Spec.TLSSecurityProfile)
+//	})
+func Watch[T client.Object](mgr ctrl.Manager, obj T, name string, fn HandlerFunc[T]) error {
+	gvk, err := apiutil.GVKForObject(obj, mgr.GetScheme())
+	if err != nil {
+		return fmt.Errorf("failed to get GroupVersionKind of %T: %w", obj, err)
+	}
+
+	controllerName := strings.ToLower(gvk.Kind) + "-" + name
+
+	objectCache, err := cache.New(mgr.GetConfig(), cache.Options{
+		HTTPClient: mgr.GetHTTPClient(),
+		Scheme:     mgr.GetScheme(),
+		Mapper:     mgr.GetRESTMapper(),
+		ByObject: map[client.Object]cache.ByObject{
+			obj: {Field: fields.OneTermEqualSelector("metadata.name", name)},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create cache for %s: %w", controllerName, err)
+	}
+
+	if err := mgr.Add(objectCache); err != nil {
+		return fmt.Errorf("failed to add cache for %s to manager: %w", controllerName, err)
+	}
+
+	isObject := predicate.NewTypedPredicateFuncs(func(o T) bool {
+		// The field selector already filters the object, this guards against fake or misconfigured caches.
+		return o.GetName() == name
+	})
+
+	r := &reconciler[T]{reader: objectCache, obj: obj, fn: fn}
+
+	if err := ctrl.NewControllerManagedBy(mgr).
+		Named(controllerName).
+		WithOptions(controller.Options{NeedLeaderElection: ptr.To(false)}).
+		WatchesRawSource(source.Kind(objectCache, obj, &handler.TypedEnqueueRequestForObject[T]{}, isObject)).
+		// Override the default log constructor as it makes the logs very chatty.
+		WithLogConstructor(func(_ *reconcile.Request) logr.Logger {
+			return mgr.GetLogger().WithValues(
+				"controller", controllerName,
+			)
+		}).
+		Complete(r); err != nil {
+		return fmt.Errorf("could not set up controller for %s: %w", controllerName, err)
+	}
+
+	return nil
+}
+
+// reconciler calls the handler with the current state of the watched object.
+type reconciler[T client.Object] struct {
+	reader client.Reader
+	obj    T
+	fn     HandlerFunc[T]
+}
+
+// Reconcile calls the handler with the object of the request, or nil when it is not found.
+func (r *reconciler[T]) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx, "name", req.Name)
+
+	logger.V(1).Info("Reconciling singleton")
+	defer logger.V(1).Info("Finished reconciling singleton")
+
+	obj, ok := r.obj.DeepCopyObject().(T)
+	if !ok {
+		return ctrl.Result{}, fmt.Errorf("unexpected copy of %T", r.obj)
+	}
+
+	if err := r.reader.Get(ctx, req.NamespacedName, obj); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to get %q: %w", req.Name, err)
+		}
+
+		var deleted T
+		obj = deleted
+	}
+
+	if err := r.fn(ctx, obj); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to handle %q: %w", req.Name, err)
+	}
+
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package singleton
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("reconciler", func() {
+	var (
+		ctx     context.Context
+		scheme  *runtime.Scheme
+		req     ctrl.Request
+		handled []*configv1.APIServer
+		fn      HandlerFunc[*configv1.APIServer]
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(configv1.AddToScheme(scheme)).To(Succeed())
+		req = ctrl.Request{NamespacedName: client.ObjectKey{Name: "cluster"}}
+		handled = nil
+		fn = func(_ context.Context, apiServer *configv1.APIServer) error {
+			handled = append(handled, apiServer)
+
+			return nil
+		}
+	})
+
+	newReconciler := func(objs ...client.Object) *reconciler[*configv1.APIServer] {
+		return &reconciler[*configv1.APIServer]{
+			reader: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build(),
+			obj:    &configv1.APIServer{},
+			fn:     fn,
+		}
+	}
+
+	It("should handle the current object", func() {
+		apiServer := &configv1.APIServer{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+			Spec:       configv1.APIServerSpec{TLSSecurityProfile: &configv1.TLSSecurityProfile{Type: configv1.TLSProfileModernType}},
+		}
+
+		_, err := newReconciler(apiServer).Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(handled).To(HaveLen(1))
+		Expect(handled[0].Name).To(Equal("cluster"))
+		Expect(handled[0].Spec.TLSSecurityProfile.Type).To(Equal(configv1.TLSProfileModernType))
+	})
+
+	It("should handle a deleted object as nil", func() {
+		_, err := newReconciler().Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(handled).To(HaveLen(1))
+		Expect(handled[0]).To(BeNil())
+	})
+
+	It("should return the errors of the handler", func() {
+		fn = func(context.Context, *configv1.APIServer) error {
+			return errors.New("boom")
+		}
+
+		_, err := newReconciler(&configv1.APIServer{ObjectMeta: metav1.ObjectMeta{Name: "cluster"}}).Reconcile(ctx, req)
+		Expect(err).To(MatchError(ContainSubstring("failed to handle \"cluster\": boom")))
+	})
+})
@@ -0,0 +1,127 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// ObservedGenerationAccessor is implemented by objects exposing the generation observed by their status.
+// Objects that do not implement it are supported when they have a Status.ObservedGeneration int64 field,
+// or status.observedGeneration when unstructured.
+type ObservedGenerationAccessor interface {
+	GetObservedGeneration() int64
+	SetObservedGeneration(generation int64)
+}
+
+// ObservedGeneration returns the generation observed by the status of obj,
+// and false when obj has no observed generation.
+func ObservedGeneration(obj client.Object) (int64, bool) {
+	switch o := obj.(type) {
+	case ObservedGenerationAccessor:
+		return o.GetObservedGeneration(), true
+	case *unstructured.Unstructured:
+		generation, found, err := unstructured.NestedInt64(o.Object, "status", "observedGeneration")
+		return generation, found && err == nil
+	}
+
+	field, ok := observedGenerationField(obj)
+	if !ok {
+		return 0, false
+	}
+
+	return field.Int(), true
+}
+
+// SetObservedGeneration records the generation of obj as observed by its status, and reports whether it changed.
+// It does nothing for objects without an observed generation.
+func SetObservedGeneration(obj client.Object) bool {
+	generation := obj.GetGeneration()
+	if observed, ok := ObservedGeneration(obj); !ok || observed == generation {
+		return false
+	}
+
+	switch o := obj.(type) {
+	case ObservedGenerationAccessor:
+		o.SetObservedGeneration(generation)
+		return true
+	case *unstructured.Unstructured:
+		return unstructured.SetNestedField(o.Object, generation, "status", "observedGeneration") == nil
+	}
+
+	field, ok := observedGenerationField(obj)
+	if !ok {
+		return false
+	}
+
+	field.SetInt(generation)
+
+	return true
+}
+
+// IsStale reports whether the status of obj does not reflect its current generation yet.
+// Objects without an observed generation are never stale. Unstructured objects without
+// status.observedGeneration are considered as not observing any generation yet.
+func IsStale(obj client.Object) bool {
+	observed, ok := ObservedGeneration(obj)
+	if !ok {
+		_, isUnstructured := obj.(*unstructured.Unstructured)
+		return isUnstructured && obj.GetGeneration() > 0
+	}
+
+	return observed < obj.GetGeneration()
+}
+
+// IgnoreStaleStatusUpdates returns a predicate that filters out update events that do not change the generation
+// and leave the status stale, such as status updates written for a previous generation while a new one is being
+// reconciled. Such events carry nothing the current reconciliation needs.
+func IgnoreStaleStatusUpdates() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if e.ObjectOld == nil || e.ObjectNew == nil {
+				return true
+			}
+
+			return e.ObjectOld.GetGeneration() != e.ObjectNew.GetGeneration() || !IsStale(e.ObjectNew)
+		},
+	}
+}
+
+// observedGenerationField returns the settable Status.ObservedGeneration field of a typed object.
+func observedGenerationField(obj client.Object) (reflect.Value, bool) {
+	value := reflect.ValueOf(obj)
+	if value.Kind() != reflect.Pointer || value.IsNil() {
+		return reflect.Value{}, false
+	}
+
+	status := value.Elem().FieldByName("Status")
+	if !status.IsValid() || status.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+
+	field := status.FieldByName("ObservedGeneration")
+	if !field.IsValid() || field.Kind() != reflect.Int64 || !field.CanSet() {
+		return reflect.Value{}, false
+	}
+
+	return field, true
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+var _ = Describe("ObservedGeneration", func() {
+	It("should detect and fix stale typed statuses", func() {
+		deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Generation: 2}}
+		deployment.Status.ObservedGeneration = 1
+
+		Expect(IsStale(deployment)).To(BeTrue())
+		Expect(SetObservedGeneration(deployment)).To(BeTrue())
+		Expect(deployment.Status.ObservedGeneration).To(BeEquivalentTo(2))
+		Expect(IsStale(deployment)).To(BeFalse())
+		Expect(SetObservedGeneration(deployment)).To(BeFalse())
+	})
+
+	It("should detect and fix stale unstructured statuses", func() {
+		obj := &unstructured.Unstructured{Object: map[string]any{}}
+		obj.SetGeneration(3)
+		Expect(IsStale(obj)).To(BeTrue(), "a missing observedGeneration has not observed anything")
+
+		Expect(unstructured.SetNestedField(obj.Object, int64(2), "status", "observedGeneration")).To(Succeed())
+		Expect(IsStale(obj)).To(BeTrue())
+
+		Expect(SetObservedGeneration(obj)).To(BeTrue())
+		generation, ok := ObservedGeneration(obj)
+		Expect(ok).To(BeTrue())
+		Expect(generation).To(BeEquivalentTo(3))
+		Expect(IsStale(obj)).To(BeFalse())
+	})
+
+	It("should never consider objects without an observed generation stale", func() {
+		configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Generation: 2}}
+		Expect(IsStale(configMap)).To(BeFalse())
+		Expect(SetObservedGeneration(configMap)).To(BeFalse())
+	})
+
+	It("should filter status updates that leave the status stale", func() {
+		p := IgnoreStaleStatusUpdates()
+
+		old := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Generation: 2}}
+		old.Status.ObservedGeneration = 1
+
+		staleUpdate := old.DeepCopy()
+		staleUpdate.Status.Replicas = 3
+		Expect(p.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: staleUpdate})).To(BeFalse())
+
+		currentUpdate := old.DeepCopy()
+		currentUpdate.Status.ObservedGeneration = 2
+		Expect(p.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: currentUpdate})).To(BeTrue())
+
+		specUpdate := old.DeepCopy()
+		specUpdate.Generation = 3
+		Expect(p.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: specUpdate})).To(BeTrue())
+	})
+})
@@ -0,0 +1,98 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package status provides helpers to update the status of objects from reconcilers.
+package status
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openshift/controller-runtime-common/pkg/metricsregistry"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+var statusUpdateConflicts = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "status_update_conflicts_total",
+	Help: "Number of status updates that conflicted with a concurrent change and were retried, by kind.",
+}, []string{"kind"})
+
+func init() {
+	metricsregistry.Add(statusUpdateConflicts)
+}
+
+// Update applies mutate to obj and updates its status subresource, skipping the API call when the mutation
+// leaves the object semantically unchanged.
+//
+// On conflict, obj is read again from the client and mutate is applied to the fresh copy, so mutate must compute
+// the status from obj rather than from state captured before the call. Conflicts are counted in the
+// status_update_conflicts_total metric. On return, obj holds the last state read or written.
+//
+// Example:
+//
+//	err := status.Update(ctx, r.Client, operand, func(operand *v1alpha1.Operand) error {
+//	    conditions.MarkTrue(&operand.Status.Conditions, operand.Generation, "Available", "AsExpected", "")
+//	    return nil
+//	})
+func Update[T client.Object](ctx context.Context, c client.Client, obj T, mutate func(T) error) error {
+	kind := kindOf(c, obj)
+	key := client.ObjectKeyFromObject(obj)
+	refresh := false
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if refresh {
+			if err := c.Get(ctx, key, obj); err != nil {
+				return fmt.Errorf("failed to get %s %s: %w", kind, key.String(), err)
+			}
+		}
+
+		refresh = true
+
+		before := obj.DeepCopyObject()
+		if err := mutate(obj); err != nil {
+			return err
+		}
+
+		if equality.Semantic.DeepEqual(before, obj) {
+			return nil
+		}
+
+		if err := c.Status().Update(ctx, obj); err != nil {
+			if apierrors.IsConflict(err) {
+				statusUpdateConflicts.WithLabelValues(kind).Inc()
+			}
+
+			return fmt.Errorf("failed to update status of %s %s: %w", kind, key.String(), err)
+		}
+
+		return nil
+	})
+}
+
+// kindOf returns the kind of obj, falling back to its Go type when it is not registered with the client scheme.
+func kindOf(c client.Client, obj client.Object) string {
+	gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+	if err != nil {
+		return fmt.Sprintf("%T", obj)
+	}
+
+	return gvk.Kind
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+var _ = Describe("Update", func() {
+	var (
+		deployment *appsv1.Deployment
+		conflicts  int
+		updates    int
+		fakeClient client.Client
+	)
+
+	BeforeEach(func() {
+		deployment = &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "operator", Name: "operand"}}
+		conflicts, updates = 0, 0
+
+		fakeClient = fake.NewClientBuilder().
+			WithObjects(deployment).
+			WithStatusSubresource(deployment).
+			WithInterceptorFuncs(interceptor.Funcs{
+				SubResourceUpdate: func(ctx context.Context, c client.Client, subResource string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+					updates++
+					if conflicts > 0 {
+						conflicts--
+						return apierrors.NewConflict(schema.GroupResource{Group: "apps", Resource: "deployments"}, obj.GetName(), errors.New("conflict"))
+					}
+
+					return c.SubResource(subResource).Update(ctx, obj, opts...)
+				},
+			}).
+			Build()
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+	})
+
+	setReplicas := func(replicas int32) func(*appsv1.Deployment) error {
+		return func(d *appsv1.Deployment) error {
+			d.Status.Replicas = replicas
+			return nil
+		}
+	}
+
+	It("should update the status", func() {
+		Expect(Update(ctx, fakeClient, deployment, setReplicas(3))).To(Succeed())
+		Expect(updates).To(Equal(1))
+
+		stored := &appsv1.Deployment{}
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), stored)).To(Succeed())
+		Expect(stored.Status.Replicas).To(BeEquivalentTo(3))
+	})
+
+	It("should skip the update when the status is unchanged", func() {
+		Expect(Update(ctx, fakeClient, deployment, setReplicas(0))).To(Succeed())
+		Expect(updates).To(BeZero())
+	})
+
+	It("should retry on conflict and count the conflicts", func() {
+		before := testutil.ToFloat64(statusUpdateConflicts.WithLabelValues("Deployment"))
+		conflicts = 2
+
+		Expect(Update(ctx, fakeClient, deployment, setReplicas(3))).To(Succeed())
+		Expect(updates).To(Equal(3))
+		Expect(deployment.Status.Replicas).To(BeEquivalentTo(3))
+		Expect(testutil.ToFloat64(statusUpdateConflicts.WithLabelValues("Deployment"))).To(Equal(before + 2))
+	})
+
+	It("should return the mutation error without updating", func() {
+		err := Update(ctx, fakeClient, deployment, func(*appsv1.Deployment) error {
+			return errors.New("cannot compute status")
+		})
+		Expect(err).To(MatchError("cannot compute status"))
+		Expect(updates).To(BeZero())
+	})
+})
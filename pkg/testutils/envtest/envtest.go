@@ -20,8 +20,10 @@ package envtest
 import (
 	"context"
 	"fmt"
+	"io/fs"
 	"os"
 	"os/exec"
+	"path"
 	"path/filepath"
 	"strings"
 )
@@ -49,25 +51,69 @@ func GetGoModuleDirectory(ctx context.Context, module string) (string, error) {
 	return moduleDir, nil
 }
 
-// GetCRDManifestsPath returns the full path to the CRD manifests directory within a go module.
-// It combines GetGoModuleDirectory with the provided path segments.
+// GetCRDManifestsPath returns the full paths of the CRD manifests within a go module.
+// It combines GetGoModuleDirectory with the provided path segments. Without glob patterns, it returns the single
+// path the segments point at, such as a directory of manifests. The trailing segments may contain glob patterns
+// (as understood by path.Match), which are expanded into the list of matching files, as callers often need
+// individual manifests rather than a directory. Only the path segments are treated as a pattern: the module
+// directory is used verbatim, so glob metacharacters in the module cache path are not expanded. Every match must be
+// a regular file and the pattern must match at least one file, so that a typo in the pattern does not silently
+// result in no CRDs being installed.
 //
 // Example:
 //
-//	crdPath, err := GetCRDManifestsPath(ctx, "github.com/openshift/api", "config", "v1", "zz_generated.crd-manifests")
+//	crdPaths, err := GetCRDManifestsPath(ctx, "github.com/openshift/api", "config", "v1", "zz_generated.crd-manifests")
 //	if err != nil {
 //	    return err
 //	}
-func GetCRDManifestsPath(ctx context.Context, module string, pathSegments ...string) (string, error) {
+//
+//	crdFiles, err := GetCRDManifestsPath(ctx, "github.com/openshift/api", "config", "v1", "zz_generated.crd-manifests", "*_apiservers-Default.crd.yaml")
+//	if err != nil {
+//	    return err
+//	}
+func GetCRDManifestsPath(ctx context.Context, module string, pathSegments ...string) ([]string, error) {
 	moduleDir, err := GetGoModuleDirectory(ctx, module)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	path := filepath.Join(append([]string{moduleDir}, pathSegments...)...)
-	if _, err := os.Stat(path); err != nil {
-		return "", fmt.Errorf("path %s does not exist: %w", path, err)
+	pattern := path.Join(pathSegments...)
+	if !hasMeta(pattern) {
+		path := filepath.Join(moduleDir, filepath.FromSlash(pattern))
+		if _, err := os.Stat(path); err != nil {
+			return nil, fmt.Errorf("path %s does not exist: %w", path, err)
+		}
+
+		return []string{path}, nil
+	}
+
+	matches, err := fs.Glob(os.DirFS(moduleDir), pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern %s: %w", pattern, err)
 	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("pattern %s did not match any files in %s", pattern, moduleDir)
+	}
+
+	files := make([]string, 0, len(matches))
+	for _, match := range matches {
+		file := filepath.Join(moduleDir, filepath.FromSlash(match))
+
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, fmt.Errorf("path %s does not exist: %w", file, err)
+		}
+		if !info.Mode().IsRegular() {
+			return nil, fmt.Errorf("path %s is not a regular file", file)
+		}
+
+		files = append(files, file)
+	}
+
+	return files, nil
+}
 
-	return path, nil
+// hasMeta reports whether pattern contains any of the special characters recognized by path.Match.
+func hasMeta(pattern string) bool {
+	return strings.ContainsAny(pattern, `*?[\`)
 }
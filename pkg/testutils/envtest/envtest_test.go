@@ -0,0 +1,71 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package envtest
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// thisModule is the main module, whose directory is always resolvable by 'go list -m'.
+const thisModule = "github.com/openshift/controller-runtime-common"
+
+var _ = Describe("GetCRDManifestsPath", func() {
+	It("should return every file matching the pattern", func() {
+		files, err := GetCRDManifestsPath(ctx, thisModule, "pkg", "testutils", "envtest", "envtest*.go")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(files).To(HaveLen(2))
+		Expect(files).To(ContainElements(
+			HaveSuffix(filepath.Join("pkg", "testutils", "envtest", "envtest.go")),
+			HaveSuffix(filepath.Join("pkg", "testutils", "envtest", "envtest_test.go")),
+		))
+		for _, file := range files {
+			Expect(filepath.IsAbs(file)).To(BeTrue(), "returned paths should be absolute")
+		}
+	})
+
+	It("should return the path without patterns", func() {
+		paths, err := GetCRDManifestsPath(ctx, thisModule, "pkg", "testutils", "envtest")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(paths).To(ConsistOf(HaveSuffix(filepath.Join("pkg", "testutils", "envtest"))))
+
+		_, err = GetCRDManifestsPath(ctx, thisModule, "pkg", "testutils", "missing")
+		Expect(err).To(MatchError(ContainSubstring("does not exist")))
+	})
+
+	It("should return an error when nothing matches", func() {
+		_, err := GetCRDManifestsPath(ctx, thisModule, "pkg", "testutils", "envtest", "*.crd.yaml")
+		Expect(err).To(MatchError(ContainSubstring("did not match any files")))
+	})
+
+	It("should reject matches that are directories", func() {
+		_, err := GetCRDManifestsPath(ctx, thisModule, "pkg", "testutils", "env*")
+		Expect(err).To(MatchError(ContainSubstring("is not a regular file")))
+	})
+
+	It("should return an error for a malformed pattern", func() {
+		_, err := GetCRDManifestsPath(ctx, thisModule, "pkg", "[")
+		Expect(err).To(MatchError(ContainSubstring("invalid pattern")))
+	})
+
+	It("should return an error for an unknown module", func() {
+		_, err := GetCRDManifestsPath(ctx, "example.com/does-not-exist", "*.yaml")
+		Expect(err).To(HaveOccurred())
+	})
+})
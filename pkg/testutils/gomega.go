@@ -0,0 +1,163 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package testutils provides utilities shared by the test suites of controller-runtime based projects.
+package testutils
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/onsi/gomega"
+)
+
+const (
+	// EventuallyTimeoutEnv is the environment variable used to override the default Eventually timeout.
+	EventuallyTimeoutEnv = "GOMEGA_DEFAULT_EVENTUALLY_TIMEOUT"
+	// EventuallyPollingIntervalEnv is the environment variable used to override the default Eventually polling interval.
+	EventuallyPollingIntervalEnv = "GOMEGA_DEFAULT_EVENTUALLY_POLLING_INTERVAL"
+	// ConsistentlyDurationEnv is the environment variable used to override the default Consistently duration.
+	ConsistentlyDurationEnv = "GOMEGA_DEFAULT_CONSISTENTLY_DURATION"
+	// ConsistentlyPollingIntervalEnv is the environment variable used to override the default Consistently polling interval.
+	ConsistentlyPollingIntervalEnv = "GOMEGA_DEFAULT_CONSISTENTLY_POLLING_INTERVAL"
+)
+
+// GomegaDefaults holds the suite-wide defaults used by Eventually and Consistently.
+type GomegaDefaults struct {
+	// EventuallyTimeout is the default timeout of Eventually assertions.
+	EventuallyTimeout time.Duration
+	// EventuallyPollingInterval is the default polling interval of Eventually assertions.
+	EventuallyPollingInterval time.Duration
+	// ConsistentlyDuration is the default duration of Consistently assertions.
+	ConsistentlyDuration time.Duration
+	// ConsistentlyPollingInterval is the default polling interval of Consistently assertions.
+	ConsistentlyPollingInterval time.Duration
+}
+
+// LocalGomegaDefaults returns the defaults used when running outside of CI.
+//
+// Consistently is kept close to gomega's own default, as suites commonly call it
+// without an explicit duration and a longer default would slow every such assertion down.
+func LocalGomegaDefaults() GomegaDefaults {
+	return GomegaDefaults{
+		EventuallyTimeout:           10 * time.Second,
+		EventuallyPollingInterval:   100 * time.Millisecond,
+		ConsistentlyDuration:        100 * time.Millisecond,
+		ConsistentlyPollingInterval: 10 * time.Millisecond,
+	}
+}
+
+// CIGomegaDefaults returns the defaults used when running in CI, where machines are often
+// slower and more heavily loaded than developer workstations.
+func CIGomegaDefaults() GomegaDefaults {
+	return GomegaDefaults{
+		EventuallyTimeout:           60 * time.Second,
+		EventuallyPollingInterval:   250 * time.Millisecond,
+		ConsistentlyDuration:        200 * time.Millisecond,
+		ConsistentlyPollingInterval: 20 * time.Millisecond,
+	}
+}
+
+// GomegaDefaultsOptions configures ConfigureGomegaDefaults.
+// Any non-zero field takes precedence over both the environment and the CI/local profile.
+type GomegaDefaultsOptions struct {
+	GomegaDefaults
+
+	// IsCI forces the CI profile on or off. When nil, CI is detected from the environment.
+	IsCI *bool
+}
+
+// ConfigureGomegaDefaults sets the suite-wide defaults of Eventually and Consistently and returns
+// the values that were applied. It is intended to be called from a suite's BeforeSuite.
+//
+// The value of each setting is resolved in the following order:
+//  1. the corresponding field of opts, if non-zero;
+//  2. the corresponding GOMEGA_DEFAULT_* environment variable, if set and valid;
+//  3. CIGomegaDefaults() when running in CI, LocalGomegaDefaults() otherwise.
+//
+// Example:
+//
+//	var _ = BeforeSuite(func() {
+//	    testutils.ConfigureGomegaDefaults(testutils.GomegaDefaultsOptions{})
+//	})
+func ConfigureGomegaDefaults(opts GomegaDefaultsOptions) GomegaDefaults {
+	defaults := resolveGomegaDefaults(opts, os.LookupEnv)
+
+	gomega.SetDefaultEventuallyTimeout(defaults.EventuallyTimeout)
+	gomega.SetDefaultEventuallyPollingInterval(defaults.EventuallyPollingInterval)
+	gomega.SetDefaultConsistentlyDuration(defaults.ConsistentlyDuration)
+	gomega.SetDefaultConsistentlyPollingInterval(defaults.ConsistentlyPollingInterval)
+
+	return defaults
+}
+
+// IsCI reports whether the process is running in a CI environment.
+// It recognises the generic CI variable as well as the variables set by OpenShift CI (Prow).
+// A variable counts as set unless it is empty or parses as a false boolean (e.g. "0", "false", "False").
+func IsCI() bool {
+	return isCI(os.LookupEnv)
+}
+
+func isCI(lookupEnv func(string) (string, bool)) bool {
+	for _, env := range []string{"CI", "OPENSHIFT_CI", "PROW_JOB_ID"} {
+		value, ok := lookupEnv(env)
+		if !ok || value == "" {
+			continue
+		}
+
+		// Values that are not booleans, such as a Prow job ID, still mean CI.
+		if enabled, err := strconv.ParseBool(value); err != nil || enabled {
+			return true
+		}
+	}
+
+	return false
+}
+
+func resolveGomegaDefaults(opts GomegaDefaultsOptions, lookupEnv func(string) (string, bool)) GomegaDefaults {
+	ci := isCI(lookupEnv)
+	if opts.IsCI != nil {
+		ci = *opts.IsCI
+	}
+
+	base := LocalGomegaDefaults()
+	if ci {
+		base = CIGomegaDefaults()
+	}
+
+	return GomegaDefaults{
+		EventuallyTimeout:           resolveDuration(opts.EventuallyTimeout, EventuallyTimeoutEnv, base.EventuallyTimeout, lookupEnv),
+		EventuallyPollingInterval:   resolveDuration(opts.EventuallyPollingInterval, EventuallyPollingIntervalEnv, base.EventuallyPollingInterval, lookupEnv),
+		ConsistentlyDuration:        resolveDuration(opts.ConsistentlyDuration, ConsistentlyDurationEnv, base.ConsistentlyDuration, lookupEnv),
+		ConsistentlyPollingInterval: resolveDuration(opts.ConsistentlyPollingInterval, ConsistentlyPollingIntervalEnv, base.ConsistentlyPollingInterval, lookupEnv),
+	}
+}
+
+func resolveDuration(override time.Duration, env string, fallback time.Duration, lookupEnv func(string) (string, bool)) time.Duration {
+	if override > 0 {
+		return override
+	}
+
+	if value, ok := lookupEnv(env); ok {
+		// Invalid values are ignored so that a typo does not break the whole suite.
+		if d, err := time.ParseDuration(value); err == nil && d > 0 {
+			return d
+		}
+	}
+
+	return fallback
+}
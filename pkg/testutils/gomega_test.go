@@ -0,0 +1,77 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testutils
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+)
+
+func fakeEnv(env map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}
+}
+
+var _ = Describe("resolveGomegaDefaults", func() {
+	It("should use the local defaults outside of CI", func() {
+		Expect(resolveGomegaDefaults(GomegaDefaultsOptions{}, fakeEnv(nil))).To(Equal(LocalGomegaDefaults()))
+	})
+
+	It("should use the CI defaults when CI is detected", func() {
+		Expect(resolveGomegaDefaults(GomegaDefaultsOptions{}, fakeEnv(map[string]string{"OPENSHIFT_CI": "true"}))).To(Equal(CIGomegaDefaults()))
+	})
+
+	DescribeTable("should not treat false boolean values as CI",
+		func(value string) {
+			Expect(resolveGomegaDefaults(GomegaDefaultsOptions{}, fakeEnv(map[string]string{"CI": value}))).To(Equal(LocalGomegaDefaults()))
+		},
+		Entry("false", "false"),
+		Entry("False", "False"),
+		Entry("0", "0"),
+		Entry("empty", ""),
+	)
+
+	It("should treat non-boolean values as CI", func() {
+		Expect(resolveGomegaDefaults(GomegaDefaultsOptions{}, fakeEnv(map[string]string{"PROW_JOB_ID": "abc-123"}))).To(Equal(CIGomegaDefaults()))
+	})
+
+	It("should let IsCI override the detected environment", func() {
+		opts := GomegaDefaultsOptions{IsCI: ptr.To(false)}
+		Expect(resolveGomegaDefaults(opts, fakeEnv(map[string]string{"CI": "true"}))).To(Equal(LocalGomegaDefaults()))
+	})
+
+	It("should prefer environment variables over the profile", func() {
+		defaults := resolveGomegaDefaults(GomegaDefaultsOptions{}, fakeEnv(map[string]string{
+			EventuallyTimeoutEnv:    "42s",
+			ConsistentlyDurationEnv: "not-a-duration",
+		}))
+		Expect(defaults.EventuallyTimeout).To(Equal(42 * time.Second))
+		Expect(defaults.ConsistentlyDuration).To(Equal(LocalGomegaDefaults().ConsistentlyDuration), "invalid values should be ignored")
+	})
+
+	It("should prefer explicit options over environment variables", func() {
+		opts := GomegaDefaultsOptions{GomegaDefaults: GomegaDefaults{EventuallyTimeout: time.Minute}}
+		defaults := resolveGomegaDefaults(opts, fakeEnv(map[string]string{EventuallyTimeoutEnv: "42s"}))
+		Expect(defaults.EventuallyTimeout).To(Equal(time.Minute))
+		Expect(defaults.EventuallyPollingInterval).To(Equal(LocalGomegaDefaults().EventuallyPollingInterval))
+	})
+})
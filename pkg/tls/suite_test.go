@@ -25,6 +25,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	configv1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/controller-runtime-common/pkg/testutils"
 	testutilsenvtest "github.com/openshift/controller-runtime-common/pkg/testutils/envtest"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -56,15 +57,14 @@ func TestSuite(t *testing.T) {
 
 var _ = BeforeSuite(func() {
 	logf.SetLogger(zap.New(zap.WriteTo(GinkgoWriter), zap.UseDevMode(true)))
+	testutils.ConfigureGomegaDefaults(testutils.GomegaDefaultsOptions{})
 
 	By("bootstrapping test environment")
-	configV1CRDPath, errPath := testutilsenvtest.GetCRDManifestsPath(ctx, "github.com/openshift/api", "config", "v1", "zz_generated.crd-manifests")
+	configV1CRDPaths, errPath := testutilsenvtest.GetCRDManifestsPath(ctx, "github.com/openshift/api", "config", "v1", "zz_generated.crd-manifests")
 	Expect(errPath).NotTo(HaveOccurred())
 
 	testEnv = &envtest.Environment{
-		CRDDirectoryPaths: []string{
-			configV1CRDPath,
-		},
+		CRDDirectoryPaths:     configV1CRDPaths,
 		ErrorIfCRDPathMissing: true,
 
 		// Automatically download envtest binaries (etcd, kube-apiserver) if not present.
@@ -0,0 +1,200 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tlsidentity provides a single hot-reloading TLS configuration for servers,
+// combining the cluster TLS security profile with a serving certificate source.
+package tlsidentity
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/controller-runtime-common/pkg/certs"
+	crtls "github.com/openshift/controller-runtime-common/pkg/tls"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// ErrNoCertificateSource is returned when a Provider is used without a certificate source.
+var ErrNoCertificateSource = errors.New("no certificate source configured")
+
+// profileState is an immutable snapshot of the TLS profile and the settings derived from it.
+type profileState struct {
+	spec               configv1.TLSProfileSpec
+	apply              func(*tls.Config)
+	unsupportedCiphers []string
+}
+
+// Provider serves a single tls.Config combining the TLS security profile configured in the APIServer
+// (minimum version and ciphers) with a serving certificate from a certs.CertificateSource
+// (a file, a Secret or a generated certificate).
+//
+// Both the profile and the certificate are resolved on every handshake, so servers pick up
+// changes to either without being restarted.
+//
+// Example:
+//
+//	provider := &tlsidentity.Provider{CertificateSource: secretWatcher}
+//	if err := provider.SetupWithManager(ctx, mgr); err != nil {
+//	    return err
+//	}
+//
+//	webhookServer := webhook.NewServer(webhook.Options{TLSOpts: []func(*tls.Config){provider.TLSOpt()}})
+type Provider struct {
+	// CertificateSource provides the serving certificate.
+	// When it implements SetupWithManager(ctrl.Manager) error or manager.Runnable,
+	// SetupWithManager registers it with the manager as well.
+	CertificateSource certs.CertificateSource
+
+	// OnProfileChange is an optional function that will be called after the provider has switched to a new profile.
+	OnProfileChange func(ctx context.Context, oldTLSProfileSpec, newTLSProfileSpec configv1.TLSProfileSpec)
+
+	profile atomic.Pointer[profileState]
+}
+
+// SetupWithManager fetches the current TLS profile, sets up a tls.SecurityProfileWatcher that keeps the provider
+// up to date, and registers the certificate source with the manager when it needs to be run.
+func (p *Provider) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	if p.CertificateSource == nil {
+		return ErrNoCertificateSource
+	}
+
+	// The cache is not started yet, so read the initial profile from the API server directly.
+	apiClient, err := client.New(mgr.GetConfig(), client.Options{
+		HTTPClient: mgr.GetHTTPClient(),
+		Scheme:     mgr.GetScheme(),
+		Mapper:     mgr.GetRESTMapper(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create client to fetch initial TLS profile: %w", err)
+	}
+
+	profile, err := crtls.FetchAPIServerTLSProfile(ctx, apiClient)
+	if err != nil {
+		return fmt.Errorf("failed to fetch initial TLS profile: %w", err)
+	}
+
+	p.SetProfile(profile)
+
+	watcher := &crtls.SecurityProfileWatcher{
+		Client:                mgr.GetClient(),
+		InitialTLSProfileSpec: profile,
+		OnProfileChange: func(ctx context.Context, oldTLSProfileSpec, newTLSProfileSpec configv1.TLSProfileSpec) {
+			log.FromContext(ctx).Info("TLS profile changed, reloading TLS configuration")
+			p.SetProfile(newTLSProfileSpec)
+
+			if p.OnProfileChange != nil {
+				p.OnProfileChange(ctx, oldTLSProfileSpec, newTLSProfileSpec)
+			}
+		},
+	}
+
+	if err := watcher.SetupWithManager(mgr); err != nil {
+		return err
+	}
+
+	switch source := p.CertificateSource.(type) {
+	case interface{ SetupWithManager(ctrl.Manager) error }:
+		if err := source.SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("failed to set up certificate source: %w", err)
+		}
+	case manager.Runnable:
+		if err := mgr.Add(source); err != nil {
+			return fmt.Errorf("failed to add certificate source to manager: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// SetProfile switches the provider to the given TLS profile.
+// New handshakes use the profile immediately; established connections are not affected.
+func (p *Provider) SetProfile(profile configv1.TLSProfileSpec) {
+	apply, unsupportedCiphers := crtls.NewTLSConfigFromProfile(profile)
+
+	p.profile.Store(&profileState{
+		spec:               profile,
+		apply:              apply,
+		unsupportedCiphers: unsupportedCiphers,
+	})
+}
+
+// Profile returns the current TLS profile, or the default profile if none has been set.
+func (p *Provider) Profile() configv1.TLSProfileSpec {
+	return p.state().spec
+}
+
+// UnsupportedCiphers returns the ciphers of the current profile that are not supported by Go and are therefore ignored.
+func (p *Provider) UnsupportedCiphers() []string {
+	return p.state().unsupportedCiphers
+}
+
+// TLSOpt returns a function that configures a tls.Config to resolve the TLS profile and serving certificate
+// on every handshake. It is intended to be used with controller-runtime's TLSOpts, and should come after options
+// that set static fields, as the configuration at the time of the handshake is used as the base for each connection.
+// Options that wrap GetConfigForClient, such as certs.ClientCAWatcher.TLSOpt, go after it.
+func (p *Provider) TLSOpt() func(*tls.Config) {
+	return func(base *tls.Config) {
+		base.Certificates = nil
+		base.GetCertificate = p.getCertificate
+		base.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return p.configFor(base), nil
+		}
+	}
+}
+
+// TLSConfig returns a new tls.Config configured with TLSOpt, for servers not managed by controller-runtime.
+func (p *Provider) TLSConfig() *tls.Config {
+	tlsConf := &tls.Config{MinVersion: tls.VersionTLS12}
+	p.TLSOpt()(tlsConf)
+
+	return tlsConf
+}
+
+// configFor returns the configuration of a single connection, derived from base and the current profile.
+func (p *Provider) configFor(base *tls.Config) *tls.Config {
+	tlsConf := base.Clone()
+	tlsConf.GetConfigForClient = nil
+	p.state().apply(tlsConf)
+
+	return tlsConf
+}
+
+func (p *Provider) getCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	if p.CertificateSource == nil {
+		return nil, ErrNoCertificateSource
+	}
+
+	return p.CertificateSource.GetCertificate(hello)
+}
+
+func (p *Provider) state() *profileState {
+	if state := p.profile.Load(); state != nil {
+		return state
+	}
+
+	// Fall back to the default profile, so that a provider is safe to use before a profile has been set.
+	profile, _ := crtls.GetTLSProfileSpec(nil)
+	apply, unsupportedCiphers := crtls.NewTLSConfigFromProfile(profile)
+
+	return &profileState{spec: profile, apply: apply, unsupportedCiphers: unsupportedCiphers}
+}
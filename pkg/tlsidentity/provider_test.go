@@ -0,0 +1,102 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tlsidentity
+
+import (
+	"crypto/tls"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+)
+
+// staticSource is a certs.CertificateSource serving a fixed certificate.
+type staticSource struct {
+	cert *tls.Certificate
+}
+
+func (s *staticSource) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return s.cert, nil
+}
+
+var _ = Describe("Provider", func() {
+	var (
+		source   *staticSource
+		provider *Provider
+	)
+
+	BeforeEach(func() {
+		source = &staticSource{cert: &tls.Certificate{}}
+		provider = &Provider{CertificateSource: source}
+	})
+
+	handshakeConfig := func(tlsConf *tls.Config) *tls.Config {
+		GinkgoHelper()
+		Expect(tlsConf.GetConfigForClient).NotTo(BeNil())
+		conf, err := tlsConf.GetConfigForClient(&tls.ClientHelloInfo{})
+		Expect(err).NotTo(HaveOccurred())
+		return conf
+	}
+
+	It("should use the default profile before a profile is set", func() {
+		Expect(provider.Profile()).To(Equal(*configv1.TLSProfiles[configv1.TLSProfileIntermediateType]))
+		Expect(handshakeConfig(provider.TLSConfig()).MinVersion).To(Equal(uint16(tls.VersionTLS12)))
+	})
+
+	It("should apply profile changes to new handshakes", func() {
+		tlsConf := provider.TLSConfig()
+		Expect(handshakeConfig(tlsConf).CipherSuites).NotTo(BeEmpty())
+
+		provider.SetProfile(*configv1.TLSProfiles[configv1.TLSProfileModernType])
+
+		conf := handshakeConfig(tlsConf)
+		Expect(conf.MinVersion).To(Equal(uint16(tls.VersionTLS13)))
+		Expect(conf.GetConfigForClient).To(BeNil(), "per-connection configs must not recurse")
+	})
+
+	It("should serve the certificate of the source", func() {
+		tlsConf := &tls.Config{Certificates: []tls.Certificate{{}}}
+		provider.TLSOpt()(tlsConf)
+
+		conf := handshakeConfig(tlsConf)
+		Expect(conf.Certificates).To(BeEmpty())
+		cert, err := conf.GetCertificate(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cert).To(BeIdenticalTo(source.cert))
+	})
+
+	It("should keep other settings of the base configuration", func() {
+		tlsConf := &tls.Config{NextProtos: []string{"h2"}}
+		provider.TLSOpt()(tlsConf)
+
+		Expect(handshakeConfig(tlsConf).NextProtos).To(Equal([]string{"h2"}))
+	})
+
+	It("should report unsupported ciphers of the profile", func() {
+		provider.SetProfile(configv1.TLSProfileSpec{
+			Ciphers:       []string{"ECDHE-RSA-AES128-GCM-SHA256", "NOT-A-CIPHER"},
+			MinTLSVersion: configv1.VersionTLS12,
+		})
+		Expect(provider.UnsupportedCiphers()).To(ConsistOf("NOT-A-CIPHER"))
+	})
+
+	It("should return an error without a certificate source", func() {
+		provider.CertificateSource = nil
+		_, err := provider.TLSConfig().GetCertificate(nil)
+		Expect(err).To(MatchError(ErrNoCertificateSource))
+	})
+})
@@ -0,0 +1,121 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package version provides the version of an operator, as set in its environment and build, to report it in
+// metrics and in its ClusterOperator.
+package version
+
+import (
+	"os"
+	"runtime"
+	"runtime/debug"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/controller-runtime-common/pkg/clusteroperator"
+	"github.com/openshift/controller-runtime-common/pkg/metricsregistry"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// OperatorImageVersionEnv is the environment variable holding the version of the operator image,
+	// set by the cluster version operator from the release payload.
+	OperatorImageVersionEnv = "OPERATOR_IMAGE_VERSION"
+
+	// ReleaseVersionEnv is the environment variable holding the version of the release the operator belongs to.
+	ReleaseVersionEnv = "RELEASE_VERSION"
+
+	// OperatorVersionName is the name of the version of the operator in the ClusterOperator versions.
+	OperatorVersionName = "operator"
+)
+
+// GitCommit is the git commit the operator was built from. It defaults to the VCS revision stamped by the Go
+// toolchain, and can be set at build time with -ldflags "-X github.com/openshift/controller-runtime-common/pkg/version.GitCommit=<sha>".
+var GitCommit string
+
+var buildInfo = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "operator_build_info",
+	Help: "A metric with a constant '1' value labeled by the version, release version, git commit and Go version of the operator.",
+}, []string{"version", "release_version", "git_commit", "go_version"})
+
+func init() {
+	info := Get()
+	buildInfo.WithLabelValues(info.Version, info.ReleaseVersion, info.GitCommit, info.GoVersion).Set(1)
+
+	metricsregistry.Add(buildInfo)
+}
+
+// Info is the version of an operator.
+type Info struct {
+	// Version is the version of the operator image, from OPERATOR_IMAGE_VERSION.
+	Version string
+
+	// ReleaseVersion is the version of the release, from RELEASE_VERSION.
+	ReleaseVersion string
+
+	// GitCommit is the git commit the operator was built from, if known.
+	GitCommit string
+
+	// GoVersion is the version of Go the operator was built with.
+	GoVersion string
+}
+
+// Get returns the version of the operator from its environment and build information.
+func Get() Info {
+	return Info{
+		Version:        os.Getenv(OperatorImageVersionEnv),
+		ReleaseVersion: os.Getenv(ReleaseVersionEnv),
+		GitCommit:      gitCommit(),
+		GoVersion:      runtime.Version(),
+	}
+}
+
+// OperandVersions returns the versions to report in the ClusterOperator: the version of the operator, named
+// OperatorVersionName, or nothing when the version is not set.
+func (i Info) OperandVersions() []configv1.OperandVersion {
+	if i.Version == "" {
+		return nil
+	}
+
+	return []configv1.OperandVersion{{Name: OperatorVersionName, Version: i.Version}}
+}
+
+// SetVersions records the versions of the operator in the ClusterOperator status written by writer.
+// It should only be called once the operator reached its version, see clusteroperator.StatusWriter.SetVersion.
+func (i Info) SetVersions(writer *clusteroperator.StatusWriter) {
+	for _, version := range i.OperandVersions() {
+		writer.SetVersion(version.Name, version.Version)
+	}
+}
+
+// gitCommit returns GitCommit when set, or the VCS revision of the build.
+func gitCommit() string {
+	if GitCommit != "" {
+		return GitCommit
+	}
+
+	build, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+
+	for _, setting := range build.Settings {
+		if setting.Key == "vcs.revision" {
+			return setting.Value
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/controller-runtime-common/pkg/clusteroperator"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Get", func() {
+	It("should read the versions from the environment", func() {
+		GinkgoT().Setenv(OperatorImageVersionEnv, "4.20.0-0.nightly")
+		GinkgoT().Setenv(ReleaseVersionEnv, "4.20.0")
+
+		info := Get()
+		Expect(info.Version).To(Equal("4.20.0-0.nightly"))
+		Expect(info.ReleaseVersion).To(Equal("4.20.0"))
+		Expect(info.GoVersion).To(HavePrefix("go"))
+	})
+
+	It("should prefer the git commit set at build time", func() {
+		DeferCleanup(func(commit string) { GitCommit = commit }, GitCommit)
+		GitCommit = "abc123"
+
+		Expect(Get().GitCommit).To(Equal("abc123"))
+	})
+})
+
+var _ = Describe("Info", func() {
+	It("should only report the operator version when set", func() {
+		Expect(Info{}.OperandVersions()).To(BeEmpty())
+		Expect(Info{Version: "4.20.0"}.OperandVersions()).To(Equal([]configv1.OperandVersion{
+			{Name: OperatorVersionName, Version: "4.20.0"},
+		}))
+	})
+
+	It("should set the versions of the ClusterOperator", func() {
+		scheme := runtime.NewScheme()
+		Expect(configv1.AddToScheme(scheme)).To(Succeed())
+		fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&configv1.ClusterOperator{}).Build()
+
+		writer := &clusteroperator.StatusWriter{Client: fakeClient, Name: "my-operator"}
+		Info{Version: "4.20.0"}.SetVersions(writer)
+		Expect(writer.Sync(ctx)).To(Succeed())
+
+		co := &configv1.ClusterOperator{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "my-operator"}, co)).To(Succeed())
+		Expect(co.Status.Versions).To(ConsistOf(configv1.OperandVersion{Name: OperatorVersionName, Version: "4.20.0"}))
+	})
+})
+
+var _ = Describe("operator_build_info", func() {
+	It("should report the build as a constant metric", func() {
+		Expect(testutil.CollectAndCount(buildInfo, "operator_build_info")).To(Equal(1))
+	})
+})
@@ -0,0 +1,162 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// SystemClusterCriticalPriorityClass is the priority class of the operands the cluster cannot work without.
+	SystemClusterCriticalPriorityClass = "system-cluster-critical"
+	// SystemNodeCriticalPriorityClass is the priority class of the operands a node cannot work without.
+	SystemNodeCriticalPriorityClass = "system-node-critical"
+
+	// MasterNodeRoleLabel is the legacy label of control plane nodes, still used by their taints.
+	MasterNodeRoleLabel = "node-role.kubernetes.io/master"
+	// ControlPlaneNodeRoleLabel is the label of control plane nodes.
+	ControlPlaneNodeRoleLabel = "node-role.kubernetes.io/control-plane"
+)
+
+// HardeningOptions select the OpenShift recommended defaults Harden applies to a pod template. The zero value applies
+// none of them.
+type HardeningOptions struct {
+	// RestrictedSecurityContext makes the pods compatible with the restricted-v2 SecurityContextConstraints and the
+	// restricted pod security standard.
+	RestrictedSecurityContext bool
+
+	// PriorityClassName is the priority class of the pods, e.g. SystemClusterCriticalPriorityClass for operands the
+	// cluster cannot work without.
+	PriorityClassName string
+
+	// RequiredAntiAffinity spreads the pods across nodes, for highly available operands whose replicas must not share a
+	// node. It selects the pods with the labels of the template.
+	RequiredAntiAffinity bool
+
+	// ControlPlaneTolerations lets the pods run on the control plane nodes.
+	ControlPlaneTolerations bool
+}
+
+// Harden applies the defaults selected by opts to the pod template. Values set by the template are kept, and applying
+// the defaults again changes nothing, so that hardened templates do not trigger rollouts.
+//
+// Example:
+//
+//	workload.Harden(&deployment.Spec.Template, workload.HardeningOptions{
+//	    RestrictedSecurityContext: true,
+//	    PriorityClassName:         workload.SystemClusterCriticalPriorityClass,
+//	    RequiredAntiAffinity:      replicas > 1,
+//	})
+func Harden(template *corev1.PodTemplateSpec, opts HardeningOptions) {
+	if opts.RestrictedSecurityContext {
+		ApplyRestrictedSecurityContext(&template.Spec)
+	}
+
+	if opts.PriorityClassName != "" && template.Spec.PriorityClassName == "" {
+		template.Spec.PriorityClassName = opts.PriorityClassName
+	}
+
+	if opts.RequiredAntiAffinity && len(template.Labels) > 0 {
+		ApplyRequiredAntiAffinity(&template.Spec, &metav1.LabelSelector{MatchLabels: template.Labels}, corev1.LabelHostname)
+	}
+
+	if opts.ControlPlaneTolerations {
+		ApplyControlPlaneTolerations(&template.Spec)
+	}
+}
+
+// ApplyRestrictedSecurityContext makes the pod compatible with the restricted-v2 SecurityContextConstraints: it runs as
+// non-root with the runtime default seccomp profile, and its containers cannot escalate privileges and drop all
+// capabilities. The user and group are left to the SecurityContextConstraints, which assign them from the namespace.
+func ApplyRestrictedSecurityContext(spec *corev1.PodSpec) {
+	if spec.SecurityContext == nil {
+		spec.SecurityContext = &corev1.PodSecurityContext{}
+	}
+
+	if spec.SecurityContext.RunAsNonRoot == nil {
+		spec.SecurityContext.RunAsNonRoot = ptr.To(true)
+	}
+
+	if spec.SecurityContext.SeccompProfile == nil {
+		spec.SecurityContext.SeccompProfile = &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault}
+	}
+
+	for _, containers := range [][]corev1.Container{spec.InitContainers, spec.Containers} {
+		for i := range containers {
+			restrictContainer(&containers[i])
+		}
+	}
+}
+
+// restrictContainer prevents the container from escalating privileges and drops all its capabilities.
+func restrictContainer(container *corev1.Container) {
+	if container.SecurityContext == nil {
+		container.SecurityContext = &corev1.SecurityContext{}
+	}
+
+	if container.SecurityContext.AllowPrivilegeEscalation == nil {
+		container.SecurityContext.AllowPrivilegeEscalation = ptr.To(false)
+	}
+
+	if container.SecurityContext.Capabilities == nil {
+		container.SecurityContext.Capabilities = &corev1.Capabilities{}
+	}
+
+	if len(container.SecurityContext.Capabilities.Drop) == 0 {
+		container.SecurityContext.Capabilities.Drop = []corev1.Capability{"ALL"}
+	}
+}
+
+// ApplyRequiredAntiAffinity prevents the pods matching selector from being scheduled in the same topology domain,
+// e.g. on the same node with corev1.LabelHostname. The term is added once.
+func ApplyRequiredAntiAffinity(spec *corev1.PodSpec, selector *metav1.LabelSelector, topologyKey string) {
+	if spec.Affinity == nil {
+		spec.Affinity = &corev1.Affinity{}
+	}
+
+	if spec.Affinity.PodAntiAffinity == nil {
+		spec.Affinity.PodAntiAffinity = &corev1.PodAntiAffinity{}
+	}
+
+	term := corev1.PodAffinityTerm{LabelSelector: selector, TopologyKey: topologyKey}
+
+	antiAffinity := spec.Affinity.PodAntiAffinity
+	if slices.ContainsFunc(antiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, func(existing corev1.PodAffinityTerm) bool {
+		return equality.Semantic.DeepEqual(existing, term)
+	}) {
+		return
+	}
+
+	antiAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(antiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, term)
+}
+
+// ApplyControlPlaneTolerations lets the pod run on control plane nodes despite their NoSchedule taints. Each toleration
+// is added once.
+func ApplyControlPlaneTolerations(spec *corev1.PodSpec) {
+	for _, key := range []string{MasterNodeRoleLabel, ControlPlaneNodeRoleLabel} {
+		toleration := corev1.Toleration{Key: key, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule}
+
+		if !slices.Contains(spec.Tolerations, toleration) {
+			spec.Tolerations = append(spec.Tolerations, toleration)
+		}
+	}
+}
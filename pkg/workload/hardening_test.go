@@ -0,0 +1,92 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// newTemplate returns the pod template of an operand.
+func newTemplate() *corev1.PodTemplateSpec {
+	return &corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "operand"}},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "init"}},
+			Containers:     []corev1.Container{{Name: "operand"}},
+		},
+	}
+}
+
+var _ = Describe("Harden", func() {
+	It("should apply nothing by default", func() {
+		template := newTemplate()
+		Harden(template, HardeningOptions{})
+
+		Expect(template).To(Equal(newTemplate()))
+	})
+
+	It("should apply the selected defaults idempotently", func() {
+		opts := HardeningOptions{
+			RestrictedSecurityContext: true,
+			PriorityClassName:         SystemClusterCriticalPriorityClass,
+			RequiredAntiAffinity:      true,
+			ControlPlaneTolerations:   true,
+		}
+
+		template := newTemplate()
+		Harden(template, opts)
+
+		spec := template.Spec
+		Expect(spec.SecurityContext.RunAsNonRoot).To(Equal(ptr.To(true)))
+		Expect(spec.SecurityContext.SeccompProfile.Type).To(Equal(corev1.SeccompProfileTypeRuntimeDefault))
+
+		for _, container := range append(spec.InitContainers, spec.Containers...) {
+			Expect(container.SecurityContext.AllowPrivilegeEscalation).To(Equal(ptr.To(false)))
+			Expect(container.SecurityContext.Capabilities.Drop).To(ConsistOf(corev1.Capability("ALL")))
+		}
+
+		Expect(spec.PriorityClassName).To(Equal(SystemClusterCriticalPriorityClass))
+		Expect(spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution).To(ConsistOf(corev1.PodAffinityTerm{
+			LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "operand"}},
+			TopologyKey:   corev1.LabelHostname,
+		}))
+		Expect(spec.Tolerations).To(HaveLen(2))
+
+		hardened := template.DeepCopy()
+		Harden(template, opts)
+		Expect(template).To(Equal(hardened))
+	})
+
+	It("should keep the values set by the template", func() {
+		template := newTemplate()
+		template.Spec.PriorityClassName = "custom"
+		template.Spec.SecurityContext = &corev1.PodSecurityContext{RunAsNonRoot: ptr.To(false)}
+		template.Spec.Containers[0].SecurityContext = &corev1.SecurityContext{
+			Capabilities: &corev1.Capabilities{Drop: []corev1.Capability{"NET_RAW"}},
+		}
+
+		Harden(template, HardeningOptions{RestrictedSecurityContext: true, PriorityClassName: SystemClusterCriticalPriorityClass})
+
+		Expect(template.Spec.PriorityClassName).To(Equal("custom"))
+		Expect(template.Spec.SecurityContext.RunAsNonRoot).To(Equal(ptr.To(false)))
+		Expect(template.Spec.Containers[0].SecurityContext.Capabilities.Drop).To(ConsistOf(corev1.Capability("NET_RAW")))
+	})
+})
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// ProjectNodeSelectorAnnotation is the annotation of namespaces holding the node selector of their pods. When set,
+	// even empty, it replaces the default node selector of the cluster for the namespace.
+	ProjectNodeSelectorAnnotation = "openshift.io/node-selector"
+
+	// InfraNodeRoleLabel is the label, and taint, of the infra nodes dedicated to infrastructure workloads.
+	InfraNodeRoleLabel = "node-role.kubernetes.io/infra"
+)
+
+// ErrNodeSelectorConflict is returned when the node selector of pods conflicts with the node selector of their
+// project, in which case admission rejects them.
+var ErrNodeSelectorConflict = errors.New("node selector conflicts with the node selector of the project")
+
+// Placement is where the pods of an operand are scheduled, as configured in the config custom resource of the
+// operator.
+type Placement struct {
+	// NodeSelector selects the nodes of the pods.
+	NodeSelector map[string]string
+	// Tolerations let the pods run on tainted nodes.
+	Tolerations []corev1.Toleration
+}
+
+// InfraPlacement returns the placement on infra nodes, selected by InfraNodeRoleLabel and tolerating its taints.
+//
+// The pods of namespaces subject to the default node selector of the cluster must match it as well, which infra nodes
+// often do not: the namespaces of the operands placed on infra nodes should set ProjectNodeSelectorAnnotation to "".
+func InfraPlacement() Placement {
+	return Placement{
+		NodeSelector: map[string]string{InfraNodeRoleLabel: ""},
+		Tolerations: []corev1.Toleration{
+			{Key: InfraNodeRoleLabel, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+			{Key: InfraNodeRoleLabel, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute},
+		},
+	}
+}
+
+// ProjectNodeSelector returns the node selector admission adds to the pods of the namespace: its
+// ProjectNodeSelectorAnnotation when set, even empty, and otherwise the default node selector of the cluster, e.g. from
+// clusterconfig.SchedulerWatcher.DefaultNodeSelector.
+func ProjectNodeSelector(namespace *corev1.Namespace, clusterDefaultNodeSelector string) (map[string]string, error) {
+	selector := clusterDefaultNodeSelector
+	if annotation, ok := namespace.Annotations[ProjectNodeSelectorAnnotation]; ok {
+		selector = annotation
+	}
+
+	if selector == "" {
+		return nil, nil
+	}
+
+	set, err := labels.ConvertSelectorToLabelsMap(selector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse node selector %q of namespace %s: %w", selector, namespace.Name, err)
+	}
+
+	return set, nil
+}
+
+// EffectiveNodeSelector returns the node selector of the pods once admitted in their project: the node selector of the
+// pods merged with the node selector of the project. It fails with ErrNodeSelectorConflict when both select different
+// values of a label, as admission rejects such pods.
+func EffectiveNodeSelector(nodeSelector, projectNodeSelector map[string]string) (map[string]string, error) {
+	effective := maps.Clone(nodeSelector)
+
+	for _, key := range slices.Sorted(maps.Keys(projectNodeSelector)) {
+		value := projectNodeSelector[key]
+
+		if current, ok := effective[key]; ok && current != value {
+			return nil, fmt.Errorf("%w: %s=%s conflicts with %s=%s", ErrNodeSelectorConflict, key, current, key, value)
+		}
+
+		if effective == nil {
+			effective = map[string]string{}
+		}
+
+		effective[key] = value
+	}
+
+	return effective, nil
+}
+
+// ApplyPlacement merges the configured placement into the pod: the labels of its node selector replace those of the
+// pod, and its tolerations replace the tolerations of the pod with the same key and effect. The node selector of the
+// project is left to admission, and the placement is rejected with ErrNodeSelectorConflict when admission would
+// reject the pods, leaving the pod unchanged.
+//
+// Example:
+//
+//	projectNodeSelector, err := workload.ProjectNodeSelector(namespace, schedulerWatcher.DefaultNodeSelector())
+//	if err != nil {
+//	    return err
+//	}
+//
+//	placement := workload.Placement{NodeSelector: config.Spec.NodeSelector, Tolerations: config.Spec.Tolerations}
+//	if err := workload.ApplyPlacement(&deployment.Spec.Template.Spec, placement, projectNodeSelector); err != nil {
+//	    return err
+//	}
+func ApplyPlacement(spec *corev1.PodSpec, placement Placement, projectNodeSelector map[string]string) error {
+	nodeSelector := maps.Clone(spec.NodeSelector)
+	if len(placement.NodeSelector) > 0 {
+		if nodeSelector == nil {
+			nodeSelector = map[string]string{}
+		}
+
+		maps.Copy(nodeSelector, placement.NodeSelector)
+	}
+
+	if _, err := EffectiveNodeSelector(nodeSelector, projectNodeSelector); err != nil {
+		return err
+	}
+
+	spec.NodeSelector = nodeSelector
+	spec.Tolerations = MergeTolerations(spec.Tolerations, placement.Tolerations)
+
+	return nil
+}
+
+// MergeTolerations returns the tolerations with the overrides, which replace the tolerations with the same key and
+// effect, keeping the order of the tolerations and appending the new ones.
+func MergeTolerations(tolerations, overrides []corev1.Toleration) []corev1.Toleration {
+	merged := slices.Clone(tolerations)
+
+	for _, override := range overrides {
+		i := slices.IndexFunc(merged, func(t corev1.Toleration) bool {
+			return t.Key == override.Key && t.Effect == override.Effect
+		})
+		if i < 0 {
+			merged = append(merged, override)
+
+			continue
+		}
+
+		merged[i] = override
+	}
+
+	return merged
+}
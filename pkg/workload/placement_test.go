@@ -0,0 +1,96 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("ProjectNodeSelector", func() {
+	DescribeTable("should prefer the annotation of the namespace to the cluster default",
+		func(annotations map[string]string, expected map[string]string) {
+			namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns", Annotations: annotations}}
+
+			selector, err := ProjectNodeSelector(namespace, "node-role.kubernetes.io/worker=")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(selector).To(Equal(expected))
+		},
+		Entry("without annotation", nil, map[string]string{"node-role.kubernetes.io/worker": ""}),
+		Entry("with an empty annotation", map[string]string{ProjectNodeSelectorAnnotation: ""}, nil),
+		Entry("with an annotation", map[string]string{ProjectNodeSelectorAnnotation: "region=east,zone=a"},
+			map[string]string{"region": "east", "zone": "a"}),
+	)
+
+	It("should reject invalid selectors", func() {
+		namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:        "ns",
+			Annotations: map[string]string{ProjectNodeSelectorAnnotation: "region in (east)"},
+		}}
+
+		_, err := ProjectNodeSelector(namespace, "")
+		Expect(err).To(MatchError(ContainSubstring(`failed to parse node selector "region in (east)" of namespace ns`)))
+	})
+})
+
+var _ = Describe("EffectiveNodeSelector", func() {
+	It("should merge the node selector of the project", func() {
+		selector, err := EffectiveNodeSelector(map[string]string{InfraNodeRoleLabel: ""}, map[string]string{"region": "east"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(selector).To(Equal(map[string]string{InfraNodeRoleLabel: "", "region": "east"}))
+	})
+
+	It("should reject conflicting node selectors", func() {
+		_, err := EffectiveNodeSelector(map[string]string{"region": "west"}, map[string]string{"region": "east"})
+		Expect(err).To(MatchError(ErrNodeSelectorConflict))
+	})
+})
+
+var _ = Describe("ApplyPlacement", func() {
+	It("should merge the placement into the pod", func() {
+		spec := corev1.PodSpec{
+			NodeSelector: map[string]string{corev1.LabelOSStable: "linux"},
+			Tolerations: []corev1.Toleration{
+				{Key: InfraNodeRoleLabel, Operator: corev1.TolerationOpEqual, Value: "reserved", Effect: corev1.TaintEffectNoSchedule},
+				{Key: "example.com/other", Operator: corev1.TolerationOpExists},
+			},
+		}
+
+		Expect(ApplyPlacement(&spec, InfraPlacement(), nil)).To(Succeed())
+
+		Expect(spec.NodeSelector).To(Equal(map[string]string{corev1.LabelOSStable: "linux", InfraNodeRoleLabel: ""}))
+		Expect(spec.Tolerations).To(Equal([]corev1.Toleration{
+			{Key: InfraNodeRoleLabel, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+			{Key: "example.com/other", Operator: corev1.TolerationOpExists},
+			{Key: InfraNodeRoleLabel, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute},
+		}))
+
+		applied := spec.DeepCopy()
+		Expect(ApplyPlacement(&spec, InfraPlacement(), nil)).To(Succeed())
+		Expect(&spec).To(Equal(applied))
+	})
+
+	It("should leave the pod unchanged when admission would reject it", func() {
+		spec := corev1.PodSpec{}
+
+		err := ApplyPlacement(&spec, Placement{NodeSelector: map[string]string{"region": "west"}}, map[string]string{"region": "east"})
+		Expect(err).To(MatchError(ErrNodeSelectorConflict))
+		Expect(spec.NodeSelector).To(BeNil())
+	})
+})
@@ -0,0 +1,190 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var (
+	// ErrInvalidResources is returned for invalid resource requirements.
+	ErrInvalidResources = errors.New("invalid resource requirements")
+	// ErrUnknownContainer is returned for resource overrides of containers the pod does not have.
+	ErrUnknownContainer = errors.New("unknown container")
+)
+
+// ParseResourceList parses quantities keyed by resource name, e.g. {"cpu": "100m", "memory": "256Mi"}, as found in the
+// config custom resources of operators exposing them as strings.
+func ParseResourceList(quantities map[string]string) (corev1.ResourceList, error) {
+	if len(quantities) == 0 {
+		return nil, nil
+	}
+
+	list := make(corev1.ResourceList, len(quantities))
+
+	for _, name := range slices.Sorted(maps.Keys(quantities)) {
+		quantity, err := resource.ParseQuantity(quantities[name])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %q: %w", ErrInvalidResources, name, quantities[name], err)
+		}
+
+		list[corev1.ResourceName(name)] = quantity
+	}
+
+	return list, nil
+}
+
+// ValidateResourceRequirements checks that the quantities are not negative, and that limits are not lower than
+// requests.
+func ValidateResourceRequirements(requirements corev1.ResourceRequirements) error {
+	var errs []error
+
+	for _, list := range []corev1.ResourceList{requirements.Requests, requirements.Limits} {
+		for _, name := range slices.Sorted(maps.Keys(list)) {
+			if quantity := list[name]; quantity.Sign() < 0 {
+				errs = append(errs, fmt.Errorf("%w: %s must not be negative, got %s", ErrInvalidResources, name, quantity.String()))
+			}
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(requirements.Limits)) {
+		request, ok := requirements.Requests[name]
+		if limit := requirements.Limits[name]; ok && limit.Cmp(request) < 0 {
+			errs = append(errs, fmt.Errorf("%w: %s limit %s must not be lower than its request %s",
+				ErrInvalidResources, name, limit.String(), request.String()))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// MergeResourceRequirements returns the defaults with the requests and limits set by overrides, resource by resource,
+// in their canonical form, defaulting the requests without value to their limits as the API server does. The result is
+// the same on every call, and as stored by the API server, so that it does not trigger rollouts.
+//
+// A default request over a limit lowered by the overrides is lowered to the limit, so that only the overrides can make
+// the requirements invalid, e.g. with a request over its limit.
+func MergeResourceRequirements(defaults, overrides corev1.ResourceRequirements) (corev1.ResourceRequirements, error) {
+	merged := corev1.ResourceRequirements{
+		Requests: mergeResourceList(defaults.Requests, overrides.Requests),
+		Limits:   mergeResourceList(defaults.Limits, overrides.Limits),
+		Claims:   defaults.Claims,
+	}
+
+	if len(overrides.Claims) > 0 {
+		merged.Claims = overrides.Claims
+	}
+
+	for name, limit := range merged.Limits {
+		request, ok := merged.Requests[name]
+
+		_, overridden := overrides.Requests[name]
+		if !ok || (!overridden && request.Cmp(limit) > 0) {
+			if merged.Requests == nil {
+				merged.Requests = corev1.ResourceList{}
+			}
+
+			merged.Requests[name] = limit.DeepCopy()
+		}
+	}
+
+	if err := ValidateResourceRequirements(merged); err != nil {
+		return corev1.ResourceRequirements{}, err
+	}
+
+	return merged, nil
+}
+
+// ApplyResourceOverrides merges the resource overrides, keyed by container name, into the resources of the containers
+// and init containers of the pod with MergeResourceRequirements. Overrides of containers the pod does not have are
+// rejected, to catch typos in the configuration, and the pod is left unchanged on errors.
+//
+// Example:
+//
+//	overrides := map[string]corev1.ResourceRequirements{"operand": config.Spec.Resources}
+//	if err := workload.ApplyResourceOverrides(&deployment.Spec.Template.Spec, overrides); err != nil {
+//	    return fmt.Errorf("invalid resources in %s: %w", config.Name, err)
+//	}
+func ApplyResourceOverrides(spec *corev1.PodSpec, overrides map[string]corev1.ResourceRequirements) error {
+	merged := map[string]corev1.ResourceRequirements{}
+
+	var errs []error
+
+	for _, name := range slices.Sorted(maps.Keys(overrides)) {
+		container := findContainer(spec, name)
+		if container == nil {
+			errs = append(errs, fmt.Errorf("%w: %q", ErrUnknownContainer, name))
+
+			continue
+		}
+
+		requirements, err := MergeResourceRequirements(container.Resources, overrides[name])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("container %q: %w", name, err))
+
+			continue
+		}
+
+		merged[name] = requirements
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	for name, requirements := range merged {
+		findContainer(spec, name).Resources = requirements
+	}
+
+	return nil
+}
+
+// mergeResourceList returns the canonical quantities of defaults replaced by those of overrides.
+func mergeResourceList(defaults, overrides corev1.ResourceList) corev1.ResourceList {
+	if len(defaults) == 0 && len(overrides) == 0 {
+		return nil
+	}
+
+	merged := make(corev1.ResourceList, len(defaults)+len(overrides))
+	for _, list := range []corev1.ResourceList{defaults, overrides} {
+		for name, quantity := range list {
+			// Round trip the quantity through its canonical form, as the API server stores it.
+			merged[name] = resource.MustParse(quantity.String())
+		}
+	}
+
+	return merged
+}
+
+// findContainer returns the container or init container of the pod with the given name, or nil.
+func findContainer(spec *corev1.PodSpec, name string) *corev1.Container {
+	for _, containers := range [][]corev1.Container{spec.Containers, spec.InitContainers} {
+		for i := range containers {
+			if containers[i].Name == name {
+				return &containers[i]
+			}
+		}
+	}
+
+	return nil
+}
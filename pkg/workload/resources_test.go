@@ -0,0 +1,115 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("ParseResourceList", func() {
+	It("should parse the quantities", func() {
+		list, err := ParseResourceList(map[string]string{"cpu": "100m", "memory": "256Mi"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list).To(Equal(corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("100m"),
+			corev1.ResourceMemory: resource.MustParse("256Mi"),
+		}))
+	})
+
+	It("should reject invalid quantities", func() {
+		_, err := ParseResourceList(map[string]string{"memory": "lots"})
+		Expect(err).To(MatchError(ErrInvalidResources))
+		Expect(err).To(MatchError(ContainSubstring(`memory: "lots"`)))
+	})
+})
+
+var _ = Describe("ValidateResourceRequirements", func() {
+	It("should reject negative quantities and limits lower than requests", func() {
+		err := ValidateResourceRequirements(corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("-1"), corev1.ResourceMemory: resource.MustParse("1Gi")},
+			Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")},
+		})
+		Expect(err).To(MatchError(ErrInvalidResources))
+		Expect(err).To(MatchError(ContainSubstring("cpu must not be negative")))
+		Expect(err).To(MatchError(ContainSubstring("memory limit 512Mi must not be lower than its request 1Gi")))
+	})
+})
+
+var _ = Describe("MergeResourceRequirements", func() {
+	defaults := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m"), corev1.ResourceMemory: resource.MustParse("256Mi")},
+	}
+
+	It("should replace the defaults resource by resource in canonical form", func() {
+		merged, err := MergeResourceRequirements(defaults, corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("0.5")},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(merged.Requests.Cpu().String()).To(Equal("500m"))
+		Expect(merged.Requests.Memory().String()).To(Equal("256Mi"))
+		Expect(merged.Limits).To(BeNil())
+	})
+
+	It("should default the requests to the limits", func() {
+		merged, err := MergeResourceRequirements(defaults, corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi"), "example.com/gpu": resource.MustParse("1")},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(merged.Requests.Memory().String()).To(Equal("128Mi"))
+		Expect(merged.Requests).To(HaveKeyWithValue(corev1.ResourceName("example.com/gpu"), resource.MustParse("1")))
+	})
+
+	It("should reject overrides with a request over their limit", func() {
+		_, err := MergeResourceRequirements(defaults, corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+			Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")},
+		})
+		Expect(err).To(MatchError(ErrInvalidResources))
+	})
+})
+
+var _ = Describe("ApplyResourceOverrides", func() {
+	It("should merge the overrides into the containers", func() {
+		spec := newTemplate().Spec
+		overrides := map[string]corev1.ResourceRequirements{
+			"init":    {Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("10m")}},
+			"operand": {Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")}},
+		}
+
+		Expect(ApplyResourceOverrides(&spec, overrides)).To(Succeed())
+		Expect(spec.InitContainers[0].Resources.Requests.Cpu().String()).To(Equal("10m"))
+		Expect(spec.Containers[0].Resources.Limits.Memory().String()).To(Equal("1Gi"))
+
+		applied := spec.DeepCopy()
+		Expect(ApplyResourceOverrides(&spec, overrides)).To(Succeed())
+		Expect(&spec).To(Equal(applied))
+	})
+
+	It("should leave the pod unchanged on errors", func() {
+		spec := newTemplate().Spec
+
+		err := ApplyResourceOverrides(&spec, map[string]corev1.ResourceRequirements{
+			"operand": {Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("10m")}},
+			"typo":    {},
+		})
+		Expect(err).To(MatchError(ErrUnknownContainer))
+		Expect(spec).To(Equal(newTemplate().Spec))
+	})
+})
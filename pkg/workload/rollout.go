@@ -0,0 +1,267 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package workload provides helpers for the workloads of the operands of OpenShift operators: evaluating the rollout
+// of their Deployments and DaemonSets, and building their pod templates.
+package workload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openshift/controller-runtime-common/pkg/conditions"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ReasonSpecNotObserved is the reason of a rollout whose latest spec has not been observed by its controller yet.
+	ReasonSpecNotObserved = "SpecNotObserved"
+	// ReasonUpdatingReplicas is the reason of a rollout whose replicas are not all updated yet.
+	ReasonUpdatingReplicas = "UpdatingReplicas"
+	// ReasonTerminatingOldReplicas is the reason of a rollout whose old replicas are still terminating.
+	ReasonTerminatingOldReplicas = "TerminatingOldReplicas"
+	// ReasonUpdatedReplicasUnavailable is the reason of a rollout whose updated replicas are not all available yet.
+	ReasonUpdatedReplicasUnavailable = "UpdatedReplicasUnavailable"
+	// ReasonProgressDeadlineExceeded is the reason of a Deployment rollout which exceeded its progress deadline.
+	ReasonProgressDeadlineExceeded = "ProgressDeadlineExceeded"
+	// ReasonUnsupportedUpdateStrategy is the reason of a DaemonSet whose update strategy has no rollout to follow.
+	ReasonUnsupportedUpdateStrategy = "UnsupportedUpdateStrategy"
+	// ReasonMinimumReplicasUnavailable is the reason of a Deployment without its minimum available replicas.
+	ReasonMinimumReplicasUnavailable = "MinimumReplicasUnavailable"
+
+	// rolloutPollInterval is the interval at which WaitForRollout checks the rollout.
+	rolloutPollInterval = time.Second
+)
+
+var (
+	// ErrRolloutFailed is returned when a rollout cannot complete without an intervention.
+	ErrRolloutFailed = errors.New("rollout failed")
+	// ErrUnsupportedWorkload is returned for objects other than Deployments and DaemonSets.
+	ErrUnsupportedWorkload = errors.New("unsupported workload")
+)
+
+// Status is the outcome of evaluating a workload, with a reason and message suitable for the Progressing and Degraded
+// conditions of the operator.
+type Status struct {
+	// Done reports whether the workload is rolled out, or available.
+	Done bool
+	// Failed reports whether the rollout cannot complete without an intervention, e.g. a fixed pod template.
+	Failed bool
+
+	// Reason is the CamelCase reason of the status, conditions.ReasonAsExpected when it is done.
+	Reason string
+	// Message describes the status.
+	Message string
+}
+
+// IsDeploymentRolledOut reports whether the rollout of the Deployment is complete, as kubectl rollout status: its
+// latest spec is observed, and all its replicas are updated and available, without old replicas left.
+func IsDeploymentRolledOut(deployment *appsv1.Deployment) Status {
+	if deployment.Generation > deployment.Status.ObservedGeneration {
+		return Status{
+			Reason:  ReasonSpecNotObserved,
+			Message: fmt.Sprintf("Waiting for the spec update of deployment %q to be observed", deployment.Name),
+		}
+	}
+
+	if cond := deploymentCondition(deployment, appsv1.DeploymentProgressing); cond != nil && cond.Reason == ReasonProgressDeadlineExceeded {
+		return Status{
+			Failed:  true,
+			Reason:  ReasonProgressDeadlineExceeded,
+			Message: fmt.Sprintf("Deployment %q exceeded its progress deadline", deployment.Name),
+		}
+	}
+
+	status := deployment.Status
+
+	if deployment.Spec.Replicas != nil && status.UpdatedReplicas < *deployment.Spec.Replicas {
+		return Status{
+			Reason: ReasonUpdatingReplicas,
+			Message: fmt.Sprintf("Waiting for deployment %q rollout to finish: %d out of %d new replicas have been updated",
+				deployment.Name, status.UpdatedReplicas, *deployment.Spec.Replicas),
+		}
+	}
+
+	if status.Replicas > status.UpdatedReplicas {
+		return Status{
+			Reason: ReasonTerminatingOldReplicas,
+			Message: fmt.Sprintf("Waiting for deployment %q rollout to finish: %d old replicas are pending termination",
+				deployment.Name, status.Replicas-status.UpdatedReplicas),
+		}
+	}
+
+	if status.AvailableReplicas < status.UpdatedReplicas {
+		return Status{
+			Reason: ReasonUpdatedReplicasUnavailable,
+			Message: fmt.Sprintf("Waiting for deployment %q rollout to finish: %d of %d updated replicas are available",
+				deployment.Name, status.AvailableReplicas, status.UpdatedReplicas),
+		}
+	}
+
+	return Status{
+		Done:    true,
+		Reason:  conditions.ReasonAsExpected,
+		Message: fmt.Sprintf("Deployment %q successfully rolled out", deployment.Name),
+	}
+}
+
+// IsDeploymentAvailable reports whether the Deployment has its minimum available replicas, according to its Available
+// condition, which may be the case in the middle of a rollout.
+func IsDeploymentAvailable(deployment *appsv1.Deployment) Status {
+	if deployment.Status.ObservedGeneration == 0 {
+		return Status{
+			Reason:  ReasonSpecNotObserved,
+			Message: fmt.Sprintf("Waiting for deployment %q to be observed", deployment.Name),
+		}
+	}
+
+	cond := deploymentCondition(deployment, appsv1.DeploymentAvailable)
+	if cond == nil || cond.Status != corev1.ConditionTrue {
+		status := Status{
+			Reason: ReasonMinimumReplicasUnavailable,
+			Message: fmt.Sprintf("Deployment %q does not have minimum availability: %d of %d replicas are available",
+				deployment.Name, deployment.Status.AvailableReplicas, deployment.Status.Replicas),
+		}
+
+		if cond != nil && cond.Message != "" {
+			status.Message = fmt.Sprintf("Deployment %q is unavailable: %s", deployment.Name, cond.Message)
+		}
+
+		return status
+	}
+
+	return Status{
+		Done:    true,
+		Reason:  conditions.ReasonAsExpected,
+		Message: fmt.Sprintf("Deployment %q is available", deployment.Name),
+	}
+}
+
+// IsDaemonSetRolledOut reports whether the rollout of the DaemonSet is complete, as kubectl rollout status: its latest
+// spec is observed, and its pods are updated and available on all the nodes they are scheduled on. DaemonSets updated
+// OnDelete have no rollout to follow, and are reported as failed.
+func IsDaemonSetRolledOut(daemonSet *appsv1.DaemonSet) Status {
+	if daemonSet.Spec.UpdateStrategy.Type != appsv1.RollingUpdateDaemonSetStrategyType {
+		return Status{
+			Failed:  true,
+			Reason:  ReasonUnsupportedUpdateStrategy,
+			Message: fmt.Sprintf("Rollout status is only available for the %s strategy of daemon set %q", appsv1.RollingUpdateDaemonSetStrategyType, daemonSet.Name),
+		}
+	}
+
+	if daemonSet.Generation > daemonSet.Status.ObservedGeneration {
+		return Status{
+			Reason:  ReasonSpecNotObserved,
+			Message: fmt.Sprintf("Waiting for the spec update of daemon set %q to be observed", daemonSet.Name),
+		}
+	}
+
+	status := daemonSet.Status
+
+	if status.UpdatedNumberScheduled < status.DesiredNumberScheduled {
+		return Status{
+			Reason: ReasonUpdatingReplicas,
+			Message: fmt.Sprintf("Waiting for daemon set %q rollout to finish: %d out of %d new pods have been updated",
+				daemonSet.Name, status.UpdatedNumberScheduled, status.DesiredNumberScheduled),
+		}
+	}
+
+	if status.NumberAvailable < status.DesiredNumberScheduled {
+		return Status{
+			Reason: ReasonUpdatedReplicasUnavailable,
+			Message: fmt.Sprintf("Waiting for daemon set %q rollout to finish: %d of %d updated pods are available",
+				daemonSet.Name, status.NumberAvailable, status.DesiredNumberScheduled),
+		}
+	}
+
+	return Status{
+		Done:    true,
+		Reason:  conditions.ReasonAsExpected,
+		Message: fmt.Sprintf("Daemon set %q successfully rolled out", daemonSet.Name),
+	}
+}
+
+// RolloutStatus returns the rollout status of a Deployment or DaemonSet.
+func RolloutStatus(obj client.Object) (Status, error) {
+	switch workload := obj.(type) {
+	case *appsv1.Deployment:
+		return IsDeploymentRolledOut(workload), nil
+	case *appsv1.DaemonSet:
+		return IsDaemonSetRolledOut(workload), nil
+	default:
+		return Status{}, fmt.Errorf("%w: %T", ErrUnsupportedWorkload, obj)
+	}
+}
+
+// WaitForRollout waits at most timeout for the rollout of the Deployment or DaemonSet to complete, reading it with c
+// into obj, whose name and namespace are set. It keeps waiting when obj cannot be read, e.g. when it is not in the
+// cache yet right after its creation. It returns the last status, and an error wrapping ErrRolloutFailed when the
+// rollout failed, or the error of the last status, or of the last read, when it timed out.
+//
+// Reconcilers should rather requeue until IsDeploymentRolledOut reports the rollout complete; this suits installers
+// and tests.
+func WaitForRollout(ctx context.Context, c client.Reader, obj client.Object, timeout time.Duration) (Status, error) {
+	key := client.ObjectKeyFromObject(obj)
+
+	var (
+		status Status
+		getErr error
+	)
+
+	if err := wait.PollUntilContextTimeout(ctx, rolloutPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		if getErr = c.Get(ctx, key, obj); getErr != nil {
+			return false, nil
+		}
+
+		var err error
+		if status, err = RolloutStatus(obj); err != nil {
+			return false, err
+		}
+
+		if status.Failed {
+			return false, fmt.Errorf("%w: %s", ErrRolloutFailed, status.Message)
+		}
+
+		return status.Done, nil
+	}); err != nil {
+		switch {
+		case getErr != nil && wait.Interrupted(err):
+			return status, fmt.Errorf("%w: failed to get %s: %w", err, key.String(), getErr)
+		case wait.Interrupted(err) && status.Message != "":
+			return status, fmt.Errorf("%w: %s", err, status.Message)
+		default:
+			return status, err
+		}
+	}
+
+	return status, nil
+}
+
+// deploymentCondition returns the condition of the given type of the Deployment, or nil.
+func deploymentCondition(deployment *appsv1.Deployment, conditionType appsv1.DeploymentConditionType) *appsv1.DeploymentCondition {
+	for i := range deployment.Status.Conditions {
+		if deployment.Status.Conditions[i].Type == conditionType {
+			return &deployment.Status.Conditions[i]
+		}
+	}
+
+	return nil
+}
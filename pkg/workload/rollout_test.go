@@ -0,0 +1,201 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/controller-runtime-common/pkg/conditions"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+// newDeployment returns a Deployment of 3 replicas, rolled out.
+func newDeployment() *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "operand", Generation: 2},
+		Spec:       appsv1.DeploymentSpec{Replicas: ptr.To[int32](3)},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration: 2,
+			Replicas:           3,
+			UpdatedReplicas:    3,
+			AvailableReplicas:  3,
+			Conditions: []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue},
+				{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionTrue, Reason: "NewReplicaSetAvailable"},
+			},
+		},
+	}
+}
+
+// newDaemonSet returns a DaemonSet scheduled on 3 nodes, rolled out.
+func newDaemonSet() *appsv1.DaemonSet {
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "agent", Generation: 2},
+		Spec: appsv1.DaemonSetSpec{
+			UpdateStrategy: appsv1.DaemonSetUpdateStrategy{Type: appsv1.RollingUpdateDaemonSetStrategyType},
+		},
+		Status: appsv1.DaemonSetStatus{
+			ObservedGeneration:     2,
+			DesiredNumberScheduled: 3,
+			UpdatedNumberScheduled: 3,
+			NumberAvailable:        3,
+		},
+	}
+}
+
+var _ = Describe("IsDeploymentRolledOut", func() {
+	DescribeTable("should evaluate the rollout",
+		func(mutate func(*appsv1.Deployment), done, failed bool, reason, message string) {
+			deployment := newDeployment()
+			mutate(deployment)
+
+			status := IsDeploymentRolledOut(deployment)
+			Expect(status.Done).To(Equal(done))
+			Expect(status.Failed).To(Equal(failed))
+			Expect(status.Reason).To(Equal(reason))
+			Expect(status.Message).To(Equal(message))
+		},
+		Entry("rolled out", func(*appsv1.Deployment) {}, true, false,
+			conditions.ReasonAsExpected, `Deployment "operand" successfully rolled out`),
+		Entry("spec not observed", func(d *appsv1.Deployment) { d.Generation = 3 }, false, false,
+			ReasonSpecNotObserved, `Waiting for the spec update of deployment "operand" to be observed`),
+		Entry("progress deadline exceeded", func(d *appsv1.Deployment) {
+			d.Status.Conditions[1].Reason = ReasonProgressDeadlineExceeded
+		}, false, true, ReasonProgressDeadlineExceeded, `Deployment "operand" exceeded its progress deadline`),
+		Entry("updating replicas", func(d *appsv1.Deployment) { d.Status.UpdatedReplicas = 1 }, false, false,
+			ReasonUpdatingReplicas, `Waiting for deployment "operand" rollout to finish: 1 out of 3 new replicas have been updated`),
+		Entry("terminating old replicas", func(d *appsv1.Deployment) { d.Status.Replicas = 4 }, false, false,
+			ReasonTerminatingOldReplicas, `Waiting for deployment "operand" rollout to finish: 1 old replicas are pending termination`),
+		Entry("updated replicas unavailable", func(d *appsv1.Deployment) { d.Status.AvailableReplicas = 2 }, false, false,
+			ReasonUpdatedReplicasUnavailable, `Waiting for deployment "operand" rollout to finish: 2 of 3 updated replicas are available`),
+	)
+})
+
+var _ = Describe("IsDeploymentAvailable", func() {
+	It("should follow the Available condition", func() {
+		deployment := newDeployment()
+		deployment.Status.UpdatedReplicas = 1
+		Expect(IsDeploymentAvailable(deployment).Done).To(BeTrue())
+
+		deployment.Status.Conditions[0].Status = corev1.ConditionFalse
+		deployment.Status.Conditions[0].Message = "Deployment does not have minimum availability."
+
+		status := IsDeploymentAvailable(deployment)
+		Expect(status.Done).To(BeFalse())
+		Expect(status.Reason).To(Equal(ReasonMinimumReplicasUnavailable))
+		Expect(status.Message).To(Equal(`Deployment "operand" is unavailable: Deployment does not have minimum availability.`))
+	})
+
+	It("should wait for the Deployment to be observed", func() {
+		deployment := newDeployment()
+		deployment.Status = appsv1.DeploymentStatus{}
+
+		Expect(IsDeploymentAvailable(deployment).Reason).To(Equal(ReasonSpecNotObserved))
+	})
+})
+
+var _ = Describe("IsDaemonSetRolledOut", func() {
+	DescribeTable("should evaluate the rollout",
+		func(mutate func(*appsv1.DaemonSet), done, failed bool, reason string) {
+			daemonSet := newDaemonSet()
+			mutate(daemonSet)
+
+			status := IsDaemonSetRolledOut(daemonSet)
+			Expect(status.Done).To(Equal(done))
+			Expect(status.Failed).To(Equal(failed))
+			Expect(status.Reason).To(Equal(reason))
+		},
+		Entry("rolled out", func(*appsv1.DaemonSet) {}, true, false, conditions.ReasonAsExpected),
+		Entry("updated on delete", func(ds *appsv1.DaemonSet) {
+			ds.Spec.UpdateStrategy.Type = appsv1.OnDeleteDaemonSetStrategyType
+		}, false, true, ReasonUnsupportedUpdateStrategy),
+		Entry("spec not observed", func(ds *appsv1.DaemonSet) { ds.Generation = 3 }, false, false, ReasonSpecNotObserved),
+		Entry("updating pods", func(ds *appsv1.DaemonSet) { ds.Status.UpdatedNumberScheduled = 1 }, false, false, ReasonUpdatingReplicas),
+		Entry("updated pods unavailable", func(ds *appsv1.DaemonSet) { ds.Status.NumberAvailable = 1 }, false, false, ReasonUpdatedReplicasUnavailable),
+	)
+})
+
+var _ = Describe("WaitForRollout", func() {
+	It("should return once the rollout is complete", func() {
+		c := fake.NewClientBuilder().WithObjects(newDaemonSet()).Build()
+
+		status, err := WaitForRollout(context.Background(), c, &appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "agent"}}, time.Second)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.Done).To(BeTrue())
+	})
+
+	It("should fail when the rollout failed", func() {
+		deployment := newDeployment()
+		deployment.Status.Conditions[1].Reason = ReasonProgressDeadlineExceeded
+		c := fake.NewClientBuilder().WithObjects(deployment).Build()
+
+		status, err := WaitForRollout(context.Background(), c, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "operand"}}, time.Second)
+		Expect(err).To(MatchError(ErrRolloutFailed))
+		Expect(status.Reason).To(Equal(ReasonProgressDeadlineExceeded))
+	})
+
+	It("should time out with the last status", func() {
+		deployment := newDeployment()
+		deployment.Status.AvailableReplicas = 2
+		c := fake.NewClientBuilder().WithObjects(deployment).Build()
+
+		_, err := WaitForRollout(context.Background(), c, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "operand"}}, 10*time.Millisecond)
+		Expect(err).To(MatchError(ContainSubstring("2 of 3 updated replicas are available")))
+	})
+
+	It("should keep waiting until the workload is found", func() {
+		notFound := true
+		c := interceptor.NewClient(fake.NewClientBuilder().WithObjects(newDaemonSet()).Build(), interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				if notFound {
+					notFound = false
+					return apierrors.NewNotFound(appsv1.Resource("daemonsets"), key.Name)
+				}
+
+				return c.Get(ctx, key, obj, opts...)
+			},
+		})
+
+		status, err := WaitForRollout(context.Background(), c, &appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "agent"}}, time.Minute)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.Done).To(BeTrue())
+	})
+
+	It("should time out with the last read error", func() {
+		c := fake.NewClientBuilder().Build()
+
+		_, err := WaitForRollout(context.Background(), c, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "operand"}}, 10*time.Millisecond)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should reject other objects", func() {
+		c := fake.NewClientBuilder().WithObjects(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "config"}}).Build()
+
+		_, err := WaitForRollout(context.Background(), c, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "config"}}, time.Second)
+		Expect(err).To(MatchError(ErrUnsupportedWorkload))
+	})
+})
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	configv1 "github.com/openshift/api/config/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+// Topology is the recommended deployment of an operand for the topology of the nodes it runs on.
+type Topology struct {
+	// Replicas is the number of replicas of the operand.
+	Replicas int32
+
+	// MaxUnavailable is the number of replicas that may be disrupted at once, enforced by a PodDisruptionBudget.
+	// It is nil when the operand should have no PodDisruptionBudget, as with a single replica, which a
+	// PodDisruptionBudget would prevent from ever being evicted and would block node drains.
+	MaxUnavailable *intstr.IntOrString
+
+	// Strategy is the rollout strategy of the operand.
+	Strategy appsv1.DeploymentStrategy
+
+	// RequiredAntiAffinity reports whether the replicas must run on different nodes.
+	RequiredAntiAffinity bool
+}
+
+// RecommendedTopology returns the recommended deployment of an operand running on nodes of the given topology, usually
+// the InfrastructureTopology of the Infrastructure, or its ControlPlaneTopology for operands running on the control
+// plane nodes:
+//   - on single node clusters, one replica rolled out by surging a new replica before removing the old one;
+//   - otherwise, including compact three-node clusters and two-node clusters, two replicas on different nodes, at most
+//     one of them disrupted or rolled out at a time, so that rollouts fit when every node already runs a replica.
+func RecommendedTopology(mode configv1.TopologyMode) Topology {
+	if mode == configv1.SingleReplicaTopologyMode {
+		return Topology{
+			Replicas: 1,
+			Strategy: appsv1.DeploymentStrategy{
+				Type: appsv1.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateDeployment{
+					MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+					MaxSurge:       ptr.To(intstr.FromInt32(1)),
+				},
+			},
+		}
+	}
+
+	return Topology{
+		Replicas:       2,
+		MaxUnavailable: ptr.To(intstr.FromInt32(1)),
+		Strategy: appsv1.DeploymentStrategy{
+			Type: appsv1.RollingUpdateDeploymentStrategyType,
+			RollingUpdate: &appsv1.RollingUpdateDeployment{
+				MaxUnavailable: ptr.To(intstr.FromInt32(1)),
+				MaxSurge:       ptr.To(intstr.FromInt32(0)),
+			},
+		},
+		RequiredAntiAffinity: true,
+	}
+}
+
+// ApplyToDeployment sets the replicas and strategy of the Deployment, and the anti-affinity of its pods selecting them
+// with the labels of its template.
+func (t Topology) ApplyToDeployment(deployment *appsv1.Deployment) {
+	deployment.Spec.Replicas = ptr.To(t.Replicas)
+	deployment.Spec.Strategy = *t.Strategy.DeepCopy()
+
+	if t.RequiredAntiAffinity && len(deployment.Spec.Template.Labels) > 0 {
+		ApplyRequiredAntiAffinity(&deployment.Spec.Template.Spec,
+			&metav1.LabelSelector{MatchLabels: deployment.Spec.Template.Labels}, corev1.LabelHostname)
+	}
+}
+
+// PodDisruptionBudget returns the PodDisruptionBudget of the operand whose pods match selector, to ensure with
+// resourceapply.EnsurePodDisruptionBudget, or nil when the operand should have none, in which case an existing one
+// should be deleted.
+func (t Topology) PodDisruptionBudget(namespace, name string, selector *metav1.LabelSelector) *policyv1.PodDisruptionBudget {
+	if t.MaxUnavailable == nil {
+		return nil
+	}
+
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector:       selector.DeepCopy(),
+			MaxUnavailable: ptr.To(*t.MaxUnavailable),
+		},
+	}
+}
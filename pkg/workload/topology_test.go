@@ -0,0 +1,67 @@
+/*
+Copyright 2026 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workload
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ = Describe("RecommendedTopology", func() {
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "operand"}}
+
+	It("should run a single replica without PodDisruptionBudget on single node clusters", func() {
+		topology := RecommendedTopology(configv1.SingleReplicaTopologyMode)
+
+		Expect(topology.Replicas).To(BeEquivalentTo(1))
+		Expect(topology.RequiredAntiAffinity).To(BeFalse())
+		Expect(topology.Strategy.RollingUpdate.MaxSurge).To(HaveValue(Equal(intstr.FromInt32(1))))
+		Expect(topology.PodDisruptionBudget("ns", "operand", selector)).To(BeNil())
+	})
+
+	DescribeTable("should spread replicas on other topologies",
+		func(mode configv1.TopologyMode) {
+			topology := RecommendedTopology(mode)
+
+			Expect(topology.Replicas).To(BeEquivalentTo(2))
+			Expect(topology.RequiredAntiAffinity).To(BeTrue())
+			Expect(topology.Strategy.RollingUpdate.MaxSurge).To(HaveValue(Equal(intstr.FromInt32(0))))
+
+			pdb := topology.PodDisruptionBudget("ns", "operand", selector)
+			Expect(pdb.Namespace).To(Equal("ns"))
+			Expect(pdb.Spec.Selector).To(Equal(selector))
+			Expect(pdb.Spec.MaxUnavailable).To(HaveValue(Equal(intstr.FromInt32(1))))
+		},
+		Entry("highly available", configv1.HighlyAvailableTopologyMode),
+		Entry("two nodes", configv1.DualReplicaTopologyMode),
+		Entry("unknown", configv1.TopologyMode("")),
+	)
+
+	It("should apply to a Deployment", func() {
+		deployment := &appsv1.Deployment{Spec: appsv1.DeploymentSpec{Template: *newTemplate()}}
+
+		RecommendedTopology(configv1.HighlyAvailableTopologyMode).ApplyToDeployment(deployment)
+
+		Expect(deployment.Spec.Replicas).To(HaveValue(BeEquivalentTo(2)))
+		Expect(deployment.Spec.Strategy.Type).To(Equal(appsv1.RollingUpdateDeploymentStrategyType))
+		Expect(deployment.Spec.Template.Spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution).To(HaveLen(1))
+	})
+})